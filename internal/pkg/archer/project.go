@@ -29,6 +29,7 @@ type ProjectStore interface {
 	ProjectLister
 	ProjectGetter
 	ProjectCreator
+	ProjectUpdater
 	ProjectDeleter
 }
 
@@ -42,6 +43,11 @@ type ProjectCreator interface {
 	CreateProject(project *Project) error
 }
 
+// ProjectUpdater updates an existing project in the underlying project manager.
+type ProjectUpdater interface {
+	UpdateProject(project *Project) error
+}
+
 // ProjectGetter fetches an individual project from the underlying project manager.
 type ProjectGetter interface {
 	GetProject(projectName string) (*Project, error)
@@ -6,9 +6,15 @@ package archer
 // Secretsmanager can create secrets in an underlying secret management store
 type SecretsManager interface {
 	SecretCreator
+	SecretDeleter
 }
 
 // SecretCreator creates a secretin the underlying secret management store
 type SecretCreator interface {
 	CreateSecret(secretName, secretString string) (string, error)
 }
+
+// SecretDeleter deletes a secret from the underlying secret management store
+type SecretDeleter interface {
+	DeleteSecret(secretName string) error
+}
@@ -0,0 +1,88 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cloudwatch contains utility functions for dealing with CloudWatch alarms.
+package cloudwatch
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+type cloudwatchClient interface {
+	DescribeAlarms(input *cloudwatch.DescribeAlarmsInput) (*cloudwatch.DescribeAlarmsOutput, error)
+}
+
+// CloudWatch wraps an AWS CloudWatch client.
+type CloudWatch struct {
+	client cloudwatchClient
+}
+
+// Alarm represents a CloudWatch metric alarm.
+type Alarm struct {
+	Name       string
+	Namespace  string
+	MetricName string
+	Dimensions map[string]string
+	Reason     string
+	UpdatedAt  time.Time
+}
+
+// New returns a CloudWatch client configured against the input session.
+func New(s *session.Session) *CloudWatch {
+	return &CloudWatch{
+		client: cloudwatch.New(s),
+	}
+}
+
+// AlarmsWithDimensions returns the metric alarms in ALARM state whose metric has all the dimensions of any of the sets.
+// The alarms are described once for all the sets.
+func (cw *CloudWatch) AlarmsWithDimensions(dimensionSets ...map[string]string) ([]*Alarm, error) {
+	var alarms []*Alarm
+	in := &cloudwatch.DescribeAlarmsInput{
+		StateValue: aws.String(cloudwatch.StateValueAlarm),
+	}
+	for {
+		resp, err := cw.client.DescribeAlarms(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe alarms in ALARM state: %w", err)
+		}
+		for _, metricAlarm := range resp.MetricAlarms {
+			alarm := &Alarm{
+				Name:       aws.StringValue(metricAlarm.AlarmName),
+				Namespace:  aws.StringValue(metricAlarm.Namespace),
+				MetricName: aws.StringValue(metricAlarm.MetricName),
+				Dimensions: make(map[string]string),
+				Reason:     aws.StringValue(metricAlarm.StateReason),
+				UpdatedAt:  aws.TimeValue(metricAlarm.StateUpdatedTimestamp),
+			}
+			for _, dimension := range metricAlarm.Dimensions {
+				alarm.Dimensions[aws.StringValue(dimension.Name)] = aws.StringValue(dimension.Value)
+			}
+			for _, dimensions := range dimensionSets {
+				if alarm.hasDimensions(dimensions) {
+					alarms = append(alarms, alarm)
+					break
+				}
+			}
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		in.NextToken = resp.NextToken
+	}
+	return alarms, nil
+}
+
+func (a *Alarm) hasDimensions(dimensions map[string]string) bool {
+	for name, value := range dimensions {
+		if a.Dimensions[name] != value {
+			return false
+		}
+	}
+	return true
+}
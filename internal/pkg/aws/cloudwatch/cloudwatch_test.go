@@ -0,0 +1,130 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatch
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/cloudwatch/mocks"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudWatch_AlarmsWithDimensions(t *testing.T) {
+	updatedAt := time.Date(2020, 4, 1, 10, 0, 0, 0, time.UTC)
+	serviceDimensions := map[string]string{
+		"ClusterName": "phonetool-test-Cluster",
+		"ServiceName": "phonetool-test-frontend-Service",
+	}
+	targetGroupDimensions := map[string]string{
+		"TargetGroup": "targetgroup/phonet-Targe-1A2B3C/6d0ecf831eec9f09",
+	}
+	testCases := map[string]struct {
+		mockClient func(m *mocks.MockcloudwatchClient)
+
+		wantedAlarms []*Alarm
+		wantedError  error
+	}{
+		"returns wrapped error if fail to describe the alarms": {
+			mockClient: func(m *mocks.MockcloudwatchClient) {
+				m.EXPECT().DescribeAlarms(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe alarms in ALARM state: some error"),
+		},
+		"returns the alarms of all the pages with any of the dimension sets": {
+			mockClient: func(m *mocks.MockcloudwatchClient) {
+				gomock.InOrder(
+					m.EXPECT().DescribeAlarms(&cloudwatch.DescribeAlarmsInput{
+						StateValue: aws.String("ALARM"),
+					}).Return(&cloudwatch.DescribeAlarmsOutput{
+						MetricAlarms: []*cloudwatch.MetricAlarm{
+							{
+								AlarmName:  aws.String("other-service-cpu"),
+								Namespace:  aws.String("AWS/ECS"),
+								MetricName: aws.String("CPUUtilization"),
+								Dimensions: []*cloudwatch.Dimension{
+									{Name: aws.String("ClusterName"), Value: aws.String("phonetool-test-Cluster")},
+									{Name: aws.String("ServiceName"), Value: aws.String("other-service")},
+								},
+							},
+						},
+						NextToken: aws.String("token"),
+					}, nil),
+					m.EXPECT().DescribeAlarms(&cloudwatch.DescribeAlarmsInput{
+						StateValue: aws.String("ALARM"),
+						NextToken:  aws.String("token"),
+					}).Return(&cloudwatch.DescribeAlarmsOutput{
+						MetricAlarms: []*cloudwatch.MetricAlarm{
+							{
+								AlarmName:  aws.String("frontend-cpu"),
+								Namespace:  aws.String("AWS/ECS"),
+								MetricName: aws.String("CPUUtilization"),
+								Dimensions: []*cloudwatch.Dimension{
+									{Name: aws.String("ClusterName"), Value: aws.String("phonetool-test-Cluster")},
+									{Name: aws.String("ServiceName"), Value: aws.String("phonetool-test-frontend-Service")},
+								},
+								StateReason:           aws.String("Threshold Crossed"),
+								StateUpdatedTimestamp: aws.Time(updatedAt),
+							},
+							{
+								AlarmName:  aws.String("frontend-5xx"),
+								Namespace:  aws.String("AWS/ApplicationELB"),
+								MetricName: aws.String("HTTPCode_Target_5XX_Count"),
+								Dimensions: []*cloudwatch.Dimension{
+									{Name: aws.String("TargetGroup"), Value: aws.String("targetgroup/phonet-Targe-1A2B3C/6d0ecf831eec9f09")},
+								},
+								StateReason:           aws.String("Threshold Crossed"),
+								StateUpdatedTimestamp: aws.Time(updatedAt),
+							},
+						},
+					}, nil),
+				)
+			},
+			wantedAlarms: []*Alarm{
+				{
+					Name:       "frontend-cpu",
+					Namespace:  "AWS/ECS",
+					MetricName: "CPUUtilization",
+					Dimensions: serviceDimensions,
+					Reason:     "Threshold Crossed",
+					UpdatedAt:  updatedAt,
+				},
+				{
+					Name:       "frontend-5xx",
+					Namespace:  "AWS/ApplicationELB",
+					MetricName: "HTTPCode_Target_5XX_Count",
+					Dimensions: targetGroupDimensions,
+					Reason:     "Threshold Crossed",
+					UpdatedAt:  updatedAt,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockcloudwatchClient(ctrl)
+			tc.mockClient(mockClient)
+			cw := &CloudWatch{client: mockClient}
+
+			// WHEN
+			alarms, err := cw.AlarmsWithDimensions(serviceDimensions, targetGroupDimensions)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAlarms, alarms)
+		})
+	}
+}
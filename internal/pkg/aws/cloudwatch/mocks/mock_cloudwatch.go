@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/aws/cloudwatch/cloudwatch.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	cloudwatch "github.com/aws/aws-sdk-go/service/cloudwatch"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockcloudwatchClient is a mock of cloudwatchClient interface
+type MockcloudwatchClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockcloudwatchClientMockRecorder
+}
+
+// MockcloudwatchClientMockRecorder is the mock recorder for MockcloudwatchClient
+type MockcloudwatchClientMockRecorder struct {
+	mock *MockcloudwatchClient
+}
+
+// NewMockcloudwatchClient creates a new mock instance
+func NewMockcloudwatchClient(ctrl *gomock.Controller) *MockcloudwatchClient {
+	mock := &MockcloudwatchClient{ctrl: ctrl}
+	mock.recorder = &MockcloudwatchClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockcloudwatchClient) EXPECT() *MockcloudwatchClientMockRecorder {
+	return m.recorder
+}
+
+// DescribeAlarms mocks base method
+func (m *MockcloudwatchClient) DescribeAlarms(input *cloudwatch.DescribeAlarmsInput) (*cloudwatch.DescribeAlarmsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAlarms", input)
+	ret0, _ := ret[0].(*cloudwatch.DescribeAlarmsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAlarms indicates an expected call of DescribeAlarms
+func (mr *MockcloudwatchClientMockRecorder) DescribeAlarms(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAlarms", reflect.TypeOf((*MockcloudwatchClient)(nil).DescribeAlarms), input)
+}
@@ -5,6 +5,7 @@
 package cloudwatchlogs
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
@@ -19,21 +20,36 @@ import (
 const (
 	// SleepDuration is the sleep time for making the next request for log events.
 	SleepDuration = 1 * time.Second
+	// MaxSleepDuration is the longest sleep time in between two requests for log events when they are throttled.
+	MaxSleepDuration = 30 * time.Second
+
+	errCodeThrottling = "ThrottlingException"
+
+	// filterLogEventsPageSize is the maximum number of events FilterLogEvents returns in a single call.
+	filterLogEventsPageSize = 10000
 )
 
 var (
 	fatalCodes   = []string{"FATA", "FATAL", "fatal", "ERR", "ERROR", "error"}
 	warningCodes = []string{"WARN", "warn", "WARNING", "warning"}
+
+	// filterWindows are the time windows searched one after the other, going back in time, for the latest log events
+	// when no start time is given. Log events older than the last window are not searched.
+	filterWindows = []time.Duration{time.Hour, 24 * time.Hour, 7 * 24 * time.Hour, 30 * 24 * time.Hour}
 )
 
 type cloudwatchlogsClient interface {
 	DescribeLogStreams(input *cloudwatchlogs.DescribeLogStreamsInput) (*cloudwatchlogs.DescribeLogStreamsOutput, error)
 	GetLogEvents(input *cloudwatchlogs.GetLogEventsInput) (*cloudwatchlogs.GetLogEventsOutput, error)
+	FilterLogEvents(input *cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error)
+	StartQuery(input *cloudwatchlogs.StartQueryInput) (*cloudwatchlogs.StartQueryOutput, error)
+	GetQueryResults(input *cloudwatchlogs.GetQueryResultsInput) (*cloudwatchlogs.GetQueryResultsOutput, error)
 }
 
 // Service wraps an AWS Cloudwatch Logs client.
 type Service struct {
 	cwlogs cloudwatchlogsClient
+	now    func() time.Time
 }
 
 // GetLogEventsOpts sets up optional parameters for LogEvents function.
@@ -60,6 +76,51 @@ func WithEndTime(endTime int64) GetLogEventsOpts {
 	}
 }
 
+// FilterLogEventsOpts sets up optional parameters for FilterTaskLogEvents function.
+type FilterLogEventsOpts func(*cloudwatchlogs.FilterLogEventsInput)
+
+// WithFilterPattern sets up the CloudWatch Logs filter pattern for FilterLogEventsInput
+func WithFilterPattern(pattern string) FilterLogEventsOpts {
+	return func(in *cloudwatchlogs.FilterLogEventsInput) {
+		in.FilterPattern = aws.String(pattern)
+	}
+}
+
+// WithLogStreamNames sets up the names of the log streams to search in for FilterLogEventsInput
+func WithLogStreamNames(names []string) FilterLogEventsOpts {
+	return func(in *cloudwatchlogs.FilterLogEventsInput) {
+		in.LogStreamNames = aws.StringSlice(names)
+	}
+}
+
+// WithLogStreamNamePrefix sets up the prefix of the log streams to search in for FilterLogEventsInput
+func WithLogStreamNamePrefix(prefix string) FilterLogEventsOpts {
+	return func(in *cloudwatchlogs.FilterLogEventsInput) {
+		in.LogStreamNamePrefix = aws.String(prefix)
+	}
+}
+
+// WithFilterLimit sets up limit for FilterLogEventsInput
+func WithFilterLimit(limit int) FilterLogEventsOpts {
+	return func(in *cloudwatchlogs.FilterLogEventsInput) {
+		in.Limit = aws.Int64(int64(limit))
+	}
+}
+
+// WithFilterStartTime sets up startTime for FilterLogEventsInput
+func WithFilterStartTime(startTime int64) FilterLogEventsOpts {
+	return func(in *cloudwatchlogs.FilterLogEventsInput) {
+		in.StartTime = aws.Int64(startTime)
+	}
+}
+
+// WithFilterEndTime sets up endTime for FilterLogEventsInput
+func WithFilterEndTime(endTime int64) FilterLogEventsOpts {
+	return func(in *cloudwatchlogs.FilterLogEventsInput) {
+		in.EndTime = aws.Int64(endTime)
+	}
+}
+
 // LogEventsOutput contains the output for LogEvents
 type LogEventsOutput struct {
 	// Retrieved log events.
@@ -68,10 +129,35 @@ type LogEventsOutput struct {
 	NextTokens map[string]*string
 }
 
+// FilteredLogEventsOutput contains the output for FilterTaskLogEvents.
+type FilteredLogEventsOutput struct {
+	// Retrieved log events.
+	Events []*Event
+	// Position of the latest retrieved event, pass it to the next call to only retrieve newer events.
+	Cursor *LogEventsCursor
+}
+
+// LogEventsCursor marks the latest log event retrieved from a log group.
+type LogEventsCursor struct {
+	// Timestamp of the latest retrieved event.
+	Timestamp int64
+	// IDs of the retrieved events at Timestamp, used to skip them when searching again from Timestamp.
+	EventIDs map[string]bool
+}
+
+// LogEventsPage contains a single page of the log events matching a filter.
+type LogEventsPage struct {
+	// Retrieved log events.
+	Events []*Event
+	// Token to retrieve the next page, empty if there are no more pages.
+	NextToken string
+}
+
 // New returns a Service configured against the input session.
 func New(s *session.Session) *Service {
 	return &Service{
 		cwlogs: cloudwatchlogs.New(s),
+		now:    time.Now,
 	}
 }
 
@@ -149,6 +235,199 @@ func (s *Service) TaskLogEvents(logGroupName string, streamTokens map[string]*st
 	}, nil
 }
 
+// FilterTaskLogEvents returns the latest Cloudwatch Logs events matching the options across all the log streams of a log group.
+// If a cursor is passed in, all the events newer than the cursor are returned so that no event is dropped while following logs.
+// Without a cursor or a start time, the log group is searched in widening time windows until enough events are found.
+func (s *Service) FilterTaskLogEvents(logGroupName string, cursor *LogEventsCursor, opts ...FilterLogEventsOpts) (*FilteredLogEventsOutput, error) {
+	in := &cloudwatchlogs.FilterLogEventsInput{
+		LogGroupName: aws.String(logGroupName),
+		Limit:        aws.Int64(10), // default to be 10
+	}
+	for _, opt := range opts {
+		opt(in)
+	}
+	// The limit is the number of events to return, the events are always requested in pages as large as possible.
+	limit := int(*in.Limit)
+	in.Limit = aws.Int64(filterLogEventsPageSize)
+
+	if cursor != nil || in.StartTime != nil {
+		if cursor != nil {
+			if cursor.Timestamp > aws.Int64Value(in.StartTime) {
+				in.StartTime = aws.Int64(cursor.Timestamp)
+			}
+			// Never drop the new events while following.
+			limit = 0
+		}
+		events, err := s.filterLogEvents(in, cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+		return &FilteredLogEventsOutput{
+			Events: events,
+			Cursor: nextCursor(cursor, aws.Int64Value(in.StartTime), events),
+		}, nil
+	}
+
+	end := s.now().UnixNano() / int64(time.Millisecond)
+	if in.EndTime != nil {
+		end = *in.EndTime
+	}
+	var events []*Event
+	var startTime int64
+	for _, window := range filterWindows {
+		startTime = end - window.Milliseconds()
+		in.StartTime = aws.Int64(startTime)
+		in.NextToken = nil
+		windowEvents, err := s.filterLogEvents(in, nil, limit)
+		if err != nil {
+			return nil, err
+		}
+		// The events of a window are older than the ones of the previous windows.
+		events = append(windowEvents, events...)
+		if len(events) >= limit {
+			events = events[len(events)-limit:]
+			break
+		}
+		// Don't search the events at the start of this window again.
+		in.EndTime = aws.Int64(startTime - 1)
+	}
+	if len(events) == 0 {
+		// Nothing matched up to the end of the search, so there is no need to search these windows again.
+		startTime = end
+	}
+	return &FilteredLogEventsOutput{
+		Events: events,
+		Cursor: nextCursor(nil, startTime, events),
+	}, nil
+}
+
+// filterLogEvents returns the events of all the pages matching the input in timestamp order, skipping the events
+// already returned at the cursor. If limit is positive, only the latest limit events are kept.
+func (s *Service) filterLogEvents(in *cloudwatchlogs.FilterLogEventsInput, cursor *LogEventsCursor, limit int) ([]*Event, error) {
+	var events []*Event
+	for {
+		resp, err := s.cwlogs.FilterLogEvents(in)
+		if err != nil {
+			return nil, fmt.Errorf("filter log events of log group %s: %w", aws.StringValue(in.LogGroupName), err)
+		}
+		for _, event := range resp.Events {
+			if cursor != nil && aws.Int64Value(event.Timestamp) == cursor.Timestamp && cursor.EventIDs[aws.StringValue(event.EventId)] {
+				continue
+			}
+			e, err := toEvent(event)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, e)
+		}
+		// Events are returned in pages across all the matching log streams, keep the latest ones only.
+		if limit > 0 && len(events) > limit {
+			sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp < events[j].Timestamp })
+			events = events[len(events)-limit:]
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		in.NextToken = resp.NextToken
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp < events[j].Timestamp })
+	return events, nil
+}
+
+// FilterTaskLogEventsPage returns the page of log events of a log group starting at the pagination token,
+// or the first page if the token is empty. Unlike FilterTaskLogEvents, it lets the caller save the token
+// to resume reading the log events later.
+func (s *Service) FilterTaskLogEventsPage(logGroupName, token string, opts ...FilterLogEventsOpts) (*LogEventsPage, error) {
+	in := &cloudwatchlogs.FilterLogEventsInput{
+		LogGroupName: aws.String(logGroupName),
+	}
+	for _, opt := range opts {
+		opt(in)
+	}
+	if token != "" {
+		in.NextToken = aws.String(token)
+	}
+	resp, err := s.cwlogs.FilterLogEvents(in)
+	if err != nil {
+		return nil, fmt.Errorf("filter log events of log group %s: %w", logGroupName, err)
+	}
+	page := &LogEventsPage{
+		NextToken: aws.StringValue(resp.NextToken),
+	}
+	for _, event := range resp.Events {
+		e, err := toEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		page.Events = append(page.Events, e)
+	}
+	return page, nil
+}
+
+func toEvent(event *cloudwatchlogs.FilteredLogEvent) (*Event, error) {
+	taskID, err := parseTaskID(aws.StringValue(event.LogStreamName))
+	if err != nil {
+		return nil, err
+	}
+	return &Event{
+		TaskID:        taskID,
+		IngestionTime: aws.Int64Value(event.IngestionTime),
+		Message:       aws.StringValue(event.Message),
+		Timestamp:     aws.Int64Value(event.Timestamp),
+		eventID:       aws.StringValue(event.EventId),
+	}, nil
+}
+
+// IsThrottled returns true if the error is caused by a throttled request to Cloudwatch Logs.
+func IsThrottled(err error) bool {
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) {
+		return false
+	}
+	return aerr.Code() == errCodeThrottling
+}
+
+// Backoff computes how long to wait in between two requests for log events.
+type Backoff struct {
+	wait time.Duration
+}
+
+// Next returns SleepDuration after a successful request, and doubles the previous duration up to MaxSleepDuration
+// after each throttled request.
+func (b *Backoff) Next(throttled bool) time.Duration {
+	if !throttled || b.wait == 0 {
+		b.wait = SleepDuration
+		return b.wait
+	}
+	b.wait *= 2
+	if b.wait > MaxSleepDuration {
+		b.wait = MaxSleepDuration
+	}
+	return b.wait
+}
+
+func nextCursor(prev *LogEventsCursor, startTime int64, events []*Event) *LogEventsCursor {
+	next := &LogEventsCursor{
+		Timestamp: startTime,
+		EventIDs:  make(map[string]bool),
+	}
+	if prev != nil && prev.Timestamp == startTime {
+		for id := range prev.EventIDs {
+			next.EventIDs[id] = true
+		}
+	}
+	for _, event := range events {
+		if event.Timestamp > next.Timestamp {
+			next.Timestamp = event.Timestamp
+			next.EventIDs = make(map[string]bool)
+		}
+		if event.Timestamp == next.Timestamp {
+			next.EventIDs[event.eventID] = true
+		}
+	}
+	return next
+}
+
 // LogGroupExists returns if a log group exists.
 func (s *Service) LogGroupExists(logGroupName string) (bool, error) {
 	_, err := s.cwlogs.DescribeLogStreams(&cloudwatchlogs.DescribeLogStreamsInput{
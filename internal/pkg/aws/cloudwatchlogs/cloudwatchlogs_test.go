@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/cloudwatchlogs/mocks"
 	"github.com/aws/aws-sdk-go/aws"
@@ -244,6 +245,423 @@ func TestLogEvents(t *testing.T) {
 	}
 }
 
+func TestFilterTaskLogEvents(t *testing.T) {
+	mockError := errors.New("some error")
+	mockNow := time.Unix(1600000000, 0)
+	mockEnd := int64(1600000000000)
+	testCases := map[string]struct {
+		cursor                   *LogEventsCursor
+		opts                     []FilterLogEventsOpts
+		mockcloudwatchlogsClient func(m *mocks.MockcloudwatchlogsClient)
+
+		wantLogEvents []*Event
+		wantCursor    *LogEventsCursor
+		wantErr       error
+	}{
+		"should paginate across log streams and return the latest events": {
+			opts: []FilterLogEventsOpts{WithFilterLimit(2), WithFilterPattern("ERROR"), WithLogStreamNamePrefix("ecs/mockApp")},
+			mockcloudwatchlogsClient: func(m *mocks.MockcloudwatchlogsClient) {
+				m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName:        aws.String("mockLogGroup"),
+					Limit:               aws.Int64(10000),
+					FilterPattern:       aws.String("ERROR"),
+					LogStreamNamePrefix: aws.String("ecs/mockApp"),
+					StartTime:           aws.Int64(mockEnd - time.Hour.Milliseconds()),
+				}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+					Events: []*cloudwatchlogs.FilteredLogEvent{
+						{
+							EventId:       aws.String("1"),
+							LogStreamName: aws.String("ecs/mockApp/task1"),
+							Message:       aws.String("ERROR 1"),
+							Timestamp:     aws.Int64(mockEnd - 3),
+						},
+						{
+							EventId:       aws.String("2"),
+							LogStreamName: aws.String("ecs/mockApp/task2"),
+							Message:       aws.String("ERROR 2"),
+							Timestamp:     aws.Int64(mockEnd - 2),
+						},
+					},
+					NextToken: aws.String("mockNextToken"),
+				}, nil)
+				m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName:        aws.String("mockLogGroup"),
+					Limit:               aws.Int64(10000),
+					FilterPattern:       aws.String("ERROR"),
+					LogStreamNamePrefix: aws.String("ecs/mockApp"),
+					StartTime:           aws.Int64(mockEnd - time.Hour.Milliseconds()),
+					NextToken:           aws.String("mockNextToken"),
+				}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+					Events: []*cloudwatchlogs.FilteredLogEvent{
+						{
+							EventId:       aws.String("3"),
+							LogStreamName: aws.String("ecs/mockApp/task1"),
+							Message:       aws.String("ERROR 3"),
+							Timestamp:     aws.Int64(mockEnd - 1),
+						},
+					},
+				}, nil)
+			},
+
+			wantLogEvents: []*Event{
+				{
+					TaskID:    "task2",
+					Message:   "ERROR 2",
+					Timestamp: mockEnd - 2,
+					eventID:   "2",
+				},
+				{
+					TaskID:    "task1",
+					Message:   "ERROR 3",
+					Timestamp: mockEnd - 1,
+					eventID:   "3",
+				},
+			},
+			wantCursor: &LogEventsCursor{
+				Timestamp: mockEnd - 1,
+				EventIDs:  map[string]bool{"3": true},
+			},
+		},
+		"should search older windows until the limit is satisfied": {
+			opts: []FilterLogEventsOpts{WithFilterLimit(2)},
+			mockcloudwatchlogsClient: func(m *mocks.MockcloudwatchlogsClient) {
+				gomock.InOrder(
+					m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+						LogGroupName: aws.String("mockLogGroup"),
+						Limit:        aws.Int64(10000),
+						StartTime:    aws.Int64(mockEnd - time.Hour.Milliseconds()),
+					}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+						Events: []*cloudwatchlogs.FilteredLogEvent{
+							{
+								EventId:       aws.String("3"),
+								LogStreamName: aws.String("ecs/mockApp/task1"),
+								Message:       aws.String("ERROR 3"),
+								Timestamp:     aws.Int64(mockEnd - 1),
+							},
+						},
+					}, nil),
+					m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+						LogGroupName: aws.String("mockLogGroup"),
+						Limit:        aws.Int64(10000),
+						StartTime:    aws.Int64(mockEnd - (24 * time.Hour).Milliseconds()),
+						EndTime:      aws.Int64(mockEnd - time.Hour.Milliseconds() - 1),
+					}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+						Events: []*cloudwatchlogs.FilteredLogEvent{
+							{
+								EventId:       aws.String("1"),
+								LogStreamName: aws.String("ecs/mockApp/task1"),
+								Message:       aws.String("ERROR 1"),
+								Timestamp:     aws.Int64(mockEnd - (2 * time.Hour).Milliseconds()),
+							},
+							{
+								EventId:       aws.String("2"),
+								LogStreamName: aws.String("ecs/mockApp/task2"),
+								Message:       aws.String("ERROR 2"),
+								Timestamp:     aws.Int64(mockEnd - (2 * time.Hour).Milliseconds() + 1),
+							},
+						},
+					}, nil),
+				)
+			},
+
+			wantLogEvents: []*Event{
+				{
+					TaskID:    "task2",
+					Message:   "ERROR 2",
+					Timestamp: mockEnd - (2 * time.Hour).Milliseconds() + 1,
+					eventID:   "2",
+				},
+				{
+					TaskID:    "task1",
+					Message:   "ERROR 3",
+					Timestamp: mockEnd - 1,
+					eventID:   "3",
+				},
+			},
+			wantCursor: &LogEventsCursor{
+				Timestamp: mockEnd - 1,
+				EventIDs:  map[string]bool{"3": true},
+			},
+		},
+		"should stop searching after the last window and move the cursor to the end of the search": {
+			mockcloudwatchlogsClient: func(m *mocks.MockcloudwatchlogsClient) {
+				m.EXPECT().FilterLogEvents(gomock.Any()).Return(&cloudwatchlogs.FilterLogEventsOutput{}, nil).Times(len(filterWindows))
+			},
+
+			wantCursor: &LogEventsCursor{
+				Timestamp: mockEnd,
+				EventIDs:  map[string]bool{},
+			},
+		},
+		"should only return the events newer than the cursor": {
+			cursor: &LogEventsCursor{
+				Timestamp: 3,
+				EventIDs:  map[string]bool{"3": true},
+			},
+			opts: []FilterLogEventsOpts{WithFilterStartTime(1), WithLogStreamNames([]string{"ecs/mockApp/task1"})},
+			mockcloudwatchlogsClient: func(m *mocks.MockcloudwatchlogsClient) {
+				m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName:   aws.String("mockLogGroup"),
+					Limit:          aws.Int64(10000),
+					StartTime:      aws.Int64(3),
+					LogStreamNames: aws.StringSlice([]string{"ecs/mockApp/task1"}),
+				}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+					Events: []*cloudwatchlogs.FilteredLogEvent{
+						{
+							EventId:       aws.String("3"),
+							LogStreamName: aws.String("ecs/mockApp/task1"),
+							Message:       aws.String("ERROR 3"),
+							Timestamp:     aws.Int64(3),
+						},
+						{
+							EventId:       aws.String("4"),
+							LogStreamName: aws.String("ecs/mockApp/task1"),
+							Message:       aws.String("ERROR 4"),
+							Timestamp:     aws.Int64(3),
+						},
+					},
+				}, nil)
+			},
+
+			wantLogEvents: []*Event{
+				{
+					TaskID:    "task1",
+					Message:   "ERROR 4",
+					Timestamp: 3,
+					eventID:   "4",
+				},
+			},
+			wantCursor: &LogEventsCursor{
+				Timestamp: 3,
+				EventIDs:  map[string]bool{"3": true, "4": true},
+			},
+		},
+		"should not drop new events when following with a cursor": {
+			cursor: &LogEventsCursor{
+				Timestamp: 1,
+				EventIDs:  map[string]bool{"1": true},
+			},
+			opts: []FilterLogEventsOpts{WithFilterLimit(1)},
+			mockcloudwatchlogsClient: func(m *mocks.MockcloudwatchlogsClient) {
+				m.EXPECT().FilterLogEvents(gomock.Any()).Return(&cloudwatchlogs.FilterLogEventsOutput{
+					Events: []*cloudwatchlogs.FilteredLogEvent{
+						{
+							EventId:       aws.String("2"),
+							LogStreamName: aws.String("ecs/mockApp/task1"),
+							Message:       aws.String("ERROR 2"),
+							Timestamp:     aws.Int64(2),
+						},
+						{
+							EventId:       aws.String("3"),
+							LogStreamName: aws.String("ecs/mockApp/task2"),
+							Message:       aws.String("ERROR 3"),
+							Timestamp:     aws.Int64(3),
+						},
+					},
+				}, nil)
+			},
+
+			wantLogEvents: []*Event{
+				{
+					TaskID:    "task1",
+					Message:   "ERROR 2",
+					Timestamp: 2,
+					eventID:   "2",
+				},
+				{
+					TaskID:    "task2",
+					Message:   "ERROR 3",
+					Timestamp: 3,
+					eventID:   "3",
+				},
+			},
+			wantCursor: &LogEventsCursor{
+				Timestamp: 3,
+				EventIDs:  map[string]bool{"3": true},
+			},
+		},
+		"should keep the cursor if there are no new events": {
+			cursor: &LogEventsCursor{
+				Timestamp: 3,
+				EventIDs:  map[string]bool{"3": true},
+			},
+			mockcloudwatchlogsClient: func(m *mocks.MockcloudwatchlogsClient) {
+				m.EXPECT().FilterLogEvents(gomock.Any()).Return(&cloudwatchlogs.FilterLogEventsOutput{}, nil)
+			},
+
+			wantCursor: &LogEventsCursor{
+				Timestamp: 3,
+				EventIDs:  map[string]bool{"3": true},
+			},
+		},
+		"returns error if fail to filter log events": {
+			mockcloudwatchlogsClient: func(m *mocks.MockcloudwatchlogsClient) {
+				m.EXPECT().FilterLogEvents(gomock.Any()).Return(nil, mockError)
+			},
+
+			wantErr: fmt.Errorf("filter log events of log group %s: %w", "mockLogGroup", mockError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcloudwatchlogsClient := mocks.NewMockcloudwatchlogsClient(ctrl)
+			tc.mockcloudwatchlogsClient(mockcloudwatchlogsClient)
+
+			service := Service{
+				cwlogs: mockcloudwatchlogsClient,
+				now: func() time.Time {
+					return mockNow
+				},
+			}
+
+			// WHEN
+			got, err := service.FilterTaskLogEvents("mockLogGroup", tc.cursor, tc.opts...)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantLogEvents, got.Events)
+				require.Equal(t, tc.wantCursor, got.Cursor)
+			}
+		})
+	}
+}
+
+func TestFilterTaskLogEventsPage(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		token                    string
+		mockcloudwatchlogsClient func(m *mocks.MockcloudwatchlogsClient)
+
+		wantPage *LogEventsPage
+		wantErr  error
+	}{
+		"should return the first page with the token of the next one": {
+			mockcloudwatchlogsClient: func(m *mocks.MockcloudwatchlogsClient) {
+				m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName: aws.String("mockLogGroup"),
+					StartTime:    aws.Int64(1),
+					EndTime:      aws.Int64(5),
+				}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+					Events: []*cloudwatchlogs.FilteredLogEvent{
+						{
+							EventId:       aws.String("1"),
+							LogStreamName: aws.String("ecs/mockApp/task1"),
+							Message:       aws.String("some log"),
+							Timestamp:     aws.Int64(2),
+						},
+					},
+					NextToken: aws.String("mockNextToken"),
+				}, nil)
+			},
+
+			wantPage: &LogEventsPage{
+				Events: []*Event{
+					{
+						TaskID:    "task1",
+						Message:   "some log",
+						Timestamp: 2,
+						eventID:   "1",
+					},
+				},
+				NextToken: "mockNextToken",
+			},
+		},
+		"should resume from the token": {
+			token: "mockToken",
+			mockcloudwatchlogsClient: func(m *mocks.MockcloudwatchlogsClient) {
+				m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName: aws.String("mockLogGroup"),
+					StartTime:    aws.Int64(1),
+					EndTime:      aws.Int64(5),
+					NextToken:    aws.String("mockToken"),
+				}).Return(&cloudwatchlogs.FilterLogEventsOutput{}, nil)
+			},
+
+			wantPage: &LogEventsPage{},
+		},
+		"returns error if fail to filter log events": {
+			mockcloudwatchlogsClient: func(m *mocks.MockcloudwatchlogsClient) {
+				m.EXPECT().FilterLogEvents(gomock.Any()).Return(nil, mockError)
+			},
+
+			wantErr: fmt.Errorf("filter log events of log group %s: %w", "mockLogGroup", mockError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcloudwatchlogsClient := mocks.NewMockcloudwatchlogsClient(ctrl)
+			tc.mockcloudwatchlogsClient(mockcloudwatchlogsClient)
+
+			service := Service{
+				cwlogs: mockcloudwatchlogsClient,
+			}
+
+			// WHEN
+			got, err := service.FilterTaskLogEventsPage("mockLogGroup", tc.token, WithFilterStartTime(1), WithFilterEndTime(5))
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantPage, got)
+			}
+		})
+	}
+}
+
+func TestIsThrottled(t *testing.T) {
+	testCases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"wrapped throttling error": {
+			err:  fmt.Errorf("filter log events of log group %s: %w", "mockLogGroup", awserr.New("ThrottlingException", "Rate exceeded", nil)),
+			want: true,
+		},
+		"other aws error": {
+			err:  awserr.New(cloudwatchlogs.ErrCodeResourceNotFoundException, "some error", nil),
+			want: false,
+		},
+		"non aws error": {
+			err:  errors.New("some error"),
+			want: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.want, IsThrottled(tc.err))
+		})
+	}
+}
+
+func TestBackoff_Next(t *testing.T) {
+	b := &Backoff{}
+
+	require.Equal(t, SleepDuration, b.Next(false))
+	require.Equal(t, 2*SleepDuration, b.Next(true))
+	require.Equal(t, 4*SleepDuration, b.Next(true))
+	for i := 0; i < 10; i++ {
+		b.Next(true)
+	}
+	require.Equal(t, MaxSleepDuration, b.Next(true))
+	require.Equal(t, SleepDuration, b.Next(false))
+}
+
 func TestLogGroupExists(t *testing.T) {
 	mockError := errors.New("some error")
 	testCases := map[string]struct {
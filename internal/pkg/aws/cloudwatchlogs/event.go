@@ -7,7 +7,6 @@ package cloudwatchlogs
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
 )
@@ -18,10 +17,14 @@ const (
 
 // Event represents a log event.
 type Event struct {
+	AppName       string `json:"appName,omitempty"` // Only set when the logs of several applications are interleaved.
+	EnvName       string `json:"envName,omitempty"` // Only set when the logs of several environments are interleaved.
 	TaskID        string `json:"taskID"`
 	IngestionTime int64  `json:"ingestionTime"`
 	Message       string `json:"message"`
 	Timestamp     int64  `json:"timestamp"`
+
+	eventID string
 }
 
 // JSONString returns the stringified LogEvent struct with json format.
@@ -34,14 +37,17 @@ func (l *Event) JSONString() (string, error) {
 }
 
 // HumanString returns the stringified LogEvent struct with human readable format.
-func (l *Event) HumanString() string {
-	for _, code := range fatalCodes {
-		l.Message = strings.ReplaceAll(l.Message, code, color.Red.Sprint(code))
+// JSON messages are rendered as "time level message key=value" unless the raw message is requested.
+func (l *Event) HumanString(opts ...HumanStringOpts) string {
+	f := &humanFormatter{}
+	for _, opt := range opts {
+		opt(f)
 	}
-	for _, code := range warningCodes {
-		l.Message = strings.ReplaceAll(l.Message, code, color.Yellow.Sprint(code))
+	message := f.format(l.Message)
+	if l.AppName != "" {
+		return fmt.Sprintf("%s %s %s\n", color.Label(l.AppName+"/"+l.EnvName), color.Grey.Sprint(l.shortTaskID()), message)
 	}
-	return fmt.Sprintf("%s %s\n", color.Grey.Sprint(l.shortTaskID()), l.Message)
+	return fmt.Sprintf("%s %s\n", color.Grey.Sprint(l.shortTaskID()), message)
 }
 
 func (l *Event) shortTaskID() string {
@@ -0,0 +1,123 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatchlogs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvent_HumanString(t *testing.T) {
+	testCases := map[string]struct {
+		event *Event
+		opts  []HumanStringOpts
+
+		wanted string
+	}{
+		"keeps a plain text message as is": {
+			event: &Event{
+				TaskID:  "123456789",
+				Message: `10.0.0.00 - - [01/Jan/1970 01:01:01] "FATA some error" - -`,
+			},
+
+			wanted: "1234567 10.0.0.00 - - [01/Jan/1970 01:01:01] \"FATA some error\" - -\n",
+		},
+		"renders a JSON message as time level message and sorted key=value pairs": {
+			event: &Event{
+				TaskID:  "123456789",
+				Message: `{"msg":"request failed","level":"error","time":"2020-04-01T10:00:00Z","status":500,"path":"/api","error":"ERROR in handler"}`,
+			},
+
+			wanted: "1234567 2020-04-01T10:00:00Z ERROR request failed error=\"ERROR in handler\" path=/api status=500\n",
+		},
+		"renders nested values of a JSON message as JSON": {
+			event: &Event{
+				TaskID:  "123456789",
+				Message: `{"message":"ok","request":{"id":"abc"},"tags":["a","b"],"cached":true}`,
+			},
+
+			wanted: "1234567 ok cached=true request={\"id\":\"abc\"} tags=[\"a\",\"b\"]\n",
+		},
+		"renders only the picked fields in order": {
+			event: &Event{
+				TaskID:  "123456789",
+				Message: `{"msg":"request failed","level":"warn","status":500,"path":"/api","latency":12.5}`,
+			},
+			opts: []HumanStringOpts{WithFields([]string{"status", "missing", "latency"})},
+
+			wanted: "1234567 WARN request failed status=500 latency=12.5\n",
+		},
+		"keeps the raw JSON message": {
+			event: &Event{
+				TaskID:  "123456789",
+				Message: `{"msg":"request failed","level":"error"}`,
+			},
+			opts: []HumanStringOpts{WithRawMessage()},
+
+			wanted: "1234567 {\"msg\":\"request failed\",\"level\":\"error\"}\n",
+		},
+		"keeps a JSON object followed by trailing data as is": {
+			event: &Event{
+				TaskID:  "123456789",
+				Message: `{"level":"error"} request failed`,
+			},
+
+			wanted: "1234567 {\"level\":\"error\"} request failed\n",
+		},
+		"keeps a message with several JSON objects as is": {
+			event: &Event{
+				TaskID:  "123456789",
+				Message: `{"msg":"first"} {"msg":"second"}`,
+			},
+
+			wanted: "1234567 {\"msg\":\"first\"} {\"msg\":\"second\"}\n",
+		},
+		"keeps a message that is not a JSON object as is": {
+			event: &Event{
+				TaskID:  "123456789",
+				Message: `{"msg": "truncated`,
+			},
+
+			wanted: "1234567 {\"msg\": \"truncated\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got := tc.event.HumanString(tc.opts...)
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestLevelOf(t *testing.T) {
+	testCases := map[string]struct {
+		text string
+
+		wanted logLevel
+	}{
+		"no level": {
+			text:   "GET /errors 200",
+			wanted: levelNone,
+		},
+		"warning": {
+			text:   "[WARN] disk almost full",
+			wanted: levelWarning,
+		},
+		"fatal wins over warning": {
+			text:   "warning: retrying after error",
+			wanted: levelFatal,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, levelOf(tc.text))
+		})
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatchlogs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+)
+
+// Keys that structured loggers commonly use for the time, the level and the message of a JSON log line.
+var (
+	jsonTimeKeys    = []string{"time", "timestamp", "ts", "@timestamp"}
+	jsonLevelKeys   = []string{"level", "lvl", "severity"}
+	jsonMessageKeys = []string{"msg", "message"}
+)
+
+// HumanStringOpts sets up optional parameters for rendering a log event in human readable format.
+type HumanStringOpts func(*humanFormatter)
+
+// WithFields only renders the given keys of JSON messages, in order, after their time, level and message.
+func WithFields(fields []string) HumanStringOpts {
+	return func(f *humanFormatter) {
+		f.fields = fields
+	}
+}
+
+// WithRawMessage renders the message as it was logged, without parsing nor coloring it.
+func WithRawMessage() HumanStringOpts {
+	return func(f *humanFormatter) {
+		f.raw = true
+	}
+}
+
+type humanFormatter struct {
+	fields []string
+	raw    bool
+}
+
+// format renders a log message. JSON messages are rendered as "time level message key=value",
+// other messages are kept as is and colored after the level found in their words.
+func (f *humanFormatter) format(message string) string {
+	if f.raw {
+		return message
+	}
+	entry, ok := parseJSONMessage(message)
+	if !ok {
+		return colorLevel(levelOf(message), message)
+	}
+
+	var parts []string
+	if ts, ok := popKey(entry, jsonTimeKeys); ok {
+		parts = append(parts, color.Grey.Sprint(valueString(ts)))
+	}
+	if lvl, ok := popKey(entry, jsonLevelKeys); ok {
+		level := strings.ToUpper(valueString(lvl))
+		parts = append(parts, colorLevel(levelOf(level), level))
+	}
+	if msg, ok := popKey(entry, jsonMessageKeys); ok {
+		parts = append(parts, valueString(msg))
+	}
+	for _, key := range f.keys(entry) {
+		parts = append(parts, fmt.Sprintf("%s=%s", color.Grey.Sprint(key), fieldValue(entry[key])))
+	}
+	return strings.Join(parts, " ")
+}
+
+// keys returns the keys to render after the message: the picked fields that exist, or all of them sorted.
+func (f *humanFormatter) keys(entry map[string]interface{}) []string {
+	if len(f.fields) != 0 {
+		var keys []string
+		for _, field := range f.fields {
+			if _, ok := entry[field]; ok {
+				keys = append(keys, field)
+			}
+		}
+		return keys
+	}
+	keys := make([]string, 0, len(entry))
+	for key := range entry {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// parseJSONMessage returns the fields of a message if it is a single JSON object,
+// messages with trailing data after the object are rendered as is.
+func parseJSONMessage(message string) (map[string]interface{}, bool) {
+	trimmed := strings.TrimSpace(message)
+	if !strings.HasPrefix(trimmed, "{") {
+		return nil, false
+	}
+	decoder := json.NewDecoder(strings.NewReader(trimmed))
+	// Keep numbers as written instead of converting them to floats.
+	decoder.UseNumber()
+	var entry map[string]interface{}
+	if err := decoder.Decode(&entry); err != nil {
+		return nil, false
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, false
+	}
+	return entry, true
+}
+
+// popKey removes the first of the keys found in the entry and returns its value.
+func popKey(entry map[string]interface{}, keys []string) (interface{}, bool) {
+	for _, key := range keys {
+		if v, ok := entry[key]; ok {
+			delete(entry, key)
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+func valueString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	}
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return fmt.Sprint(v)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// fieldValue renders the value of a key=value pair. Strings are quoted if they can't be told apart from the next pair.
+func fieldValue(v interface{}) string {
+	s, ok := v.(string)
+	if !ok {
+		return valueString(v)
+	}
+	if s == "" || strings.IndexFunc(s, func(r rune) bool { return unicode.IsSpace(r) || r == '=' || r == '"' }) != -1 {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+type logLevel int
+
+const (
+	levelNone logLevel = iota
+	levelWarning
+	levelFatal
+)
+
+// levelOf returns the most severe level among the words of the text.
+func levelOf(text string) logLevel {
+	level := levelNone
+	words := strings.FieldsFunc(text, func(r rune) bool { return !unicode.IsLetter(r) })
+	for _, word := range words {
+		switch {
+		case contains(fatalCodes, word):
+			return levelFatal
+		case contains(warningCodes, word):
+			level = levelWarning
+		}
+	}
+	return level
+}
+
+func colorLevel(level logLevel, s string) string {
+	switch level {
+	case levelFatal:
+		return color.Red.Sprint(s)
+	case levelWarning:
+		return color.Yellow.Sprint(s)
+	}
+	return s
+}
+
+func contains(codes []string, word string) bool {
+	for _, code := range codes {
+		if code == word {
+			return true
+		}
+	}
+	return false
+}
@@ -62,3 +62,48 @@ func (mr *MockcloudwatchlogsClientMockRecorder) GetLogEvents(input interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogEvents", reflect.TypeOf((*MockcloudwatchlogsClient)(nil).GetLogEvents), input)
 }
+
+// FilterLogEvents mocks base method
+func (m *MockcloudwatchlogsClient) FilterLogEvents(input *cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterLogEvents", input)
+	ret0, _ := ret[0].(*cloudwatchlogs.FilterLogEventsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterLogEvents indicates an expected call of FilterLogEvents
+func (mr *MockcloudwatchlogsClientMockRecorder) FilterLogEvents(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterLogEvents", reflect.TypeOf((*MockcloudwatchlogsClient)(nil).FilterLogEvents), input)
+}
+
+// StartQuery mocks base method
+func (m *MockcloudwatchlogsClient) StartQuery(input *cloudwatchlogs.StartQueryInput) (*cloudwatchlogs.StartQueryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartQuery", input)
+	ret0, _ := ret[0].(*cloudwatchlogs.StartQueryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartQuery indicates an expected call of StartQuery
+func (mr *MockcloudwatchlogsClientMockRecorder) StartQuery(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartQuery", reflect.TypeOf((*MockcloudwatchlogsClient)(nil).StartQuery), input)
+}
+
+// GetQueryResults mocks base method
+func (m *MockcloudwatchlogsClient) GetQueryResults(input *cloudwatchlogs.GetQueryResultsInput) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueryResults", input)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetQueryResultsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueryResults indicates an expected call of GetQueryResults
+func (mr *MockcloudwatchlogsClientMockRecorder) GetQueryResults(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryResults", reflect.TypeOf((*MockcloudwatchlogsClient)(nil).GetQueryResults), input)
+}
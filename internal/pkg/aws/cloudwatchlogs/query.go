@@ -0,0 +1,142 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatchlogs
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+)
+
+const (
+	// Display settings of the query results table.
+	minCellWidth     = 10  // minimum number of characters in a table's cell.
+	tabWidth         = 4   // number of characters in between columns.
+	cellPaddingWidth = 2   // number of padding characters added by default to a cell.
+	paddingChar      = ' ' // character in between columns.
+
+	// queryPointerField is the field of a result pointing to the log event, it is not meant to be displayed.
+	queryPointerField = "@ptr"
+)
+
+// QueryResults contains the status and the results of a Logs Insights query.
+type QueryResults struct {
+	// Status of the query: Scheduled, Running, Complete, Failed, Cancelled or Timeout.
+	Status string
+	// Names of the fields returned by the query, in the order they first appear in the results.
+	Fields []string
+	// Values of the fields for each result, in the same order as Fields.
+	Rows [][]string
+}
+
+// StartQuery starts a Logs Insights query against a log group and returns the ID of the query.
+// The start and end times are in milliseconds like the other log event functions.
+func (s *Service) StartQuery(logGroupName, query string, startTime, endTime int64, limit int) (string, error) {
+	resp, err := s.cwlogs.StartQuery(&cloudwatchlogs.StartQueryInput{
+		LogGroupName: aws.String(logGroupName),
+		QueryString:  aws.String(query),
+		StartTime:    aws.Int64(startTime / 1000),
+		EndTime:      aws.Int64(endTime / 1000),
+		Limit:        aws.Int64(int64(limit)),
+	})
+	if err != nil {
+		return "", fmt.Errorf("start query against log group %s: %w", logGroupName, err)
+	}
+	return aws.StringValue(resp.QueryId), nil
+}
+
+// QueryResults returns the status of a Logs Insights query and the results it found so far.
+func (s *Service) QueryResults(queryID string) (*QueryResults, error) {
+	resp, err := s.cwlogs.GetQueryResults(&cloudwatchlogs.GetQueryResultsInput{
+		QueryId: aws.String(queryID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get results of query %s: %w", queryID, err)
+	}
+	results := &QueryResults{
+		Status: aws.StringValue(resp.Status),
+	}
+	index := make(map[string]int)
+	for _, result := range resp.Results {
+		for _, field := range result {
+			name := aws.StringValue(field.Field)
+			if _, ok := index[name]; ok || name == queryPointerField {
+				continue
+			}
+			index[name] = len(results.Fields)
+			results.Fields = append(results.Fields, name)
+		}
+	}
+	for _, result := range resp.Results {
+		row := make([]string, len(results.Fields))
+		for _, field := range result {
+			if i, ok := index[aws.StringValue(field.Field)]; ok {
+				row[i] = aws.StringValue(field.Value)
+			}
+		}
+		results.Rows = append(results.Rows, row)
+	}
+	return results, nil
+}
+
+// Done returns true if the query is not scheduled or running anymore.
+func (r *QueryResults) Done() bool {
+	return r.Status != cloudwatchlogs.QueryStatusScheduled && r.Status != cloudwatchlogs.QueryStatusRunning
+}
+
+// Complete returns true if the query finished successfully.
+func (r *QueryResults) Complete() bool {
+	return r.Status == cloudwatchlogs.QueryStatusComplete
+}
+
+// HumanString returns the query results as a table.
+func (r *QueryResults) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, 0)
+	fmt.Fprintf(writer, "%s\n", strings.Join(r.Fields, "\t"))
+	for _, row := range r.Rows {
+		fmt.Fprintf(writer, "%s\n", strings.Join(row, "\t"))
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// CSVString returns the query results in CSV format with a header row.
+func (r *QueryResults) CSVString() (string, error) {
+	var b bytes.Buffer
+	writer := csv.NewWriter(&b)
+	if err := writer.Write(r.Fields); err != nil {
+		return "", fmt.Errorf("write query results header: %w", err)
+	}
+	if err := writer.WriteAll(r.Rows); err != nil {
+		return "", fmt.Errorf("write query results: %w", err)
+	}
+	return b.String(), nil
+}
+
+// JSONString returns the query results as a JSON array of objects keyed by field.
+func (r *QueryResults) JSONString() (string, error) {
+	results := make([]map[string]string, 0, len(r.Rows))
+	for _, row := range r.Rows {
+		result := make(map[string]string)
+		for i, field := range r.Fields {
+			if row[i] == "" {
+				continue
+			}
+			result[field] = row[i]
+		}
+		results = append(results, result)
+	}
+	b, err := json.Marshal(results)
+	if err != nil {
+		return "", fmt.Errorf("marshal query results: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
@@ -0,0 +1,176 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatchlogs
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/cloudwatchlogs/mocks"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartQuery(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		mockcloudwatchlogsClient func(m *mocks.MockcloudwatchlogsClient)
+
+		wantQueryID string
+		wantErr     error
+	}{
+		"should start the query with times in seconds": {
+			mockcloudwatchlogsClient: func(m *mocks.MockcloudwatchlogsClient) {
+				m.EXPECT().StartQuery(&cloudwatchlogs.StartQueryInput{
+					LogGroupName: aws.String("mockLogGroup"),
+					QueryString:  aws.String("stats count(*) by bin(1m)"),
+					StartTime:    aws.Int64(1234),
+					EndTime:      aws.Int64(5678),
+					Limit:        aws.Int64(100),
+				}).Return(&cloudwatchlogs.StartQueryOutput{
+					QueryId: aws.String("mockQueryID"),
+				}, nil)
+			},
+
+			wantQueryID: "mockQueryID",
+		},
+		"returns error if fail to start the query": {
+			mockcloudwatchlogsClient: func(m *mocks.MockcloudwatchlogsClient) {
+				m.EXPECT().StartQuery(gomock.Any()).Return(nil, mockError)
+			},
+
+			wantErr: fmt.Errorf("start query against log group %s: %w", "mockLogGroup", mockError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcloudwatchlogsClient := mocks.NewMockcloudwatchlogsClient(ctrl)
+			tc.mockcloudwatchlogsClient(mockcloudwatchlogsClient)
+
+			service := Service{
+				cwlogs: mockcloudwatchlogsClient,
+			}
+
+			// WHEN
+			got, err := service.StartQuery("mockLogGroup", "stats count(*) by bin(1m)", 1234000, 5678000, 100)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantQueryID, got)
+			}
+		})
+	}
+}
+
+func TestQueryResults(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		mockcloudwatchlogsClient func(m *mocks.MockcloudwatchlogsClient)
+
+		wantResults *QueryResults
+		wantErr     error
+	}{
+		"should align the fields of the results and skip the pointer field": {
+			mockcloudwatchlogsClient: func(m *mocks.MockcloudwatchlogsClient) {
+				m.EXPECT().GetQueryResults(&cloudwatchlogs.GetQueryResultsInput{
+					QueryId: aws.String("mockQueryID"),
+				}).Return(&cloudwatchlogs.GetQueryResultsOutput{
+					Status: aws.String("Complete"),
+					Results: [][]*cloudwatchlogs.ResultField{
+						{
+							{Field: aws.String("@timestamp"), Value: aws.String("2020-04-01 10:00:00.000")},
+							{Field: aws.String("@message"), Value: aws.String("ERROR 1")},
+							{Field: aws.String("@ptr"), Value: aws.String("abc")},
+						},
+						{
+							{Field: aws.String("@timestamp"), Value: aws.String("2020-04-01 10:01:00.000")},
+							{Field: aws.String("status"), Value: aws.String("500")},
+						},
+					},
+				}, nil)
+			},
+
+			wantResults: &QueryResults{
+				Status: "Complete",
+				Fields: []string{"@timestamp", "@message", "status"},
+				Rows: [][]string{
+					{"2020-04-01 10:00:00.000", "ERROR 1", ""},
+					{"2020-04-01 10:01:00.000", "", "500"},
+				},
+			},
+		},
+		"returns error if fail to get the results": {
+			mockcloudwatchlogsClient: func(m *mocks.MockcloudwatchlogsClient) {
+				m.EXPECT().GetQueryResults(gomock.Any()).Return(nil, mockError)
+			},
+
+			wantErr: fmt.Errorf("get results of query %s: %w", "mockQueryID", mockError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcloudwatchlogsClient := mocks.NewMockcloudwatchlogsClient(ctrl)
+			tc.mockcloudwatchlogsClient(mockcloudwatchlogsClient)
+
+			service := Service{
+				cwlogs: mockcloudwatchlogsClient,
+			}
+
+			// WHEN
+			got, err := service.QueryResults("mockQueryID")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantResults, got)
+			}
+		})
+	}
+}
+
+func TestQueryResults_String(t *testing.T) {
+	results := &QueryResults{
+		Status: "Complete",
+		Fields: []string{"bin(1m)", "count(*)"},
+		Rows: [][]string{
+			{"2020-04-01 10:00:00.000", "12"},
+			{"2020-04-01 10:01:00.000", ""},
+		},
+	}
+
+	human := results.HumanString()
+	csv, csvErr := results.CSVString()
+	json, jsonErr := results.JSONString()
+
+	require.Equal(t, "bin(1m)                  count(*)\n2020-04-01 10:00:00.000  12\n2020-04-01 10:01:00.000  \n", human)
+	require.NoError(t, csvErr)
+	require.Equal(t, `bin(1m),count(*)
+2020-04-01 10:00:00.000,12
+2020-04-01 10:01:00.000,
+`, csv)
+	require.NoError(t, jsonErr)
+	require.Equal(t, `[{"bin(1m)":"2020-04-01 10:00:00.000","count(*)":"12"},{"bin(1m)":"2020-04-01 10:01:00.000"}]
+`, json)
+	require.True(t, results.Done())
+	require.True(t, results.Complete())
+	require.False(t, (&QueryResults{Status: "Running"}).Done())
+}
@@ -0,0 +1,247 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package codepipeline contains utility functions for dealing with CodePipeline pipelines.
+package codepipeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+)
+
+// Statuses of a pipeline execution that are not final.
+const (
+	ExecutionStatusInProgress = codepipeline.PipelineExecutionStatusInProgress
+	ExecutionStatusStopping   = codepipeline.PipelineExecutionStatusStopping
+)
+
+// maxExecutions is the number of most recent executions to list, it's enough to find
+// the executions that the stages of the pipeline are currently on.
+const maxExecutions = 25
+
+type codepipelineClient interface {
+	GetPipeline(input *codepipeline.GetPipelineInput) (*codepipeline.GetPipelineOutput, error)
+	GetPipelineState(input *codepipeline.GetPipelineStateInput) (*codepipeline.GetPipelineStateOutput, error)
+	ListPipelineExecutions(input *codepipeline.ListPipelineExecutionsInput) (*codepipeline.ListPipelineExecutionsOutput, error)
+}
+
+// CodePipeline wraps an AWS CodePipeline client.
+type CodePipeline struct {
+	client codepipelineClient
+}
+
+// Pipeline represents the structure of a pipeline.
+type Pipeline struct {
+	Name            string
+	ARN             string
+	Stages          []*Stage
+	ArtifactBuckets []*ArtifactBucket
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Stage represents a stage of a pipeline.
+type Stage struct {
+	Name    string
+	Actions []*Action
+}
+
+// Action represents an action of a stage.
+type Action struct {
+	Name     string
+	Category string
+	Provider string
+	// Region is the region the action runs in, it's empty if the action runs in the region of the pipeline.
+	Region        string
+	Configuration map[string]string
+}
+
+// ArtifactBucket represents the S3 bucket that stores the artifacts of a pipeline in a region.
+type ArtifactBucket struct {
+	Region string
+	Name   string
+}
+
+// PipelineState represents the state of each stage of a pipeline.
+type PipelineState struct {
+	PipelineName string
+	StageStates  []*StageState
+	UpdatedAt    time.Time
+}
+
+// StageState represents the state of a stage and of its actions.
+type StageState struct {
+	StageName string
+	// ExecutionID is the ID of the pipeline execution that the stage is on.
+	ExecutionID  string
+	Status       string
+	ActionStates []*ActionState
+}
+
+// ActionState represents the state of an action of a stage.
+type ActionState struct {
+	ActionName       string
+	Status           string
+	LastStatusChange time.Time
+}
+
+// PipelineExecution represents a run of a pipeline.
+type PipelineExecution struct {
+	ExecutionID     string
+	Status          string
+	StartTime       time.Time
+	LastUpdateTime  time.Time
+	SourceRevisions []*SourceRevision
+}
+
+// SourceRevision represents the revision of the source code a pipeline execution was triggered with.
+type SourceRevision struct {
+	ActionName string
+	RevisionID string
+	// Summary is the commit message of the revision.
+	Summary     string
+	RevisionURL string
+}
+
+// New returns a CodePipeline client configured against the input session.
+func New(s *session.Session) *CodePipeline {
+	return &CodePipeline{
+		client: codepipeline.New(s),
+	}
+}
+
+// GetPipeline returns the stages, actions and artifact buckets of the pipeline.
+func (c *CodePipeline) GetPipeline(name string) (*Pipeline, error) {
+	out, err := c.client.GetPipeline(&codepipeline.GetPipelineInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get pipeline %s: %w", name, err)
+	}
+	pipeline := &Pipeline{
+		Name: aws.StringValue(out.Pipeline.Name),
+	}
+	if out.Metadata != nil {
+		pipeline.ARN = aws.StringValue(out.Metadata.PipelineArn)
+		pipeline.CreatedAt = aws.TimeValue(out.Metadata.Created)
+		pipeline.UpdatedAt = aws.TimeValue(out.Metadata.Updated)
+	}
+	for _, stage := range out.Pipeline.Stages {
+		s := &Stage{
+			Name: aws.StringValue(stage.Name),
+		}
+		for _, action := range stage.Actions {
+			a := &Action{
+				Name:          aws.StringValue(action.Name),
+				Region:        aws.StringValue(action.Region),
+				Configuration: aws.StringValueMap(action.Configuration),
+			}
+			if action.ActionTypeId != nil {
+				a.Category = aws.StringValue(action.ActionTypeId.Category)
+				a.Provider = aws.StringValue(action.ActionTypeId.Provider)
+			}
+			s.Actions = append(s.Actions, a)
+		}
+		pipeline.Stages = append(pipeline.Stages, s)
+	}
+	// Pipelines with cross-region actions have an artifact store per region instead of a single one.
+	if out.Pipeline.ArtifactStore != nil {
+		pipeline.ArtifactBuckets = append(pipeline.ArtifactBuckets, &ArtifactBucket{
+			Name: aws.StringValue(out.Pipeline.ArtifactStore.Location),
+		})
+	}
+	for region, store := range out.Pipeline.ArtifactStores {
+		pipeline.ArtifactBuckets = append(pipeline.ArtifactBuckets, &ArtifactBucket{
+			Region: region,
+			Name:   aws.StringValue(store.Location),
+		})
+	}
+	sort.SliceStable(pipeline.ArtifactBuckets, func(i, j int) bool {
+		return pipeline.ArtifactBuckets[i].Region < pipeline.ArtifactBuckets[j].Region
+	})
+	return pipeline, nil
+}
+
+// GetPipelineState returns the state of the stages and actions of the pipeline.
+func (c *CodePipeline) GetPipelineState(name string) (*PipelineState, error) {
+	out, err := c.client.GetPipelineState(&codepipeline.GetPipelineStateInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get state of pipeline %s: %w", name, err)
+	}
+	state := &PipelineState{
+		PipelineName: aws.StringValue(out.PipelineName),
+		UpdatedAt:    aws.TimeValue(out.Updated),
+	}
+	for _, stage := range out.StageStates {
+		stageState := &StageState{
+			StageName: aws.StringValue(stage.StageName),
+		}
+		if stage.LatestExecution != nil {
+			stageState.ExecutionID = aws.StringValue(stage.LatestExecution.PipelineExecutionId)
+			stageState.Status = aws.StringValue(stage.LatestExecution.Status)
+		}
+		for _, action := range stage.ActionStates {
+			actionState := &ActionState{
+				ActionName: aws.StringValue(action.ActionName),
+			}
+			if action.LatestExecution != nil {
+				actionState.Status = aws.StringValue(action.LatestExecution.Status)
+				actionState.LastStatusChange = aws.TimeValue(action.LatestExecution.LastStatusChange)
+			}
+			stageState.ActionStates = append(stageState.ActionStates, actionState)
+		}
+		state.StageStates = append(state.StageStates, stageState)
+	}
+	return state, nil
+}
+
+// ListPipelineExecutions returns the most recent executions of the pipeline, starting with the latest one.
+func (c *CodePipeline) ListPipelineExecutions(name string) ([]*PipelineExecution, error) {
+	out, err := c.client.ListPipelineExecutions(&codepipeline.ListPipelineExecutionsInput{
+		PipelineName: aws.String(name),
+		MaxResults:   aws.Int64(maxExecutions),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list executions of pipeline %s: %w", name, err)
+	}
+	var executions []*PipelineExecution
+	for _, summary := range out.PipelineExecutionSummaries {
+		execution := &PipelineExecution{
+			ExecutionID:    aws.StringValue(summary.PipelineExecutionId),
+			Status:         aws.StringValue(summary.Status),
+			StartTime:      aws.TimeValue(summary.StartTime),
+			LastUpdateTime: aws.TimeValue(summary.LastUpdateTime),
+		}
+		for _, revision := range summary.SourceRevisions {
+			execution.SourceRevisions = append(execution.SourceRevisions, &SourceRevision{
+				ActionName:  aws.StringValue(revision.ActionName),
+				RevisionID:  aws.StringValue(revision.RevisionId),
+				Summary:     commitMessage(aws.StringValue(revision.RevisionSummary)),
+				RevisionURL: aws.StringValue(revision.RevisionUrl),
+			})
+		}
+		executions = append(executions, execution)
+	}
+	return executions, nil
+}
+
+// commitMessage returns the commit message from the summary of a revision.
+// Sources using a CodeStar connection have a JSON summary, for example:
+// {"ProviderType":"Bitbucket","CommitMessage":"Fix typo"}
+func commitMessage(summary string) string {
+	var connectionSummary struct {
+		CommitMessage string
+	}
+	if err := json.Unmarshal([]byte(summary), &connectionSummary); err != nil || connectionSummary.CommitMessage == "" {
+		return summary
+	}
+	return connectionSummary.CommitMessage
+}
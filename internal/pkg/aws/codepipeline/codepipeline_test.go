@@ -0,0 +1,369 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codepipeline
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/codepipeline/mocks"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodePipeline_GetPipeline(t *testing.T) {
+	mockTime := time.Unix(1588000000, 0)
+	testCases := map[string]struct {
+		mockClient func(m *mocks.MockcodepipelineClient)
+
+		wantedPipeline *Pipeline
+		wantedError    error
+	}{
+		"returns wrapped error": {
+			mockClient: func(m *mocks.MockcodepipelineClient) {
+				m.EXPECT().GetPipeline(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get pipeline phonetool-pipeline: some error"),
+		},
+		"returns the stages and artifact buckets sorted by region": {
+			mockClient: func(m *mocks.MockcodepipelineClient) {
+				m.EXPECT().GetPipeline(&codepipeline.GetPipelineInput{
+					Name: aws.String("phonetool-pipeline"),
+				}).Return(&codepipeline.GetPipelineOutput{
+					Metadata: &codepipeline.PipelineMetadata{
+						PipelineArn: aws.String("arn:aws:codepipeline:us-west-2:1234:phonetool-pipeline"),
+						Created:     aws.Time(mockTime),
+						Updated:     aws.Time(mockTime),
+					},
+					Pipeline: &codepipeline.PipelineDeclaration{
+						Name: aws.String("phonetool-pipeline"),
+						ArtifactStores: map[string]*codepipeline.ArtifactStore{
+							"us-west-2": {
+								Location: aws.String("bucket-west"),
+							},
+							"us-east-1": {
+								Location: aws.String("bucket-east"),
+							},
+						},
+						Stages: []*codepipeline.StageDeclaration{
+							{
+								Name: aws.String("Source"),
+								Actions: []*codepipeline.ActionDeclaration{
+									{
+										Name: aws.String("SourceCodeFor-phonetool"),
+										ActionTypeId: &codepipeline.ActionTypeId{
+											Category: aws.String("Source"),
+											Provider: aws.String("GitHub"),
+										},
+										Configuration: map[string]*string{
+											"Repo": aws.String("phonetool"),
+										},
+									},
+								},
+							},
+							{
+								Name: aws.String("DeployTo-test"),
+								Actions: []*codepipeline.ActionDeclaration{
+									{
+										Name: aws.String("CreateOrUpdate-frontend-test"),
+										ActionTypeId: &codepipeline.ActionTypeId{
+											Category: aws.String("Deploy"),
+											Provider: aws.String("CloudFormation"),
+										},
+										Region: aws.String("us-east-1"),
+									},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedPipeline: &Pipeline{
+				Name:      "phonetool-pipeline",
+				ARN:       "arn:aws:codepipeline:us-west-2:1234:phonetool-pipeline",
+				CreatedAt: mockTime,
+				UpdatedAt: mockTime,
+				Stages: []*Stage{
+					{
+						Name: "Source",
+						Actions: []*Action{
+							{
+								Name:     "SourceCodeFor-phonetool",
+								Category: "Source",
+								Provider: "GitHub",
+								Configuration: map[string]string{
+									"Repo": "phonetool",
+								},
+							},
+						},
+					},
+					{
+						Name: "DeployTo-test",
+						Actions: []*Action{
+							{
+								Name:          "CreateOrUpdate-frontend-test",
+								Category:      "Deploy",
+								Provider:      "CloudFormation",
+								Region:        "us-east-1",
+								Configuration: map[string]string{},
+							},
+						},
+					},
+				},
+				ArtifactBuckets: []*ArtifactBucket{
+					{
+						Region: "us-east-1",
+						Name:   "bucket-east",
+					},
+					{
+						Region: "us-west-2",
+						Name:   "bucket-west",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockcodepipelineClient(ctrl)
+			tc.mockClient(mockClient)
+			cp := &CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			pipeline, err := cp.GetPipeline("phonetool-pipeline")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedPipeline, pipeline)
+			}
+		})
+	}
+}
+
+func TestCodePipeline_GetPipelineState(t *testing.T) {
+	mockTime := time.Unix(1588000000, 0)
+	testCases := map[string]struct {
+		mockClient func(m *mocks.MockcodepipelineClient)
+
+		wantedState *PipelineState
+		wantedError error
+	}{
+		"returns wrapped error": {
+			mockClient: func(m *mocks.MockcodepipelineClient) {
+				m.EXPECT().GetPipelineState(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get state of pipeline phonetool-pipeline: some error"),
+		},
+		"returns the state of the stages and actions": {
+			mockClient: func(m *mocks.MockcodepipelineClient) {
+				m.EXPECT().GetPipelineState(&codepipeline.GetPipelineStateInput{
+					Name: aws.String("phonetool-pipeline"),
+				}).Return(&codepipeline.GetPipelineStateOutput{
+					PipelineName: aws.String("phonetool-pipeline"),
+					Updated:      aws.Time(mockTime),
+					StageStates: []*codepipeline.StageState{
+						{
+							StageName: aws.String("Source"),
+							LatestExecution: &codepipeline.StageExecution{
+								PipelineExecutionId: aws.String("exec-1"),
+								Status:              aws.String("Succeeded"),
+							},
+							ActionStates: []*codepipeline.ActionState{
+								{
+									ActionName: aws.String("SourceCodeFor-phonetool"),
+									LatestExecution: &codepipeline.ActionExecution{
+										Status:           aws.String("Succeeded"),
+										LastStatusChange: aws.Time(mockTime),
+									},
+									CurrentRevision: &codepipeline.ActionRevision{
+										RevisionId: aws.String("abc123"),
+									},
+								},
+							},
+						},
+						{
+							// Stages that never ran don't have an execution.
+							StageName: aws.String("DeployTo-prod"),
+							ActionStates: []*codepipeline.ActionState{
+								{
+									ActionName: aws.String("CreateOrUpdate-frontend-prod"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedState: &PipelineState{
+				PipelineName: "phonetool-pipeline",
+				UpdatedAt:    mockTime,
+				StageStates: []*StageState{
+					{
+						StageName:   "Source",
+						ExecutionID: "exec-1",
+						Status:      "Succeeded",
+						ActionStates: []*ActionState{
+							{
+								ActionName:       "SourceCodeFor-phonetool",
+								Status:           "Succeeded",
+								LastStatusChange: mockTime,
+							},
+						},
+					},
+					{
+						StageName: "DeployTo-prod",
+						ActionStates: []*ActionState{
+							{
+								ActionName: "CreateOrUpdate-frontend-prod",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockcodepipelineClient(ctrl)
+			tc.mockClient(mockClient)
+			cp := &CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			state, err := cp.GetPipelineState("phonetool-pipeline")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedState, state)
+			}
+		})
+	}
+}
+
+func TestCodePipeline_ListPipelineExecutions(t *testing.T) {
+	mockTime := time.Unix(1588000000, 0)
+	testCases := map[string]struct {
+		mockClient func(m *mocks.MockcodepipelineClient)
+
+		wantedExecutions []*PipelineExecution
+		wantedError      error
+	}{
+		"returns wrapped error": {
+			mockClient: func(m *mocks.MockcodepipelineClient) {
+				m.EXPECT().ListPipelineExecutions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list executions of pipeline phonetool-pipeline: some error"),
+		},
+		"returns the executions with their commit messages": {
+			mockClient: func(m *mocks.MockcodepipelineClient) {
+				m.EXPECT().ListPipelineExecutions(&codepipeline.ListPipelineExecutionsInput{
+					PipelineName: aws.String("phonetool-pipeline"),
+					MaxResults:   aws.Int64(25),
+				}).Return(&codepipeline.ListPipelineExecutionsOutput{
+					PipelineExecutionSummaries: []*codepipeline.PipelineExecutionSummary{
+						{
+							PipelineExecutionId: aws.String("exec-2"),
+							Status:              aws.String("InProgress"),
+							StartTime:           aws.Time(mockTime),
+							LastUpdateTime:      aws.Time(mockTime),
+							SourceRevisions: []*codepipeline.SourceRevision{
+								{
+									ActionName:      aws.String("SourceCodeFor-phonetool"),
+									RevisionId:      aws.String("def456"),
+									RevisionSummary: aws.String(`{"ProviderType":"Bitbucket","CommitMessage":"Add tests"}`),
+									RevisionUrl:     aws.String("https://bitbucket.org/badgoose/phonetool/commits/def456"),
+								},
+							},
+						},
+						{
+							PipelineExecutionId: aws.String("exec-1"),
+							Status:              aws.String("Succeeded"),
+							StartTime:           aws.Time(mockTime),
+							LastUpdateTime:      aws.Time(mockTime),
+							SourceRevisions: []*codepipeline.SourceRevision{
+								{
+									ActionName:      aws.String("SourceCodeFor-phonetool"),
+									RevisionId:      aws.String("abc123"),
+									RevisionSummary: aws.String("Fix typo"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedExecutions: []*PipelineExecution{
+				{
+					ExecutionID:    "exec-2",
+					Status:         "InProgress",
+					StartTime:      mockTime,
+					LastUpdateTime: mockTime,
+					SourceRevisions: []*SourceRevision{
+						{
+							ActionName:  "SourceCodeFor-phonetool",
+							RevisionID:  "def456",
+							Summary:     "Add tests",
+							RevisionURL: "https://bitbucket.org/badgoose/phonetool/commits/def456",
+						},
+					},
+				},
+				{
+					ExecutionID:    "exec-1",
+					Status:         "Succeeded",
+					StartTime:      mockTime,
+					LastUpdateTime: mockTime,
+					SourceRevisions: []*SourceRevision{
+						{
+							ActionName: "SourceCodeFor-phonetool",
+							RevisionID: "abc123",
+							Summary:    "Fix typo",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockcodepipelineClient(ctrl)
+			tc.mockClient(mockClient)
+			cp := &CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			executions, err := cp.ListPipelineExecutions("phonetool-pipeline")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedExecutions, executions)
+			}
+		})
+	}
+}
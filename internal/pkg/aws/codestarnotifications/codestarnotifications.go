@@ -0,0 +1,101 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package codestarnotifications contains utility functions for dealing with AWS CodeStar Notifications rules.
+package codestarnotifications
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codestarnotifications"
+)
+
+type codestarnotificationsClient interface {
+	ListNotificationRules(input *codestarnotifications.ListNotificationRulesInput) (*codestarnotifications.ListNotificationRulesOutput, error)
+	DescribeNotificationRule(input *codestarnotifications.DescribeNotificationRuleInput) (*codestarnotifications.DescribeNotificationRuleOutput, error)
+}
+
+// CodeStarNotifications wraps an AWS CodeStar Notifications client.
+type CodeStarNotifications struct {
+	client codestarnotificationsClient
+}
+
+// NotificationRule represents a rule that sends the events of a resource to targets.
+type NotificationRule struct {
+	Name    string
+	Status  string
+	Events  []string
+	Targets []*Target
+}
+
+// Target represents a destination of the notifications of a rule, such as an SNS topic.
+type Target struct {
+	Type    string
+	Address string
+	Status  string
+}
+
+// New returns a CodeStarNotifications client configured against the input session.
+func New(s *session.Session) *CodeStarNotifications {
+	return &CodeStarNotifications{
+		client: codestarnotifications.New(s),
+	}
+}
+
+// NotificationRules returns the notification rules of the resource along with their events and targets.
+func (c *CodeStarNotifications) NotificationRules(resourceARN string) ([]*NotificationRule, error) {
+	var rules []*NotificationRule
+	var nextToken *string
+	for {
+		out, err := c.client.ListNotificationRules(&codestarnotifications.ListNotificationRulesInput{
+			Filters: []*codestarnotifications.ListNotificationRulesFilter{
+				{
+					Name:  aws.String(codestarnotifications.ListNotificationRulesFilterNameResource),
+					Value: aws.String(resourceARN),
+				},
+			},
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list notification rules of %s: %w", resourceARN, err)
+		}
+		for _, summary := range out.NotificationRules {
+			rule, err := c.describeNotificationRule(aws.StringValue(summary.Arn))
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, rule)
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	return rules, nil
+}
+
+func (c *CodeStarNotifications) describeNotificationRule(ruleARN string) (*NotificationRule, error) {
+	out, err := c.client.DescribeNotificationRule(&codestarnotifications.DescribeNotificationRuleInput{
+		Arn: aws.String(ruleARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe notification rule %s: %w", ruleARN, err)
+	}
+	rule := &NotificationRule{
+		Name:   aws.StringValue(out.Name),
+		Status: aws.StringValue(out.Status),
+	}
+	for _, event := range out.EventTypes {
+		rule.Events = append(rule.Events, aws.StringValue(event.EventTypeName))
+	}
+	for _, target := range out.Targets {
+		rule.Targets = append(rule.Targets, &Target{
+			Type:    aws.StringValue(target.TargetType),
+			Address: aws.StringValue(target.TargetAddress),
+			Status:  aws.StringValue(target.TargetStatus),
+		})
+	}
+	return rule, nil
+}
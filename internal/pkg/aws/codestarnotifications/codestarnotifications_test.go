@@ -0,0 +1,134 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codestarnotifications
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/codestarnotifications/mocks"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codestarnotifications"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeStarNotifications_NotificationRules(t *testing.T) {
+	const pipelineARN = "arn:aws:codepipeline:us-west-2:1234:phonetool-pipeline"
+	listInput := func(nextToken *string) *codestarnotifications.ListNotificationRulesInput {
+		return &codestarnotifications.ListNotificationRulesInput{
+			Filters: []*codestarnotifications.ListNotificationRulesFilter{
+				{
+					Name:  aws.String("RESOURCE"),
+					Value: aws.String(pipelineARN),
+				},
+			},
+			NextToken: nextToken,
+		}
+	}
+	testCases := map[string]struct {
+		mockClient func(m *mocks.MockcodestarnotificationsClient)
+
+		wantedRules []*NotificationRule
+		wantedError error
+	}{
+		"returns wrapped error if fail to list the rules": {
+			mockClient: func(m *mocks.MockcodestarnotificationsClient) {
+				m.EXPECT().ListNotificationRules(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list notification rules of arn:aws:codepipeline:us-west-2:1234:phonetool-pipeline: some error"),
+		},
+		"returns wrapped error if fail to describe a rule": {
+			mockClient: func(m *mocks.MockcodestarnotificationsClient) {
+				m.EXPECT().ListNotificationRules(listInput(nil)).Return(&codestarnotifications.ListNotificationRulesOutput{
+					NotificationRules: []*codestarnotifications.NotificationRuleSummary{
+						{Arn: aws.String("arn:rule-1")},
+					},
+				}, nil)
+				m.EXPECT().DescribeNotificationRule(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe notification rule arn:rule-1: some error"),
+		},
+		"returns the events and targets of the rules across pages": {
+			mockClient: func(m *mocks.MockcodestarnotificationsClient) {
+				m.EXPECT().ListNotificationRules(listInput(nil)).Return(&codestarnotifications.ListNotificationRulesOutput{
+					NotificationRules: []*codestarnotifications.NotificationRuleSummary{
+						{Arn: aws.String("arn:rule-1")},
+					},
+					NextToken: aws.String("token"),
+				}, nil)
+				m.EXPECT().ListNotificationRules(listInput(aws.String("token"))).Return(&codestarnotifications.ListNotificationRulesOutput{
+					NotificationRules: []*codestarnotifications.NotificationRuleSummary{
+						{Arn: aws.String("arn:rule-2")},
+					},
+				}, nil)
+				m.EXPECT().DescribeNotificationRule(&codestarnotifications.DescribeNotificationRuleInput{
+					Arn: aws.String("arn:rule-1"),
+				}).Return(&codestarnotifications.DescribeNotificationRuleOutput{
+					Name:   aws.String("phonetool-pipeline-Notifications"),
+					Status: aws.String("ENABLED"),
+					EventTypes: []*codestarnotifications.EventTypeSummary{
+						{EventTypeName: aws.String("Stage execution: Failed")},
+						{EventTypeName: aws.String("Manual approval: Needed")},
+					},
+					Targets: []*codestarnotifications.TargetSummary{
+						{
+							TargetType:    aws.String("SNS"),
+							TargetAddress: aws.String("arn:aws:sns:us-west-2:1234:alerts"),
+							TargetStatus:  aws.String("ACTIVE"),
+						},
+					},
+				}, nil)
+				m.EXPECT().DescribeNotificationRule(&codestarnotifications.DescribeNotificationRuleInput{
+					Arn: aws.String("arn:rule-2"),
+				}).Return(&codestarnotifications.DescribeNotificationRuleOutput{
+					Name:   aws.String("chatbot"),
+					Status: aws.String("DISABLED"),
+				}, nil)
+			},
+			wantedRules: []*NotificationRule{
+				{
+					Name:   "phonetool-pipeline-Notifications",
+					Status: "ENABLED",
+					Events: []string{"Stage execution: Failed", "Manual approval: Needed"},
+					Targets: []*Target{
+						{
+							Type:    "SNS",
+							Address: "arn:aws:sns:us-west-2:1234:alerts",
+							Status:  "ACTIVE",
+						},
+					},
+				},
+				{
+					Name:   "chatbot",
+					Status: "DISABLED",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockcodestarnotificationsClient(ctrl)
+			tc.mockClient(mockClient)
+			c := &CodeStarNotifications{
+				client: mockClient,
+			}
+
+			// WHEN
+			rules, err := c.NotificationRules(pipelineARN)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedRules, rules)
+			}
+		})
+	}
+}
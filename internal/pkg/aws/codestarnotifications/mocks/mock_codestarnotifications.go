@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/aws/codestarnotifications/codestarnotifications.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	codestarnotifications "github.com/aws/aws-sdk-go/service/codestarnotifications"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockcodestarnotificationsClient is a mock of codestarnotificationsClient interface
+type MockcodestarnotificationsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockcodestarnotificationsClientMockRecorder
+}
+
+// MockcodestarnotificationsClientMockRecorder is the mock recorder for MockcodestarnotificationsClient
+type MockcodestarnotificationsClientMockRecorder struct {
+	mock *MockcodestarnotificationsClient
+}
+
+// NewMockcodestarnotificationsClient creates a new mock instance
+func NewMockcodestarnotificationsClient(ctrl *gomock.Controller) *MockcodestarnotificationsClient {
+	mock := &MockcodestarnotificationsClient{ctrl: ctrl}
+	mock.recorder = &MockcodestarnotificationsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockcodestarnotificationsClient) EXPECT() *MockcodestarnotificationsClientMockRecorder {
+	return m.recorder
+}
+
+// ListNotificationRules mocks base method
+func (m *MockcodestarnotificationsClient) ListNotificationRules(input *codestarnotifications.ListNotificationRulesInput) (*codestarnotifications.ListNotificationRulesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationRules", input)
+	ret0, _ := ret[0].(*codestarnotifications.ListNotificationRulesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotificationRules indicates an expected call of ListNotificationRules
+func (mr *MockcodestarnotificationsClientMockRecorder) ListNotificationRules(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationRules", reflect.TypeOf((*MockcodestarnotificationsClient)(nil).ListNotificationRules), input)
+}
+
+// DescribeNotificationRule mocks base method
+func (m *MockcodestarnotificationsClient) DescribeNotificationRule(input *codestarnotifications.DescribeNotificationRuleInput) (*codestarnotifications.DescribeNotificationRuleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeNotificationRule", input)
+	ret0, _ := ret[0].(*codestarnotifications.DescribeNotificationRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNotificationRule indicates an expected call of DescribeNotificationRule
+func (mr *MockcodestarnotificationsClientMockRecorder) DescribeNotificationRule(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNotificationRule", reflect.TypeOf((*MockcodestarnotificationsClient)(nil).DescribeNotificationRule), input)
+}
@@ -6,6 +6,9 @@ package ecs
 
 import (
 	"fmt"
+	"strings"
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
@@ -13,6 +16,42 @@ import (
 
 type ecsClient interface {
 	DescribeTaskDefinition(input *ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error)
+	ListTasks(input *ecs.ListTasksInput) (*ecs.ListTasksOutput, error)
+	DescribeServices(input *ecs.DescribeServicesInput) (*ecs.DescribeServicesOutput, error)
+	DescribeTasks(input *ecs.DescribeTasksInput) (*ecs.DescribeTasksOutput, error)
+}
+
+// describeTasksLimit is the maximum number of tasks that can be described at once.
+const describeTasksLimit = 100
+
+// ServiceStatus represents the task counts and the deployments of an ECS service.
+type ServiceStatus struct {
+	DesiredCount int64
+	RunningCount int64
+	PendingCount int64
+	Deployments  []*Deployment
+}
+
+// Deployment represents a deployment of an ECS service.
+type Deployment struct {
+	ID             string
+	Status         string // PRIMARY for the most recent deployment, ACTIVE for the ones being replaced.
+	TaskDefinition string
+	DesiredCount   int64
+	RunningCount   int64
+	PendingCount   int64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Task represents an ECS task.
+type Task struct {
+	ID             string
+	TaskDefinition string
+	LastStatus     string
+	HealthStatus   string
+	PrivateIP      string     // Empty until the network interface of the task is attached.
+	StartedAt      *time.Time // Nil if the task didn't start yet.
 }
 
 // Service wraps an AWS ECS client.
@@ -39,6 +78,131 @@ func (s Service) TaskDefinition(taskDefName string) (*TaskDefinition, error) {
 	return &td, nil
 }
 
+// RunningTaskIDs calls ECS API and returns the IDs of the running tasks of a task definition family in a cluster.
+func (s Service) RunningTaskIDs(cluster, family string) ([]string, error) {
+	var ids []string
+	in := &ecs.ListTasksInput{
+		Cluster:       aws.String(cluster),
+		Family:        aws.String(family),
+		DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+	}
+	for {
+		resp, err := s.ecs.ListTasks(in)
+		if err != nil {
+			return nil, fmt.Errorf("list running tasks of family %s in cluster %s: %w", family, cluster, err)
+		}
+		for _, arn := range resp.TaskArns {
+			ids = append(ids, resourceID(aws.StringValue(arn)))
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		in.NextToken = resp.NextToken
+	}
+	return ids, nil
+}
+
+// ServiceStatus calls ECS API and returns the task counts and the deployments of a service in a cluster.
+func (s Service) ServiceStatus(cluster, service string) (*ServiceStatus, error) {
+	resp, err := s.ecs.DescribeServices(&ecs.DescribeServicesInput{
+		Cluster:  aws.String(cluster),
+		Services: aws.StringSlice([]string{service}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe service %s in cluster %s: %w", service, cluster, err)
+	}
+	if len(resp.Services) == 0 {
+		return nil, fmt.Errorf("service %s not found in cluster %s", service, cluster)
+	}
+	svc := resp.Services[0]
+	status := &ServiceStatus{
+		DesiredCount: aws.Int64Value(svc.DesiredCount),
+		RunningCount: aws.Int64Value(svc.RunningCount),
+		PendingCount: aws.Int64Value(svc.PendingCount),
+	}
+	for _, d := range svc.Deployments {
+		status.Deployments = append(status.Deployments, &Deployment{
+			ID:             aws.StringValue(d.Id),
+			Status:         aws.StringValue(d.Status),
+			TaskDefinition: resourceID(aws.StringValue(d.TaskDefinition)),
+			DesiredCount:   aws.Int64Value(d.DesiredCount),
+			RunningCount:   aws.Int64Value(d.RunningCount),
+			PendingCount:   aws.Int64Value(d.PendingCount),
+			CreatedAt:      aws.TimeValue(d.CreatedAt),
+			UpdatedAt:      aws.TimeValue(d.UpdatedAt),
+		})
+	}
+	return status, nil
+}
+
+// ServiceTasks calls ECS API and returns the tasks of a service in a cluster.
+func (s Service) ServiceTasks(cluster, service string) ([]*Task, error) {
+	var arns []*string
+	in := &ecs.ListTasksInput{
+		Cluster:     aws.String(cluster),
+		ServiceName: aws.String(service),
+	}
+	for {
+		resp, err := s.ecs.ListTasks(in)
+		if err != nil {
+			return nil, fmt.Errorf("list tasks of service %s in cluster %s: %w", service, cluster, err)
+		}
+		arns = append(arns, resp.TaskArns...)
+		if resp.NextToken == nil {
+			break
+		}
+		in.NextToken = resp.NextToken
+	}
+
+	var tasks []*Task
+	for start := 0; start < len(arns); start += describeTasksLimit {
+		end := start + describeTasksLimit
+		if end > len(arns) {
+			end = len(arns)
+		}
+		resp, err := s.ecs.DescribeTasks(&ecs.DescribeTasksInput{
+			Cluster: aws.String(cluster),
+			Tasks:   arns[start:end],
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe tasks of service %s in cluster %s: %w", service, cluster, err)
+		}
+		for _, t := range resp.Tasks {
+			tasks = append(tasks, &Task{
+				ID:             resourceID(aws.StringValue(t.TaskArn)),
+				TaskDefinition: resourceID(aws.StringValue(t.TaskDefinitionArn)),
+				LastStatus:     aws.StringValue(t.LastStatus),
+				HealthStatus:   aws.StringValue(t.HealthStatus),
+				PrivateIP:      privateIP(t),
+				StartedAt:      t.StartedAt,
+			})
+		}
+	}
+	return tasks, nil
+}
+
+// resourceID returns the last part of an ARN.
+// For example, "1cc0685ad01d4d0f8e4e2c00d1775c56" for "arn:aws:ecs:us-west-2:123456789012:task/my-cluster/1cc0685ad01d4d0f8e4e2c00d1775c56".
+func resourceID(arn string) string {
+	parts := strings.Split(arn, "/")
+	return parts[len(parts)-1]
+}
+
+// privateIP returns the private IPv4 address of the network interface attached to a Fargate task.
+func privateIP(t *ecs.Task) string {
+	for _, attachment := range t.Attachments {
+		if aws.StringValue(attachment.Type) != "ElasticNetworkInterface" {
+			continue
+		}
+		for _, detail := range attachment.Details {
+			if aws.StringValue(detail.Name) == "privateIPv4Address" {
+				return aws.StringValue(detail.Value)
+			}
+		}
+	}
+	return ""
+}
+
 // TaskDefinition wraps up ECS TaskDefinition struct.
 type TaskDefinition ecs.TaskDefinition
 
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/ecs/mocks"
 	"github.com/aws/aws-sdk-go/aws"
@@ -102,6 +103,71 @@ func TestService_TaskDefinition(t *testing.T) {
 	}
 }
 
+func TestService_RunningTaskIDs(t *testing.T) {
+	mockError := errors.New("error")
+
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.MockecsClient)
+
+		wantErr error
+		wantIDs []string
+	}{
+		"should return wrapped error given error": {
+			mockECSClient: func(m *mocks.MockecsClient) {
+				m.EXPECT().ListTasks(gomock.Any()).Return(nil, mockError)
+			},
+			wantErr: fmt.Errorf("list running tasks of family %s in cluster %s: %w", "phonetool-test-frontend", "my-cluster", mockError),
+		},
+		"returns the task IDs across pages": {
+			mockECSClient: func(m *mocks.MockecsClient) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("my-cluster"),
+					Family:        aws.String("phonetool-test-frontend"),
+					DesiredStatus: aws.String("RUNNING"),
+				}).Return(&ecs.ListTasksOutput{
+					TaskArns:  aws.StringSlice([]string{"arn:aws:ecs:us-west-2:123456789012:task/my-cluster/task1"}),
+					NextToken: aws.String("token"),
+				}, nil)
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("my-cluster"),
+					Family:        aws.String("phonetool-test-frontend"),
+					DesiredStatus: aws.String("RUNNING"),
+					NextToken:     aws.String("token"),
+				}).Return(&ecs.ListTasksOutput{
+					TaskArns: aws.StringSlice([]string{"arn:aws:ecs:us-west-2:123456789012:task/task2"}),
+				}, nil)
+			},
+			wantIDs: []string{"task1", "task2"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockecsClient(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := Service{
+				ecs: mockECSClient,
+			}
+
+			// WHEN
+			gotIDs, gotErr := service.RunningTaskIDs("my-cluster", "phonetool-test-frontend")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantIDs, gotIDs)
+			}
+		})
+	}
+}
+
 func TestTaskDefinition_EnvVars(t *testing.T) {
 	testCases := map[string]struct {
 		inContainers []*ecs.ContainerDefinition
@@ -148,3 +214,225 @@ func TestTaskDefinition_EnvVars(t *testing.T) {
 
 	}
 }
+
+func TestService_ServiceStatus(t *testing.T) {
+	createdAt := time.Date(2020, 4, 1, 10, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2020, 4, 1, 10, 5, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.MockecsClient)
+
+		wantErr    error
+		wantStatus *ServiceStatus
+	}{
+		"should return wrapped error given error": {
+			mockECSClient: func(m *mocks.MockecsClient) {
+				m.EXPECT().DescribeServices(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("describe service my-service in cluster my-cluster: some error"),
+		},
+		"should return error if the service doesn't exist": {
+			mockECSClient: func(m *mocks.MockecsClient) {
+				m.EXPECT().DescribeServices(gomock.Any()).Return(&ecs.DescribeServicesOutput{}, nil)
+			},
+			wantErr: errors.New("service my-service not found in cluster my-cluster"),
+		},
+		"returns the task counts and deployments of the service": {
+			mockECSClient: func(m *mocks.MockecsClient) {
+				m.EXPECT().DescribeServices(&ecs.DescribeServicesInput{
+					Cluster:  aws.String("my-cluster"),
+					Services: aws.StringSlice([]string{"my-service"}),
+				}).Return(&ecs.DescribeServicesOutput{
+					Services: []*ecs.Service{
+						{
+							DesiredCount: aws.Int64(2),
+							RunningCount: aws.Int64(1),
+							PendingCount: aws.Int64(1),
+							Deployments: []*ecs.Deployment{
+								{
+									Id:             aws.String("ecs-svc/1"),
+									Status:         aws.String("PRIMARY"),
+									TaskDefinition: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-frontend:2"),
+									DesiredCount:   aws.Int64(2),
+									RunningCount:   aws.Int64(1),
+									PendingCount:   aws.Int64(1),
+									CreatedAt:      aws.Time(createdAt),
+									UpdatedAt:      aws.Time(updatedAt),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantStatus: &ServiceStatus{
+				DesiredCount: 2,
+				RunningCount: 1,
+				PendingCount: 1,
+				Deployments: []*Deployment{
+					{
+						ID:             "ecs-svc/1",
+						Status:         "PRIMARY",
+						TaskDefinition: "phonetool-test-frontend:2",
+						DesiredCount:   2,
+						RunningCount:   1,
+						PendingCount:   1,
+						CreatedAt:      createdAt,
+						UpdatedAt:      updatedAt,
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockecsClient(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := Service{
+				ecs: mockECSClient,
+			}
+
+			// WHEN
+			gotStatus, gotErr := service.ServiceStatus("my-cluster", "my-service")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantStatus, gotStatus)
+			}
+		})
+	}
+}
+
+func TestService_ServiceTasks(t *testing.T) {
+	startedAt := time.Date(2020, 4, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.MockecsClient)
+
+		wantErr   error
+		wantTasks []*Task
+	}{
+		"should return wrapped error given error to list tasks": {
+			mockECSClient: func(m *mocks.MockecsClient) {
+				m.EXPECT().ListTasks(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("list tasks of service my-service in cluster my-cluster: some error"),
+		},
+		"should return wrapped error given error to describe tasks": {
+			mockECSClient: func(m *mocks.MockecsClient) {
+				m.EXPECT().ListTasks(gomock.Any()).Return(&ecs.ListTasksOutput{
+					TaskArns: aws.StringSlice([]string{"arn:aws:ecs:us-west-2:123456789012:task/my-cluster/task1"}),
+				}, nil)
+				m.EXPECT().DescribeTasks(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("describe tasks of service my-service in cluster my-cluster: some error"),
+		},
+		"returns no tasks without describing them": {
+			mockECSClient: func(m *mocks.MockecsClient) {
+				m.EXPECT().ListTasks(gomock.Any()).Return(&ecs.ListTasksOutput{}, nil)
+			},
+		},
+		"returns the tasks of all the pages": {
+			mockECSClient: func(m *mocks.MockecsClient) {
+				gomock.InOrder(
+					m.EXPECT().ListTasks(&ecs.ListTasksInput{
+						Cluster:     aws.String("my-cluster"),
+						ServiceName: aws.String("my-service"),
+					}).Return(&ecs.ListTasksOutput{
+						TaskArns:  aws.StringSlice([]string{"arn:aws:ecs:us-west-2:123456789012:task/my-cluster/task1"}),
+						NextToken: aws.String("token"),
+					}, nil),
+					m.EXPECT().ListTasks(&ecs.ListTasksInput{
+						Cluster:     aws.String("my-cluster"),
+						ServiceName: aws.String("my-service"),
+						NextToken:   aws.String("token"),
+					}).Return(&ecs.ListTasksOutput{
+						TaskArns: aws.StringSlice([]string{"arn:aws:ecs:us-west-2:123456789012:task/my-cluster/task2"}),
+					}, nil),
+				)
+				m.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
+					Cluster: aws.String("my-cluster"),
+					Tasks: aws.StringSlice([]string{
+						"arn:aws:ecs:us-west-2:123456789012:task/my-cluster/task1",
+						"arn:aws:ecs:us-west-2:123456789012:task/my-cluster/task2",
+					}),
+				}).Return(&ecs.DescribeTasksOutput{
+					Tasks: []*ecs.Task{
+						{
+							TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/my-cluster/task1"),
+							TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-frontend:2"),
+							LastStatus:        aws.String("RUNNING"),
+							HealthStatus:      aws.String("HEALTHY"),
+							StartedAt:         aws.Time(startedAt),
+							Attachments: []*ecs.Attachment{
+								{
+									Type: aws.String("ElasticNetworkInterface"),
+									Details: []*ecs.KeyValuePair{
+										{Name: aws.String("subnetId"), Value: aws.String("subnet-1")},
+										{Name: aws.String("privateIPv4Address"), Value: aws.String("10.0.0.1")},
+									},
+								},
+							},
+						},
+						{
+							TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/my-cluster/task2"),
+							TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-frontend:2"),
+							LastStatus:        aws.String("PROVISIONING"),
+							HealthStatus:      aws.String("UNKNOWN"),
+						},
+					},
+				}, nil)
+			},
+			wantTasks: []*Task{
+				{
+					ID:             "task1",
+					TaskDefinition: "phonetool-test-frontend:2",
+					LastStatus:     "RUNNING",
+					HealthStatus:   "HEALTHY",
+					PrivateIP:      "10.0.0.1",
+					StartedAt:      aws.Time(startedAt),
+				},
+				{
+					ID:             "task2",
+					TaskDefinition: "phonetool-test-frontend:2",
+					LastStatus:     "PROVISIONING",
+					HealthStatus:   "UNKNOWN",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockecsClient(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := Service{
+				ecs: mockECSClient,
+			}
+
+			// WHEN
+			gotTasks, gotErr := service.ServiceTasks("my-cluster", "my-service")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantTasks, gotTasks)
+			}
+		})
+	}
+}
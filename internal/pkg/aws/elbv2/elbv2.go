@@ -0,0 +1,61 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package elbv2 contains utility functions for dealing with Elastic Load Balancing target groups.
+package elbv2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+)
+
+type elbv2Client interface {
+	DescribeTargetHealth(input *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error)
+}
+
+// ELBV2 wraps an AWS Elastic Load Balancing V2 client.
+type ELBV2 struct {
+	client elbv2Client
+}
+
+// TargetHealth represents the health of a target registered in a target group.
+type TargetHealth struct {
+	ID     string // IP address of the target for targets of type "ip".
+	Port   int64
+	State  string
+	Reason string // Empty if the target is healthy.
+}
+
+// New returns an ELBV2 client configured against the input session.
+func New(s *session.Session) *ELBV2 {
+	return &ELBV2{
+		client: elbv2.New(s),
+	}
+}
+
+// TargetsHealth returns the health of the targets registered in the target group.
+func (e *ELBV2) TargetsHealth(targetGroupARN string) ([]*TargetHealth, error) {
+	resp, err := e.client.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: aws.String(targetGroupARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe health of targets in target group %s: %w", targetGroupARN, err)
+	}
+	var targets []*TargetHealth
+	for _, desc := range resp.TargetHealthDescriptions {
+		target := &TargetHealth{}
+		if desc.Target != nil {
+			target.ID = aws.StringValue(desc.Target.Id)
+			target.Port = aws.Int64Value(desc.Target.Port)
+		}
+		if desc.TargetHealth != nil {
+			target.State = aws.StringValue(desc.TargetHealth.State)
+			target.Reason = aws.StringValue(desc.TargetHealth.Reason)
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package elbv2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/elbv2/mocks"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestELBV2_TargetsHealth(t *testing.T) {
+	const targetGroupARN = "arn:aws:elasticloadbalancing:us-west-2:1234:targetgroup/phonetool-test-frontend/abc"
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockelbv2Client)
+
+		wantedTargets []*TargetHealth
+		wantedError   error
+	}{
+		"returns wrapped error if fail to describe the health of the targets": {
+			mockClient: func(m *mocks.Mockelbv2Client) {
+				m.EXPECT().DescribeTargetHealth(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe health of targets in target group arn:aws:elasticloadbalancing:us-west-2:1234:targetgroup/phonetool-test-frontend/abc: some error"),
+		},
+		"returns the health of each target": {
+			mockClient: func(m *mocks.Mockelbv2Client) {
+				m.EXPECT().DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+					TargetGroupArn: aws.String(targetGroupARN),
+				}).Return(&elbv2.DescribeTargetHealthOutput{
+					TargetHealthDescriptions: []*elbv2.TargetHealthDescription{
+						{
+							Target:       &elbv2.TargetDescription{Id: aws.String("10.0.0.1"), Port: aws.Int64(80)},
+							TargetHealth: &elbv2.TargetHealth{State: aws.String("healthy")},
+						},
+						{
+							Target: &elbv2.TargetDescription{Id: aws.String("10.0.0.2"), Port: aws.Int64(80)},
+							TargetHealth: &elbv2.TargetHealth{
+								State:  aws.String("unhealthy"),
+								Reason: aws.String("Target.ResponseCodeMismatch"),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedTargets: []*TargetHealth{
+				{ID: "10.0.0.1", Port: 80, State: "healthy"},
+				{ID: "10.0.0.2", Port: 80, State: "unhealthy", Reason: "Target.ResponseCodeMismatch"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockelbv2Client(ctrl)
+			tc.mockClient(mockClient)
+			e := &ELBV2{client: mockClient}
+
+			// WHEN
+			targets, err := e.TargetsHealth(targetGroupARN)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTargets, targets)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/aws/elbv2/elbv2.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	elbv2 "github.com/aws/aws-sdk-go/service/elbv2"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// Mockelbv2Client is a mock of elbv2Client interface
+type Mockelbv2Client struct {
+	ctrl     *gomock.Controller
+	recorder *Mockelbv2ClientMockRecorder
+}
+
+// Mockelbv2ClientMockRecorder is the mock recorder for Mockelbv2Client
+type Mockelbv2ClientMockRecorder struct {
+	mock *Mockelbv2Client
+}
+
+// NewMockelbv2Client creates a new mock instance
+func NewMockelbv2Client(ctrl *gomock.Controller) *Mockelbv2Client {
+	mock := &Mockelbv2Client{ctrl: ctrl}
+	mock.recorder = &Mockelbv2ClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *Mockelbv2Client) EXPECT() *Mockelbv2ClientMockRecorder {
+	return m.recorder
+}
+
+// DescribeTargetHealth mocks base method
+func (m *Mockelbv2Client) DescribeTargetHealth(input *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTargetHealth", input)
+	ret0, _ := ret[0].(*elbv2.DescribeTargetHealthOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTargetHealth indicates an expected call of DescribeTargetHealth
+func (mr *Mockelbv2ClientMockRecorder) DescribeTargetHealth(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTargetHealth", reflect.TypeOf((*Mockelbv2Client)(nil).DescribeTargetHealth), input)
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/aws/profile/sso.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	sso "github.com/aws/aws-sdk-go/service/sso"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockroleCredentialsGetter is a mock of roleCredentialsGetter interface
+type MockroleCredentialsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockroleCredentialsGetterMockRecorder
+}
+
+// MockroleCredentialsGetterMockRecorder is the mock recorder for MockroleCredentialsGetter
+type MockroleCredentialsGetterMockRecorder struct {
+	mock *MockroleCredentialsGetter
+}
+
+// NewMockroleCredentialsGetter creates a new mock instance
+func NewMockroleCredentialsGetter(ctrl *gomock.Controller) *MockroleCredentialsGetter {
+	mock := &MockroleCredentialsGetter{ctrl: ctrl}
+	mock.recorder = &MockroleCredentialsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockroleCredentialsGetter) EXPECT() *MockroleCredentialsGetterMockRecorder {
+	return m.recorder
+}
+
+// GetRoleCredentials mocks base method
+func (m *MockroleCredentialsGetter) GetRoleCredentials(input *sso.GetRoleCredentialsInput) (*sso.GetRoleCredentialsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoleCredentials", input)
+	ret0, _ := ret[0].(*sso.GetRoleCredentialsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoleCredentials indicates an expected call of GetRoleCredentials
+func (mr *MockroleCredentialsGetterMockRecorder) GetRoleCredentials(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleCredentials", reflect.TypeOf((*MockroleCredentialsGetter)(nil).GetRoleCredentials), input)
+}
@@ -16,6 +16,18 @@ import (
 const (
 	awsCredentialsDir = ".aws"
 	awsConfigFileName = "config"
+
+	defaultProfileName   = "default"
+	profileSectionPrefix = "profile "
+)
+
+// Keys of the AWS SSO settings in a named profile.
+const (
+	ssoStartURLKey  = "sso_start_url"
+	ssoRegionKey    = "sso_region"
+	ssoAccountIDKey = "sso_account_id"
+	ssoRoleNameKey  = "sso_role_name"
+	regionKey       = "region"
 )
 
 // Config represents the local AWS config file.
@@ -31,7 +43,10 @@ func NewConfig() (*Config, error) {
 		return nil, fmt.Errorf("get home directory: %w", err)
 	}
 
-	cfgPath := filepath.Join(homeDir, awsCredentialsDir, awsConfigFileName)
+	return newConfigFromPath(filepath.Join(homeDir, awsCredentialsDir, awsConfigFileName))
+}
+
+func newConfigFromPath(cfgPath string) (*Config, error) {
 	cfg, err := ini.New(cfgPath)
 	if err != nil {
 		return nil, fmt.Errorf("read AWS config file %s, you might need to run %s first: %w", cfgPath, "aws configure", err)
@@ -47,7 +62,44 @@ func (c *Config) Names() []string {
 	var profiles []string
 	for _, section := range c.f.Sections() {
 		// Named profiles created with "aws configure" are formatted as "[profile test]".
-		profiles = append(profiles, strings.TrimSpace(strings.ReplaceAll(section, "profile", "")))
+		profiles = append(profiles, strings.TrimSpace(strings.TrimPrefix(section, profileSectionPrefix)))
 	}
 	return profiles
 }
+
+// SSOConfig holds the AWS SSO settings of a named profile.
+type SSOConfig struct {
+	StartURL  string // URL of the AWS SSO user portal.
+	Region    string // Region where the AWS SSO directory is hosted.
+	AccountID string // ID of the account to retrieve credentials for.
+	RoleName  string // Name of the AWS SSO permission set to retrieve credentials for.
+}
+
+// SSO returns the AWS SSO settings of a named profile.
+// The boolean is false if the profile isn't configured with AWS SSO.
+func (c *Config) SSO(name string) (*SSOConfig, bool) {
+	keys := c.f.Keys(sectionName(name))
+	if keys[ssoStartURLKey] == "" {
+		return nil, false
+	}
+	return &SSOConfig{
+		StartURL:  keys[ssoStartURLKey],
+		Region:    keys[ssoRegionKey],
+		AccountID: keys[ssoAccountIDKey],
+		RoleName:  keys[ssoRoleNameKey],
+	}, true
+}
+
+// Region returns the default region of a named profile.
+// An empty string is returned if the profile doesn't set a region.
+func (c *Config) Region(name string) string {
+	return c.f.Keys(sectionName(name))[regionKey]
+}
+
+// sectionName returns the name of the section holding a named profile's settings.
+func sectionName(profile string) string {
+	if profile == defaultProfileName {
+		return defaultProfileName
+	}
+	return profileSectionPrefix + profile
+}
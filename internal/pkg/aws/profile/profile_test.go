@@ -0,0 +1,51 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_SSO(t *testing.T) {
+	// GIVEN
+	content := `[default]
+region = us-west-2
+
+[profile sso]
+sso_start_url = https://my-sso-portal.awsapps.com/start
+sso_region = us-east-1
+sso_account_id = 123456789012
+sso_role_name = AdministratorAccess
+region = eu-west-1
+`
+	dir, err := ioutil.TempDir("", "aws-config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, awsConfigFileName)
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	cfg, err := newConfigFromPath(path)
+	require.NoError(t, err)
+
+	// WHEN
+	ssoCfg, isSSO := cfg.SSO("sso")
+	_, isDefaultSSO := cfg.SSO("default")
+
+	// THEN
+	require.ElementsMatch(t, []string{"default", "sso"}, cfg.Names())
+	require.True(t, isSSO)
+	require.Equal(t, &SSOConfig{
+		StartURL:  "https://my-sso-portal.awsapps.com/start",
+		Region:    "us-east-1",
+		AccountID: "123456789012",
+		RoleName:  "AdministratorAccess",
+	}, ssoCfg)
+	require.Equal(t, "eu-west-1", cfg.Region("sso"))
+	require.False(t, isDefaultSSO)
+	require.Equal(t, "us-west-2", cfg.Region("default"))
+}
@@ -0,0 +1,131 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sso"
+)
+
+const (
+	// SSOProviderName is the name of the credentials provider for AWS SSO profiles.
+	SSOProviderName = "SSOProvider"
+
+	ssoCacheDir = "sso/cache"
+)
+
+// Time layouts used by the AWS CLI to write the expiration date of cached SSO tokens.
+var ssoTokenExpiryLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05UTC",
+}
+
+type roleCredentialsGetter interface {
+	GetRoleCredentials(input *sso.GetRoleCredentialsInput) (*sso.GetRoleCredentialsOutput, error)
+}
+
+// ssoToken is the access token cached by "aws sso login".
+type ssoToken struct {
+	AccessToken string `json:"accessToken"`
+	ExpiresAt   string `json:"expiresAt"`
+}
+
+// SSOProvider retrieves the credentials of an AWS SSO profile using the access token cached by "aws sso login".
+type SSOProvider struct {
+	credentials.Expiry
+
+	cfg      *SSOConfig
+	cacheDir string
+	client   roleCredentialsGetter
+	now      func() time.Time
+}
+
+// NewSSOCredentials returns credentials for the account and role of an AWS SSO profile.
+func NewSSOCredentials(cfg *SSOConfig) (*credentials.Credentials, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("get home directory: %w", err)
+	}
+	// The SSO portal authenticates requests with the cached access token instead of AWS credentials.
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Region:      aws.String(cfg.Region),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewCredentials(&SSOProvider{
+		cfg:      cfg,
+		cacheDir: filepath.Join(homeDir, awsCredentialsDir, ssoCacheDir),
+		client:   sso.New(sess),
+		now:      time.Now,
+	}), nil
+}
+
+// Retrieve exchanges the cached access token for the role credentials of the profile.
+func (p *SSOProvider) Retrieve() (credentials.Value, error) {
+	token, err := p.cachedToken()
+	if err != nil {
+		return credentials.Value{ProviderName: SSOProviderName}, err
+	}
+	out, err := p.client.GetRoleCredentials(&sso.GetRoleCredentialsInput{
+		AccessToken: aws.String(token.AccessToken),
+		AccountId:   aws.String(p.cfg.AccountID),
+		RoleName:    aws.String(p.cfg.RoleName),
+	})
+	if err != nil {
+		return credentials.Value{ProviderName: SSOProviderName}, fmt.Errorf("get role credentials for role %s in account %s: %w", p.cfg.RoleName, p.cfg.AccountID, err)
+	}
+	creds := out.RoleCredentials
+	p.SetExpiration(time.Unix(0, aws.Int64Value(creds.Expiration)*int64(time.Millisecond)), 0)
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(creds.AccessKeyId),
+		SecretAccessKey: aws.StringValue(creds.SecretAccessKey),
+		SessionToken:    aws.StringValue(creds.SessionToken),
+		ProviderName:    SSOProviderName,
+	}, nil
+}
+
+// cachedToken returns the unexpired access token of the profile's start URL.
+func (p *SSOProvider) cachedToken() (*ssoToken, error) {
+	// The AWS CLI names the cache file after the SHA-1 hash of the start URL.
+	hash := sha1.Sum([]byte(p.cfg.StartURL))
+	path := filepath.Join(p.cacheDir, hex.EncodeToString(hash[:])+".json")
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read cached SSO token %s, you might need to run %s first: %w", path, "aws sso login", err)
+	}
+	var token ssoToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, fmt.Errorf("unmarshal cached SSO token %s: %w", path, err)
+	}
+	expiresAt, err := parseSSOTokenExpiry(token.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if !p.now().Before(expiresAt) {
+		return nil, fmt.Errorf("cached SSO token for %s has expired, run %s to refresh it", p.cfg.StartURL, "aws sso login")
+	}
+	return &token, nil
+}
+
+func parseSSOTokenExpiry(value string) (time.Time, error) {
+	for _, layout := range ssoTokenExpiryLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("parse SSO token expiration date %s", value)
+}
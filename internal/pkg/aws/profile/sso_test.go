@@ -0,0 +1,125 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/profile/mocks"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/service/sso"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSOProvider_Retrieve(t *testing.T) {
+	const (
+		testStartURL = "https://my-sso-portal.awsapps.com/start"
+		// SHA-1 hash of testStartURL.
+		testCacheFile = "c7aaaf71fcc8777ae2475525ed049d39fe16c484.json"
+	)
+	testCfg := &SSOConfig{
+		StartURL:  testStartURL,
+		Region:    "us-east-1",
+		AccountID: "123456789012",
+		RoleName:  "AdministratorAccess",
+	}
+	testNow := time.Date(2020, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		cachedToken string
+		mockClient  func(m *mocks.MockroleCredentialsGetter)
+
+		wantedValue credentials.Value
+		wantedError string
+	}{
+		"missing cached token": {
+			mockClient: func(m *mocks.MockroleCredentialsGetter) {},
+
+			wantedError: "read cached SSO token",
+		},
+		"expired cached token": {
+			cachedToken: `{"accessToken": "token", "expiresAt": "2020-03-01T11:00:00UTC"}`,
+			mockClient:  func(m *mocks.MockroleCredentialsGetter) {},
+
+			wantedError: "cached SSO token for https://my-sso-portal.awsapps.com/start has expired, run aws sso login to refresh it",
+		},
+		"fail to get role credentials": {
+			cachedToken: `{"accessToken": "token", "expiresAt": "2020-03-01T13:00:00Z"}`,
+			mockClient: func(m *mocks.MockroleCredentialsGetter) {
+				m.EXPECT().GetRoleCredentials(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: "get role credentials for role AdministratorAccess in account 123456789012: some error",
+		},
+		"exchange cached token for role credentials": {
+			cachedToken: `{"accessToken": "token", "expiresAt": "2020-03-01T13:00:00UTC"}`,
+			mockClient: func(m *mocks.MockroleCredentialsGetter) {
+				m.EXPECT().GetRoleCredentials(&sso.GetRoleCredentialsInput{
+					AccessToken: aws.String("token"),
+					AccountId:   aws.String("123456789012"),
+					RoleName:    aws.String("AdministratorAccess"),
+				}).Return(&sso.GetRoleCredentialsOutput{
+					RoleCredentials: &sso.RoleCredentials{
+						AccessKeyId:     aws.String("access-key"),
+						SecretAccessKey: aws.String("secret"),
+						SessionToken:    aws.String("session"),
+						Expiration:      aws.Int64(testNow.Add(time.Hour).UnixNano() / int64(time.Millisecond)),
+					},
+				}, nil)
+			},
+
+			wantedValue: credentials.Value{
+				AccessKeyID:     "access-key",
+				SecretAccessKey: "secret",
+				SessionToken:    "session",
+				ProviderName:    SSOProviderName,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cacheDir, err := ioutil.TempDir("", "sso-cache")
+			require.NoError(t, err)
+			defer os.RemoveAll(cacheDir)
+			if tc.cachedToken != "" {
+				require.NoError(t, ioutil.WriteFile(filepath.Join(cacheDir, testCacheFile), []byte(tc.cachedToken), 0600))
+			}
+
+			mockClient := mocks.NewMockroleCredentialsGetter(ctrl)
+			tc.mockClient(mockClient)
+			p := &SSOProvider{
+				cfg:      testCfg,
+				cacheDir: cacheDir,
+				client:   mockClient,
+				now: func() time.Time {
+					return testNow
+				},
+			}
+
+			// WHEN
+			value, err := p.Retrieve()
+
+			// THEN
+			if tc.wantedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.wantedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedValue, value)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 type SecretsManagerAPI interface {
 	CreateSecret(*secretsmanager.CreateSecretInput) (*secretsmanager.CreateSecretOutput, error)
+	DeleteSecret(*secretsmanager.DeleteSecretInput) (*secretsmanager.DeleteSecretOutput, error)
 }
 
 // SecretsManager is in charge of fetching and creating projects, environment
@@ -66,6 +67,22 @@ func (s *SecretsManager) CreateSecret(secretName, secretString string) (string,
 	return aws.StringValue(resp.ARN), nil
 }
 
+// RecoveryWindowInDays is the number of days during which a deleted secret can be restored.
+const RecoveryWindowInDays = 7
+
+// DeleteSecret schedules the deletion of the secret from SecretsManager,
+// which can be restored until the end of the recovery window.
+func (s *SecretsManager) DeleteSecret(secretName string) error {
+	_, err := s.secretsManager.DeleteSecret(&secretsmanager.DeleteSecretInput{
+		SecretId:             aws.String(secretName),
+		RecoveryWindowInDays: aws.Int64(RecoveryWindowInDays),
+	})
+	if err != nil {
+		return fmt.Errorf("delete secret %s: %w", secretName, err)
+	}
+	return nil
+}
+
 type ErrSecretAlreadyExists struct {
 	secretName string
 	parentErr  error
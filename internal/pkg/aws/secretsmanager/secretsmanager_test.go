@@ -96,3 +96,54 @@ func TestSecretsManager_CreateSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretsManager_DeleteSecret(t *testing.T) {
+	mockSecretName := "github-token-backend-badgoose"
+
+	tests := map[string]struct {
+		callMock func(m *mocks.MockSecretsManagerAPI)
+
+		expectedError error
+	}{
+		"should wrap error returned by DeleteSecret": {
+			callMock: func(m *mocks.MockSecretsManagerAPI) {
+				m.EXPECT().DeleteSecret(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("delete secret github-token-backend-badgoose: some error"),
+		},
+		"should delete the secret with a recovery window": {
+			callMock: func(m *mocks.MockSecretsManagerAPI) {
+				m.EXPECT().DeleteSecret(&secretsmanager.DeleteSecretInput{
+					SecretId:             aws.String(mockSecretName),
+					RecoveryWindowInDays: aws.Int64(7),
+				}).Return(&secretsmanager.DeleteSecretOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSecretsManager := mocks.NewMockSecretsManagerAPI(ctrl)
+
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+
+			tc.callMock(mockSecretsManager)
+
+			// WHEN
+			err := sm.DeleteSecret(mockSecretName)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
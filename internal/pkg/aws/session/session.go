@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"runtime"
 
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/profile"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/version"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -78,7 +79,13 @@ func (p *Provider) DefaultWithRegion(region string) (*session.Session, error) {
 }
 
 // FromProfile returns a session configured against the input profile name.
+// If the profile is configured with AWS SSO, the session uses the credentials cached by "aws sso login".
 func (p *Provider) FromProfile(name string) (*session.Session, error) {
+	if cfg, err := profile.NewConfig(); err == nil {
+		if ssoCfg, ok := cfg.SSO(name); ok {
+			return p.fromSSO(ssoCfg, cfg.Region(name))
+		}
+	}
 	sess, err := session.NewSessionWithOptions(session.Options{
 		Config: aws.Config{
 			CredentialsChainVerboseErrors: aws.Bool(true),
@@ -95,12 +102,22 @@ func (p *Provider) FromProfile(name string) (*session.Session, error) {
 
 // FromRole returns a session configured against the input role and region.
 func (p *Provider) FromRole(roleARN string, region string) (*session.Session, error) {
+	return p.FromRoleWithExternalID(roleARN, "", region)
+}
+
+// FromRoleWithExternalID returns a session configured against the input role and region.
+// The external ID is passed when assuming the role unless it's empty.
+func (p *Provider) FromRoleWithExternalID(roleARN, externalID, region string) (*session.Session, error) {
 	defaultSession, err := p.Default()
 	if err != nil {
 		return nil, fmt.Errorf("error creating default session: %w", err)
 	}
 
-	creds := stscreds.NewCredentials(defaultSession, roleARN)
+	creds := stscreds.NewCredentials(defaultSession, roleARN, func(p *stscreds.AssumeRoleProvider) {
+		if externalID != "" {
+			p.ExternalID = aws.String(externalID)
+		}
+	})
 	sess, err := session.NewSession(&aws.Config{
 		CredentialsChainVerboseErrors: aws.Bool(true),
 		Credentials:                   creds,
@@ -112,3 +129,23 @@ func (p *Provider) FromRole(roleARN string, region string) (*session.Session, er
 	sess.Handlers.Build.PushBackNamed(userAgentHandler())
 	return sess, nil
 }
+
+func (p *Provider) fromSSO(cfg *profile.SSOConfig, region string) (*session.Session, error) {
+	creds, err := profile.NewSSOCredentials(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("create AWS SSO credentials: %w", err)
+	}
+	conf := &aws.Config{
+		CredentialsChainVerboseErrors: aws.Bool(true),
+		Credentials:                   creds,
+	}
+	if region != "" {
+		conf.Region = aws.String(region)
+	}
+	sess, err := session.NewSession(conf)
+	if err != nil {
+		return nil, err
+	}
+	sess.Handlers.Build.PushBackNamed(userAgentHandler())
+	return sess, nil
+}
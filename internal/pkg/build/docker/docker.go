@@ -40,6 +40,17 @@ func (s Service) Build(uri, imageTag, path string) error {
 	return nil
 }
 
+// Tag will run a `docker tag` command so that the image built with the input uri and tag is also named after the target uri.
+func (s Service) Tag(uri, imageTag, targetURI string) error {
+	err := s.runner.Run("docker", []string{"tag", imageName(uri, imageTag), imageName(targetURI, imageTag)})
+
+	if err != nil {
+		return fmt.Errorf("tag image: %w", err)
+	}
+
+	return nil
+}
+
 // Login will run a `docker login` command against the Service repository URI with the input uri and auth data.
 func (s Service) Login(uri, username, password string) error {
 	err := s.runner.Run("docker",
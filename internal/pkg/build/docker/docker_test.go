@@ -59,6 +59,52 @@ func TestBuild(t *testing.T) {
 	}
 }
 
+func TestTag(t *testing.T) {
+	mockError := errors.New("mockError")
+
+	mockURI := "mockURI"
+	mockImageTag := "mockImageTag"
+	mockTargetURI := "mockTargetURI"
+
+	var mockRunner *mocks.Mockrunner
+
+	tests := map[string]struct {
+		setupMocks func(controller *gomock.Controller)
+
+		want error
+	}{
+		"wrap error returned from Run()": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", []string{"tag", imageName(mockURI, mockImageTag), imageName(mockTargetURI, mockImageTag)}).Return(mockError)
+			},
+			want: fmt.Errorf("tag image: %w", mockError),
+		},
+		"happy path": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", []string{"tag", imageName(mockURI, mockImageTag), imageName(mockTargetURI, mockImageTag)}).Return(nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			test.setupMocks(controller)
+			s := Service{
+				runner: mockRunner,
+			}
+
+			got := s.Tag(mockURI, mockImageTag, mockTargetURI)
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
 func TestLogin(t *testing.T) {
 	mockError := errors.New("mockError")
 
@@ -26,8 +26,10 @@ An application represents an Amazon ECS service or task.`,
 	cmd.AddCommand(BuildAppListCmd())
 	cmd.AddCommand(BuildAppPackageCmd())
 	cmd.AddCommand(BuildAppDeployCmd())
+	cmd.AddCommand(BuildAppBuildAndPushCmd())
 	cmd.AddCommand(BuildAppDeleteCmd())
 	cmd.AddCommand(BuildAppShowCmd())
+	cmd.AddCommand(BuildAppStatusCmd())
 	cmd.AddCommand(BuildAppLogsCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
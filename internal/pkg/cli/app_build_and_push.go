@@ -0,0 +1,305 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/ecr"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/session"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/build/docker"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/command"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const defaultBuildAndPushOutputDir = "./infrastructure"
+
+type buildAndPushAppVars struct {
+	*GlobalOpts
+	PipelineName string
+	Tag          string
+	OutputDir    string
+}
+
+type buildAndPushAppOpts struct {
+	buildAndPushAppVars
+
+	ws                 wsAppPipelineReader
+	store              projectService
+	describer          projectResourcesGetter
+	dockerService      dockerService
+	fs                 afero.Fs
+	runner             runner
+	initECRService     func(region string) (ecrService, error) // Overriden in tests.
+	ecrServiceByRegion map[string]ecrService
+}
+
+func newBuildAndPushAppOpts(vars buildAndPushAppVars) (*buildAndPushAppOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	store, err := store.New()
+	if err != nil {
+		return nil, fmt.Errorf("couldn't connect to application datastore: %w", err)
+	}
+	p := session.NewProvider()
+	sess, err := p.Default()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving default session: %w", err)
+	}
+
+	return &buildAndPushAppOpts{
+		buildAndPushAppVars: vars,
+		ws:                  ws,
+		store:               store,
+		describer:           cloudformation.New(sess),
+		dockerService:       docker.New(),
+		fs:                  &afero.Afero{Fs: afero.NewOsFs()},
+		runner:              command.New(),
+		initECRService: func(region string) (ecrService, error) {
+			// ECR client against the tools account profile and the region of the environment.
+			sess, err := p.DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create ECR session with region %s: %w", region, err)
+			}
+			return ecr.New(sess), nil
+		},
+		ecrServiceByRegion: make(map[string]ecrService),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *buildAndPushAppOpts) Validate() error {
+	if o.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if o.OutputDir == "" {
+		return fmt.Errorf("flag --%s can't be empty", stackOutputDirFlag)
+	}
+	return nil
+}
+
+// Ask sets the image tag to the version of the workspace if it's not passed in.
+func (o *buildAndPushAppOpts) Ask() error {
+	if o.Tag != "" {
+		return nil
+	}
+	tag, err := getVersionTag(o.runner)
+	if err != nil {
+		// We're not in a Git repository, prompt the user for an explicit tag.
+		tag, err = o.prompt.Get(inputImageTagPrompt, "", nil)
+		if err != nil {
+			return fmt.Errorf("prompt get image tag: %w", err)
+		}
+	}
+	o.Tag = tag
+	return nil
+}
+
+// Execute builds the image of each application of the pipeline once, pushes it to the ECR repository
+// of every environment of the pipeline, and writes the CloudFormation templates of the applications
+// for each environment to the output directory.
+func (o *buildAndPushAppOpts) Execute() error {
+	pipeline, err := readPipelineManifest(o.ws, o.PipelineName)
+	if err != nil {
+		return err
+	}
+	envs, err := o.pipelineEnvironments(pipeline)
+	if err != nil {
+		return err
+	}
+	wsAppNames, err := o.ws.AppNames()
+	if err != nil {
+		return fmt.Errorf("list applications in workspace: %w", err)
+	}
+	appNames, err := pipelineAppNames(pipeline, wsAppNames)
+	if err != nil {
+		return err
+	}
+	for _, appName := range appNames {
+		if err := o.buildAndPushApp(appName, envs); err != nil {
+			return err
+		}
+		if err := o.packageApp(appName, envs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pipelineEnvironments returns the environments that the pipeline deploys to.
+func (o *buildAndPushAppOpts) pipelineEnvironments(pipeline *manifest.PipelineManifest) ([]*archer.Environment, error) {
+	var envs []*archer.Environment
+	for _, stage := range pipeline.Stages {
+		env, err := o.store.GetEnvironment(o.ProjectName(), stage.Name)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s in project %s: %w", stage.Name, o.ProjectName(), err)
+		}
+		envs = append(envs, env)
+	}
+	return envs, nil
+}
+
+// buildAndPushApp builds the image of the application and pushes it to the ECR repository in each region of the environments.
+func (o *buildAndPushAppOpts) buildAndPushApp(appName string, envs []*archer.Environment) error {
+	dockerfileDir, err := o.dockerfileDir(appName)
+	if err != nil {
+		return err
+	}
+	proj, err := o.store.GetProject(o.ProjectName())
+	if err != nil {
+		return fmt.Errorf("get project %s: %w", o.ProjectName(), err)
+	}
+
+	// Environments in the same region share the same repository.
+	var repoURIs, regions []string
+	seen := make(map[string]bool)
+	for _, env := range envs {
+		if seen[env.Region] {
+			continue
+		}
+		seen[env.Region] = true
+		resources, err := o.describer.GetProjectResourcesByRegion(proj, env.Region)
+		if err != nil {
+			return fmt.Errorf("get resources of project %s in region %s: %w", o.ProjectName(), env.Region, err)
+		}
+		repoURI, ok := resources.RepositoryURLs[appName]
+		if !ok {
+			return &errRepoNotFound{
+				appName:       appName,
+				envRegion:     env.Region,
+				projAccountID: proj.AccountID,
+			}
+		}
+		repoURIs = append(repoURIs, repoURI)
+		regions = append(regions, env.Region)
+	}
+	if len(repoURIs) == 0 {
+		return nil
+	}
+
+	log.Infof("Building the image of application %s with tag %s.\n", color.HighlightUserInput(appName), color.HighlightUserInput(o.Tag))
+	if err := o.dockerService.Build(repoURIs[0], o.Tag, dockerfileDir); err != nil {
+		return fmt.Errorf("build Dockerfile at %s with tag %s: %w", dockerfileDir, o.Tag, err)
+	}
+	for i, repoURI := range repoURIs {
+		if i > 0 {
+			if err := o.dockerService.Tag(repoURIs[0], o.Tag, repoURI); err != nil {
+				return err
+			}
+		}
+		ecrSvc, err := o.ecrService(regions[i])
+		if err != nil {
+			return err
+		}
+		auth, err := ecrSvc.GetECRAuth()
+		if err != nil {
+			return fmt.Errorf("get ECR auth data in region %s: %w", regions[i], err)
+		}
+		if err := o.dockerService.Login(repoURI, auth.Username, auth.Password); err != nil {
+			return err
+		}
+		if err := o.dockerService.Push(repoURI, o.Tag); err != nil {
+			return err
+		}
+		log.Successf("Pushed the image of application %s to %s.\n", color.HighlightUserInput(appName), color.HighlightResource(repoURI))
+	}
+	return nil
+}
+
+// packageApp writes the CloudFormation template and parameters of the application for each environment.
+func (o *buildAndPushAppOpts) packageApp(appName string, envs []*archer.Environment) error {
+	for _, env := range envs {
+		appPackage := packageAppOpts{
+			packageAppVars: packageAppVars{
+				AppName:    appName,
+				EnvName:    env.Name,
+				Tag:        o.Tag,
+				OutputDir:  o.OutputDir,
+				GlobalOpts: o.GlobalOpts,
+			},
+			ws:        o.ws,
+			store:     o.store,
+			describer: o.describer,
+			fs:        o.fs,
+		}
+		if err := appPackage.Execute(); err != nil {
+			return fmt.Errorf("package application %s for environment %s: %w", appName, env.Name, err)
+		}
+	}
+	log.Successf("Wrote the CloudFormation templates of application %s under %s.\n", color.HighlightUserInput(appName), color.HighlightResource(o.OutputDir))
+	return nil
+}
+
+func (o *buildAndPushAppOpts) dockerfileDir(appName string) (string, error) {
+	raw, err := o.ws.ReadAppManifest(appName)
+	if err != nil {
+		return "", fmt.Errorf("read manifest file %s: %w", appName, err)
+	}
+	mft, err := manifest.UnmarshalApp(raw)
+	if err != nil {
+		return "", fmt.Errorf("unmarshal app manifest: %w", err)
+	}
+	return strings.TrimSuffix(mft.DockerfilePath(), "/Dockerfile"), nil
+}
+
+// ecrService returns a client for the ECR repositories in the region, clients are reused across applications.
+func (o *buildAndPushAppOpts) ecrService(region string) (ecrService, error) {
+	if svc, ok := o.ecrServiceByRegion[region]; ok {
+		return svc, nil
+	}
+	svc, err := o.initECRService(region)
+	if err != nil {
+		return nil, err
+	}
+	o.ecrServiceByRegion[region] = svc
+	return svc, nil
+}
+
+// BuildAppBuildAndPushCmd builds the command for building, pushing and packaging the applications of the workspace in a pipeline.
+func BuildAppBuildAndPushCmd() *cobra.Command {
+	vars := buildAndPushAppVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "build-and-push",
+		Short: "Builds, pushes and packages the applications of a pipeline for each of its environments.",
+		Long: `Builds the container image of each application of the pipeline once, pushes it to the ECR repository of every environment of the pipeline,
+and writes the CloudFormation templates of the applications for each environment to a directory.
+This command is run by the build stage of your pipeline.`,
+		Example: `
+  Build and push the images tagged with "1a2b3c", and write the templates to the "infrastructure/" sub-directory.
+  /code $ ecs-preview app build-and-push --tag 1a2b3c --output-dir ./infrastructure
+
+  Build and push the applications of the pipeline "hotfix" when your workspace has multiple pipelines.
+  /code $ ecs-preview app build-and-push --pipeline hotfix --tag 1a2b3c`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newBuildAndPushAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVar(&vars.PipelineName, pipelineFlag, "", pipelineNameFlagDescription)
+	cmd.Flags().StringVar(&vars.Tag, imageTagFlag, "", imageTagFlagDescription)
+	cmd.Flags().StringVar(&vars.OutputDir, stackOutputDirFlag, defaultBuildAndPushOutputDir, stackOutputDirFlagDescription)
+	return cmd
+}
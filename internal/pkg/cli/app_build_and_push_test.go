@@ -0,0 +1,258 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/ecr"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildAndPushAppOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inProjectName string
+		inOutputDir   string
+
+		wantedErr error
+	}{
+		"no project in workspace": {
+			inOutputDir: "./infrastructure",
+			wantedErr:   errNoProjectInWorkspace,
+		},
+		"empty output directory": {
+			inProjectName: "phonetool",
+			wantedErr:     errors.New("flag --output-dir can't be empty"),
+		},
+		"valid": {
+			inProjectName: "phonetool",
+			inOutputDir:   "./infrastructure",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &buildAndPushAppOpts{
+				buildAndPushAppVars: buildAndPushAppVars{
+					GlobalOpts: &GlobalOpts{projectName: tc.inProjectName},
+					OutputDir:  tc.inOutputDir,
+				},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBuildAndPushAppOpts_Execute(t *testing.T) {
+	const (
+		testPipelineManifest = `name: pipeline-phonetool
+version: 1
+source:
+  provider: GitHub
+  properties:
+    repository: badgoose/phonetool
+stages:
+  - name: test
+  - name: prod
+`
+		testAppManifest = `name: frontend
+type: Load Balanced Web App
+image:
+  build: frontend/Dockerfile
+  port: 80
+http:
+  path: '*'
+cpu: 256
+memory: 512
+count: 1`
+		westRepo = "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend"
+		eastRepo = "1234.dkr.ecr.us-east-1.amazonaws.com/phonetool/frontend"
+	)
+	testProject := &archer.Project{
+		Name:      "phonetool",
+		AccountID: "1234",
+	}
+	testEnv := &archer.Environment{
+		Project: "phonetool",
+		Name:    "test",
+		Region:  "us-west-2",
+	}
+	prodEnv := &archer.Environment{
+		Project: "phonetool",
+		Name:    "prod",
+		Region:  "us-east-1",
+	}
+	mockError := errors.New("some error")
+
+	var (
+		mockWs        *climocks.MockwsAppPipelineReader
+		mockStore     *climocks.MockprojectService
+		mockDescriber *climocks.MockprojectResourcesGetter
+		mockDocker    *climocks.MockdockerService
+		mockECR       *climocks.MockecrService
+	)
+
+	testCases := map[string]struct {
+		setupMocks func()
+
+		wantedErr   error
+		wantedFiles []string
+	}{
+		"error if fail to read the pipeline manifest": {
+			setupMocks: func() {
+				mockWs.EXPECT().ReadPipelineManifest("pipeline-phonetool").Return(nil, mockError)
+			},
+			wantedErr: fmt.Errorf("read pipeline manifest: %w", mockError),
+		},
+		"error if an environment of the pipeline doesn't exist": {
+			setupMocks: func() {
+				mockWs.EXPECT().ReadPipelineManifest("pipeline-phonetool").Return([]byte(testPipelineManifest), nil)
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(nil, mockError)
+			},
+			wantedErr: fmt.Errorf("get environment test in project phonetool: %w", mockError),
+		},
+		"error if an application of the pipeline is not in the workspace": {
+			setupMocks: func() {
+				mockWs.EXPECT().ReadPipelineManifest("pipeline-phonetool").Return([]byte(testPipelineManifest+"apps: [backend]\n"), nil)
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+				mockStore.EXPECT().GetEnvironment("phonetool", "prod").Return(prodEnv, nil)
+				mockWs.EXPECT().AppNames().Return([]string{"frontend"}, nil)
+			},
+			wantedErr: errors.New("application backend of pipeline pipeline-phonetool does not exist in your workspace"),
+		},
+		"error if the repository of the application doesn't exist": {
+			setupMocks: func() {
+				mockWs.EXPECT().ReadPipelineManifest("pipeline-phonetool").Return([]byte(testPipelineManifest), nil)
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+				mockStore.EXPECT().GetEnvironment("phonetool", "prod").Return(prodEnv, nil)
+				mockWs.EXPECT().AppNames().Return([]string{"frontend"}, nil)
+				mockWs.EXPECT().ReadAppManifest("frontend").Return([]byte(testAppManifest), nil)
+				mockStore.EXPECT().GetProject("phonetool").Return(testProject, nil)
+				mockDescriber.EXPECT().GetProjectResourcesByRegion(testProject, "us-west-2").Return(&archer.ProjectRegionalResources{
+					RepositoryURLs: map[string]string{},
+				}, nil)
+			},
+			wantedErr: &errRepoNotFound{
+				appName:       "frontend",
+				envRegion:     "us-west-2",
+				projAccountID: "1234",
+			},
+		},
+		"error if fail to build the image": {
+			setupMocks: func() {
+				mockWs.EXPECT().ReadPipelineManifest("pipeline-phonetool").Return([]byte(testPipelineManifest), nil)
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+				mockStore.EXPECT().GetEnvironment("phonetool", "prod").Return(prodEnv, nil)
+				mockWs.EXPECT().AppNames().Return([]string{"frontend"}, nil)
+				mockWs.EXPECT().ReadAppManifest("frontend").Return([]byte(testAppManifest), nil)
+				mockStore.EXPECT().GetProject("phonetool").Return(testProject, nil)
+				mockDescriber.EXPECT().GetProjectResourcesByRegion(testProject, "us-west-2").Return(&archer.ProjectRegionalResources{
+					RepositoryURLs: map[string]string{"frontend": westRepo},
+				}, nil)
+				mockDescriber.EXPECT().GetProjectResourcesByRegion(testProject, "us-east-1").Return(&archer.ProjectRegionalResources{
+					RepositoryURLs: map[string]string{"frontend": eastRepo},
+				}, nil)
+				mockDocker.EXPECT().Build(westRepo, "1a2b3c", "frontend").Return(mockError)
+			},
+			wantedErr: fmt.Errorf("build Dockerfile at frontend with tag 1a2b3c: %w", mockError),
+		},
+		"builds the image once, pushes it to each region and writes the templates": {
+			setupMocks: func() {
+				mockWs.EXPECT().ReadPipelineManifest("pipeline-phonetool").Return([]byte(testPipelineManifest), nil)
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil).Times(2)
+				mockStore.EXPECT().GetEnvironment("phonetool", "prod").Return(prodEnv, nil).Times(2)
+				mockWs.EXPECT().AppNames().Return([]string{"frontend"}, nil)
+				mockWs.EXPECT().ReadAppManifest("frontend").Return([]byte(testAppManifest), nil).Times(3)
+				mockStore.EXPECT().GetProject("phonetool").Return(testProject, nil).Times(3)
+				mockDescriber.EXPECT().GetProjectResourcesByRegion(testProject, "us-west-2").Return(&archer.ProjectRegionalResources{
+					RepositoryURLs: map[string]string{"frontend": westRepo},
+				}, nil).Times(2)
+				mockDescriber.EXPECT().GetProjectResourcesByRegion(testProject, "us-east-1").Return(&archer.ProjectRegionalResources{
+					RepositoryURLs: map[string]string{"frontend": eastRepo},
+				}, nil).Times(2)
+				mockECR.EXPECT().GetECRAuth().Return(ecr.Auth{Username: "AWS", Password: "secret"}, nil).Times(2)
+				gomock.InOrder(
+					mockDocker.EXPECT().Build(westRepo, "1a2b3c", "frontend").Return(nil),
+					mockDocker.EXPECT().Login(westRepo, "AWS", "secret").Return(nil),
+					mockDocker.EXPECT().Push(westRepo, "1a2b3c").Return(nil),
+					mockDocker.EXPECT().Tag(westRepo, "1a2b3c", eastRepo).Return(nil),
+					mockDocker.EXPECT().Login(eastRepo, "AWS", "secret").Return(nil),
+					mockDocker.EXPECT().Push(eastRepo, "1a2b3c").Return(nil),
+				)
+			},
+			wantedFiles: []string{
+				"infrastructure/frontend.stack.yml",
+				"infrastructure/frontend-test.params.json",
+				"infrastructure/frontend-prod.params.json",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWs = climocks.NewMockwsAppPipelineReader(ctrl)
+			mockStore = climocks.NewMockprojectService(ctrl)
+			mockDescriber = climocks.NewMockprojectResourcesGetter(ctrl)
+			mockDocker = climocks.NewMockdockerService(ctrl)
+			mockECR = climocks.NewMockecrService(ctrl)
+			tc.setupMocks()
+
+			fs := &afero.Afero{Fs: afero.NewMemMapFs()}
+			var initializedRegions []string
+			opts := &buildAndPushAppOpts{
+				buildAndPushAppVars: buildAndPushAppVars{
+					GlobalOpts:   &GlobalOpts{projectName: "phonetool"},
+					PipelineName: "pipeline-phonetool",
+					Tag:          "1a2b3c",
+					OutputDir:    "infrastructure",
+				},
+				ws:            mockWs,
+				store:         mockStore,
+				describer:     mockDescriber,
+				dockerService: mockDocker,
+				fs:            fs,
+				initECRService: func(region string) (ecrService, error) {
+					initializedRegions = append(initializedRegions, region)
+					return mockECR, nil
+				},
+				ecrServiceByRegion: make(map[string]ecrService),
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.True(t, errors.Is(err, tc.wantedErr) || err.Error() == tc.wantedErr.Error(), "expected %v but got %v", tc.wantedErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []string{"us-west-2", "us-east-1"}, initializedRegions)
+			for _, file := range tc.wantedFiles {
+				exists, err := fs.Exists(file)
+				require.NoError(t, err)
+				require.True(t, exists, "expected file %s to be written", file)
+			}
+		})
+	}
+}
@@ -53,6 +53,7 @@ type appDeployOpts struct {
 	runner             runner
 	appPackageCfClient projectResourcesGetter
 	appDeployCfClient  cloudformation.CloudFormation
+	envDescriber       envHTTPSDescriber
 	sessProvider       sessionProvider
 
 	spinner progress
@@ -127,6 +128,10 @@ func (o *appDeployOpts) Execute() error {
 		return err
 	}
 
+	if err := o.validateAliases(); err != nil {
+		return err
+	}
+
 	repoName := fmt.Sprintf("%s/%s", o.projectName, o.AppName)
 
 	uri, err := o.ecrService.GetRepository(repoName)
@@ -328,6 +333,12 @@ func (o *appDeployOpts) configureClients() error {
 	// app deploy CF client against env account profile AND target environment region
 	o.appDeployCfClient = cloudformation.New(envSession)
 
+	envDescriber, err := describe.NewEnvDescriber(o.ProjectName(), o.targetEnvironment.Name)
+	if err != nil {
+		return fmt.Errorf("create describer for environment %s: %w", o.targetEnvironment.Name, err)
+	}
+	o.envDescriber = envDescriber
+
 	// app package CF client against tools account
 	appPackageCfSess, err := o.sessProvider.Default()
 	if err != nil {
@@ -368,6 +379,36 @@ func (o *appDeployOpts) applyAppDeployTemplate(template, stackName, changeSetNam
 	return nil
 }
 
+// validateAliases returns an error if the application has aliases in the target environment
+// but the environment doesn't have an HTTPS listener to serve them.
+func (o *appDeployOpts) validateAliases() error {
+	manifestBytes, err := o.workspaceService.ReadAppManifest(o.AppName)
+	if err != nil {
+		return fmt.Errorf("read manifest file %s: %w", o.AppName, err)
+	}
+	mft, err := manifest.UnmarshalApp(manifestBytes)
+	if err != nil {
+		return fmt.Errorf("unmarshal app manifest: %w", err)
+	}
+	lbMft, ok := mft.(*manifest.LBFargateManifest)
+	if !ok {
+		return nil
+	}
+	aliases := lbMft.EnvConf(o.targetEnvironment.Name).Aliases
+	if len(aliases) == 0 {
+		return nil
+	}
+	hasHTTPS, err := o.envDescriber.HasHTTPSListener()
+	if err != nil {
+		return fmt.Errorf("check the HTTPS listener of environment %s: %w", o.targetEnvironment.Name, err)
+	}
+	if !hasHTTPS {
+		return fmt.Errorf("environment %s has no HTTPS listener to serve the aliases %s of application %s: add the certificateARN of an ACM certificate to the environment manifest and run %s, or remove the aliases",
+			o.targetEnvironment.Name, strings.Join(aliases, ", "), o.AppName, color.HighlightCode("ecs-preview env deploy"))
+	}
+	return nil
+}
+
 func (o *appDeployOpts) getAppDockerfilePath() (string, error) {
 	manifestBytes, err := o.workspaceService.ReadAppManifest(o.AppName)
 	if err != nil {
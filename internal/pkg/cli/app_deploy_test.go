@@ -341,6 +341,94 @@ image:
 	}
 }
 
+func TestAppDeployOpts_validateAliases(t *testing.T) {
+	mockManifest := []byte(`name: appA
+type: 'Load Balanced Web App'
+image:
+  build: appA/Dockerfile
+http:
+  path: '/'
+environments:
+  prod:
+    http:
+      alias: [www.example.com, example.com]
+`)
+
+	tests := map[string]struct {
+		inEnv         string
+		mockWorkspace func(m *climocks.MockwsAppReader)
+		mockDescriber func(m *climocks.MockenvHTTPSDescriber)
+
+		wantedErr error
+	}{
+		"should not check the environment if the app has no aliases in it": {
+			inEnv: "test",
+			mockWorkspace: func(m *climocks.MockwsAppReader) {
+				m.EXPECT().ReadAppManifest("appA").Return(mockManifest, nil)
+			},
+			mockDescriber: func(m *climocks.MockenvHTTPSDescriber) {
+				m.EXPECT().HasHTTPSListener().Times(0)
+			},
+		},
+		"should return error if fail to check the HTTPS listener": {
+			inEnv: "prod",
+			mockWorkspace: func(m *climocks.MockwsAppReader) {
+				m.EXPECT().ReadAppManifest("appA").Return(mockManifest, nil)
+			},
+			mockDescriber: func(m *climocks.MockenvHTTPSDescriber) {
+				m.EXPECT().HasHTTPSListener().Return(false, errors.New("some error"))
+			},
+			wantedErr: errors.New("check the HTTPS listener of environment prod: some error"),
+		},
+		"should return error if the environment has no HTTPS listener": {
+			inEnv: "prod",
+			mockWorkspace: func(m *climocks.MockwsAppReader) {
+				m.EXPECT().ReadAppManifest("appA").Return(mockManifest, nil)
+			},
+			mockDescriber: func(m *climocks.MockenvHTTPSDescriber) {
+				m.EXPECT().HasHTTPSListener().Return(false, nil)
+			},
+			wantedErr: errors.New("environment prod has no HTTPS listener to serve the aliases www.example.com, example.com of application appA: add the certificateARN of an ACM certificate to the environment manifest and run `ecs-preview env deploy`, or remove the aliases"),
+		},
+		"should succeed if the environment has an HTTPS listener": {
+			inEnv: "prod",
+			mockWorkspace: func(m *climocks.MockwsAppReader) {
+				m.EXPECT().ReadAppManifest("appA").Return(mockManifest, nil)
+			},
+			mockDescriber: func(m *climocks.MockenvHTTPSDescriber) {
+				m.EXPECT().HasHTTPSListener().Return(true, nil)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWorkspace := climocks.NewMockwsAppReader(ctrl)
+			mockDescriber := climocks.NewMockenvHTTPSDescriber(ctrl)
+			tc.mockWorkspace(mockWorkspace)
+			tc.mockDescriber(mockDescriber)
+			opts := appDeployOpts{
+				appDeployVars: appDeployVars{
+					AppName: "appA",
+				},
+				workspaceService:  mockWorkspace,
+				envDescriber:      mockDescriber,
+				targetEnvironment: &archer.Environment{Name: tc.inEnv},
+			}
+
+			err := opts.validateAliases()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestAppDeployOpts_askImageTag(t *testing.T) {
 	var mockRunner *climocks.Mockrunner
 	var mockPrompter *climocks.Mockprompter
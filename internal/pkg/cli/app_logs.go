@@ -7,14 +7,18 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"time"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/cloudwatchlogs"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/session"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	termprogress "github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
@@ -25,8 +29,13 @@ const (
 	applicationLogAppNameHelpPrompt     = "The logs of a deployed application will be shown."
 
 	logGroupNamePattern    = "/ecs/%s-%s-%s"
+	logStreamNamePattern   = "ecs/%s/%s"
 	cwGetLogEventsLimitMin = 1
 	cwGetLogEventsLimitMax = 10000
+	// cwFilterLogStreamNamesMax is the maximum number of log streams FilterLogEvents can search in by name.
+	cwFilterLogStreamNamesMax = 100
+	// followLookback is how far back the logs are shown before following new ones when no start time is given.
+	followLookback = time.Minute
 )
 
 type appEnv struct {
@@ -42,11 +51,19 @@ type appLogsVars struct {
 	shouldOutputJSON bool
 	follow           bool
 	limit            int
-	appName          string
-	envName          string
+	appNames         []string
+	envNames         []string
+	all              bool
 	humanStartTime   string
 	humanEndTime     string
 	since            time.Duration
+	filterPattern    string
+	taskIDs          []string
+	logStreamPrefix  string
+	fields           []string
+	raw              bool
+	outputDir        string
+	merge            bool
 	*GlobalOpts
 }
 
@@ -56,11 +73,18 @@ type appLogsOpts struct {
 	// internal states
 	startTime int64
 	endTime   int64
-
-	w             io.Writer
-	storeSvc      storeReader
-	initCwLogsSvc func(*appLogsOpts, *archer.Environment) error // Overriden in tests.
-	cwlogsSvc     map[string]cwlogService
+	targets   []*appEnv // Deployed applications and environments to show the logs of.
+
+	w                  io.Writer
+	storeSvc           storeReader
+	initCwLogsSvc      func(*appLogsOpts, *archer.Environment) error // Overriden in tests.
+	cwlogsSvc          map[string]cwlogService                       // Keyed by environment name.
+	initTasksDescriber func(*appLogsOpts, *appEnv) error             // Overriden in tests.
+	tasksDescribers    map[string]runningTasksDescriber              // Keyed by appEnv.String().
+	fs                 afero.Fs
+	prog               progress
+	sleep              func(time.Duration) // Overriden in tests.
+	now                func() time.Time    // Overriden in tests.
 }
 
 func newAppLogOpts(vars appLogsVars) (*appLogsOpts, error) {
@@ -82,6 +106,23 @@ func newAppLogOpts(vars appLogsVars) (*appLogsOpts, error) {
 			return nil
 		},
 		cwlogsSvc: make(map[string]cwlogService),
+		initTasksDescriber: func(o *appLogsOpts, target *appEnv) error {
+			env, err := o.storeSvc.GetEnvironment(o.ProjectName(), target.envName)
+			if err != nil {
+				return fmt.Errorf("get environment: %w", err)
+			}
+			d, err := describe.NewAppTasksDescriber(env, target.appName)
+			if err != nil {
+				return fmt.Errorf("creating describer for the tasks of application %s: %w", target.appName, err)
+			}
+			o.tasksDescribers[target.String()] = d
+			return nil
+		},
+		tasksDescribers: make(map[string]runningTasksDescriber),
+		fs:              &afero.Afero{Fs: afero.NewOsFs()},
+		prog:            termprogress.NewSpinner(),
+		sleep:           time.Sleep,
+		now:             time.Now,
 	}, nil
 }
 
@@ -102,6 +143,26 @@ func (o *appLogsOpts) Validate() error {
 		return errors.New("only one of --follow or --end-time may be used")
 	}
 
+	if len(o.taskIDs) != 0 && o.logStreamPrefix != "" {
+		return errors.New("only one of --task-id or --log-stream-prefix may be used")
+	}
+
+	if len(o.fields) != 0 && o.raw {
+		return errors.New("only one of --fields or --raw may be used")
+	}
+
+	if o.outputDir != "" && o.follow {
+		return errors.New("only one of --follow or --output-dir may be used")
+	}
+
+	if o.merge && o.outputDir == "" {
+		return errors.New("--merge requires --output-dir")
+	}
+
+	if len(o.taskIDs) != 0 && (o.all || len(o.appNames) > 1) {
+		return errors.New("--task-id may only be used with a single application")
+	}
+
 	if o.since != 0 {
 		if o.since < 0 {
 			return fmt.Errorf("--since must be greater than 0")
@@ -143,28 +204,139 @@ func (o *appLogsOpts) Ask() error {
 
 // Execute shows the applications through the prompt.
 func (o *appLogsOpts) Execute() error {
-	logGroupName := fmt.Sprintf(logGroupNamePattern, o.ProjectName(), o.envName, o.appName)
-	logEventsOutput := &cloudwatchlogs.LogEventsOutput{
-		NextTokens: nil,
+	if o.outputDir != "" {
+		return o.exportLogs()
+	}
+	if o.follow || o.shouldFilter() {
+		return o.filterLogs()
 	}
-	var err error
-	for {
-		logEventsOutput, err = o.cwlogsSvc[o.envName].TaskLogEvents(logGroupName, logEventsOutput.NextTokens, o.generateGetLogEventOpts()...)
+	var events []*cloudwatchlogs.Event
+	for _, target := range o.targets {
+		logEventsOutput, err := o.cwlogsSvc[target.envName].TaskLogEvents(o.logGroupName(target), nil, o.generateGetLogEventOpts()...)
 		if err != nil {
 			return err
 		}
-		if err := o.outputLogs(logEventsOutput.Events); err != nil {
+		events = append(events, o.labelEvents(target, logEventsOutput.Events)...)
+	}
+	return o.outputLogs(o.latestEvents(events))
+}
+
+// filterLogs searches the log events across the log streams of the log groups with FilterLogEvents.
+// When following logs, it polls for events newer than the last ones returned and, unless the user picked
+// the log streams, only searches the log streams of the running tasks.
+func (o *appLogsOpts) filterLogs() error {
+	if o.followsRunningTasks() {
+		for _, target := range o.targets {
+			if err := o.initTasksDescriber(o, target); err != nil {
+				return err
+			}
+		}
+	}
+	if o.follow && o.startTime == 0 {
+		// Start following from the recent logs instead of searching the whole history of the log groups.
+		o.startTime = o.now().Add(-followLookback).UnixNano() / int64(time.Millisecond)
+	}
+	cursors := make(map[string]*cloudwatchlogs.LogEventsCursor)
+	backoff := &cloudwatchlogs.Backoff{}
+	for first := true; ; first = false {
+		var events []*cloudwatchlogs.Event
+		throttled := false
+		for _, target := range o.targets {
+			out, err := o.filterAppEnvLogs(target, cursors[target.String()])
+			if err != nil {
+				if o.follow && cloudwatchlogs.IsThrottled(err) {
+					// Keep the cursor to search for the same events at the next poll.
+					throttled = true
+					continue
+				}
+				return err
+			}
+			events = append(events, o.labelEvents(target, out.Events)...)
+			cursors[target.String()] = out.Cursor
+		}
+		if first {
+			events = o.latestEvents(events)
+		} else {
+			// Never drop the new events while following.
+			sortEvents(events)
+		}
+		if err := o.outputLogs(events); err != nil {
 			return err
 		}
 		if !o.follow {
 			return nil
 		}
-		// for unit test.
-		if logEventsOutput.NextTokens == nil {
-			return nil
+		o.sleep(backoff.Next(throttled))
+	}
+}
+
+// filterAppEnvLogs returns the log events of an application in an environment that are newer than the cursor.
+func (o *appLogsOpts) filterAppEnvLogs(target *appEnv, cursor *cloudwatchlogs.LogEventsCursor) (*cloudwatchlogs.FilteredLogEventsOutput, error) {
+	streams := o.taskLogStreams(target.appName, o.taskIDs)
+	if o.followsRunningTasks() {
+		taskIDs, err := o.tasksDescribers[target.String()].RunningTaskIDs()
+		if err != nil {
+			return nil, fmt.Errorf("list running tasks of application %s in environment %s: %w", target.appName, target.envName, err)
+		}
+		if len(taskIDs) == 0 {
+			// No task is writing logs, wait for new tasks to start.
+			return &cloudwatchlogs.FilteredLogEventsOutput{
+				Cursor: cursor,
+			}, nil
 		}
-		time.Sleep(cloudwatchlogs.SleepDuration)
+		if len(taskIDs) <= cwFilterLogStreamNamesMax {
+			streams = o.taskLogStreams(target.appName, taskIDs)
+		}
+	}
+	return o.cwlogsSvc[target.envName].FilterTaskLogEvents(o.logGroupName(target), cursor, o.generateFilterLogEventOpts(streams)...)
+}
+
+// followsRunningTasks returns true if the logs of the running tasks should be followed.
+func (o *appLogsOpts) followsRunningTasks() bool {
+	return o.follow && len(o.taskIDs) == 0 && o.logStreamPrefix == ""
+}
+
+// taskLogStreams returns the names of the log streams of the tasks of an application.
+func (o *appLogsOpts) taskLogStreams(appName string, taskIDs []string) []string {
+	var streams []string
+	for _, taskID := range taskIDs {
+		// The awslogs driver names the log streams "ecs/{container name}/{task ID}".
+		streams = append(streams, fmt.Sprintf(logStreamNamePattern, appName, taskID))
+	}
+	return streams
+}
+
+func (o *appLogsOpts) logGroupName(target *appEnv) string {
+	return fmt.Sprintf(logGroupNamePattern, o.ProjectName(), target.envName, target.appName)
+}
+
+// labelEvents tags the events with their application and environment when the logs of several of them are interleaved.
+func (o *appLogsOpts) labelEvents(target *appEnv, events []*cloudwatchlogs.Event) []*cloudwatchlogs.Event {
+	if len(o.targets) < 2 {
+		return events
+	}
+	for _, event := range events {
+		event.AppName = target.appName
+		event.EnvName = target.envName
 	}
+	return events
+}
+
+// latestEvents merges the events of the log groups in timestamp order and keeps the latest ones up to the limit.
+func (o *appLogsOpts) latestEvents(events []*cloudwatchlogs.Event) []*cloudwatchlogs.Event {
+	sortEvents(events)
+	if len(events) > o.limit {
+		return events[len(events)-o.limit:]
+	}
+	return events
+}
+
+func sortEvents(events []*cloudwatchlogs.Event) {
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp < events[j].Timestamp })
+}
+
+func (o *appLogsOpts) shouldFilter() bool {
+	return o.filterPattern != "" || len(o.taskIDs) != 0 || o.logStreamPrefix != ""
 }
 
 func (o *appLogsOpts) askProject() error {
@@ -204,78 +376,81 @@ func (o *appLogsOpts) generateGetLogEventOpts() []cloudwatchlogs.GetLogEventsOpt
 	return opts
 }
 
-func (o *appLogsOpts) askAppEnvName() error {
-	var appNames []string
-	var envs []*archer.Environment
-	var err error
-	if o.appName == "" {
-		appNames, err = o.retrieveAllAppNames()
-		if err != nil {
-			return err
-		}
-		if len(appNames) == 0 {
-			return fmt.Errorf("no applications found in project %s", color.HighlightUserInput(o.ProjectName()))
-		}
-	} else {
-		app, err := o.storeSvc.GetApplication(o.ProjectName(), o.appName)
-		if err != nil {
-			return fmt.Errorf("get application: %w", err)
-		}
-		appNames = []string{app.Name}
+func (o *appLogsOpts) generateFilterLogEventOpts(streams []string) []cloudwatchlogs.FilterLogEventsOpts {
+	opts := []cloudwatchlogs.FilterLogEventsOpts{
+		cloudwatchlogs.WithFilterLimit(o.limit),
+	}
+	if o.filterPattern != "" {
+		opts = append(opts, cloudwatchlogs.WithFilterPattern(o.filterPattern))
+	}
+	if len(streams) != 0 {
+		opts = append(opts, cloudwatchlogs.WithLogStreamNames(streams))
+	}
+	if o.logStreamPrefix != "" {
+		opts = append(opts, cloudwatchlogs.WithLogStreamNamePrefix(o.logStreamPrefix))
+	}
+	if o.startTime != 0 {
+		opts = append(opts, cloudwatchlogs.WithFilterStartTime(o.startTime))
+	}
+	if o.endTime != 0 {
+		opts = append(opts, cloudwatchlogs.WithFilterEndTime(o.endTime))
 	}
+	return opts
+}
 
-	if o.envName == "" {
-		envs, err = o.storeSvc.ListEnvironments(o.ProjectName())
-		if err != nil {
-			return fmt.Errorf("list environments: %w", err)
-		}
-		if len(envs) == 0 {
-			return fmt.Errorf("no environments found in project %s", color.HighlightUserInput(o.ProjectName()))
-		}
-	} else {
-		env, err := o.storeSvc.GetEnvironment(o.ProjectName(), o.envName)
-		if err != nil {
-			return fmt.Errorf("get environment: %w", err)
-		}
-		envs = []*archer.Environment{env}
+func (o *appLogsOpts) askAppEnvName() error {
+	appNames, err := o.selectedAppNames()
+	if err != nil {
+		return err
+	}
+	envs, err := o.selectedEnvs()
+	if err != nil {
+		return err
 	}
 
-	appEnvs := make(map[string]appEnv)
-	var appEnvNames []string
+	var deployed []*appEnv
 	for _, appName := range appNames {
 		for _, env := range envs {
-			if err := o.initCwLogsSvc(o, env); err != nil {
-				return err
+			if _, ok := o.cwlogsSvc[env.Name]; !ok {
+				if err := o.initCwLogsSvc(o, env); err != nil {
+					return err
+				}
 			}
-			deployed, err := o.cwlogsSvc[env.Name].LogGroupExists(fmt.Sprintf(logGroupNamePattern, o.ProjectName(), env.Name, appName))
+			exists, err := o.cwlogsSvc[env.Name].LogGroupExists(fmt.Sprintf(logGroupNamePattern, o.ProjectName(), env.Name, appName))
 			if err != nil {
 				return fmt.Errorf("check if the log group exists: %w", err)
 			}
-			if !deployed {
+			if !exists {
 				continue
 			}
-			appEnv := appEnv{
+			deployed = append(deployed, &appEnv{
 				appName: appName,
 				envName: env.Name,
-			}
-			appEnvName := appEnv.String()
-			appEnvs[appEnvName] = appEnv
-			appEnvNames = append(appEnvNames, appEnvName)
+			})
 		}
 	}
-	if len(appEnvNames) == 0 {
+	if len(deployed) == 0 {
 		return fmt.Errorf("no deployed applications found in project %s", color.HighlightUserInput(o.ProjectName()))
 	}
 
-	// return if only one deployed app found
-	if len(appEnvNames) == 1 {
-		log.Infof("Only found one deployed app, defaulting to: %s\n", color.HighlightUserInput(appEnvNames[0]))
-		o.appName = appEnvs[appEnvNames[0]].appName
-		o.envName = appEnvs[appEnvNames[0]].envName
+	if o.showsMultipleAppEnvs() {
+		o.targets = deployed
+		return nil
+	}
 
+	// return if only one deployed app found
+	if len(deployed) == 1 {
+		log.Infof("Only found one deployed app, defaulting to: %s\n", color.HighlightUserInput(deployed[0].String()))
+		o.targets = deployed
 		return nil
 	}
 
+	appEnvs := make(map[string]*appEnv)
+	var appEnvNames []string
+	for _, appEnv := range deployed {
+		appEnvs[appEnv.String()] = appEnv
+		appEnvNames = append(appEnvNames, appEnv.String())
+	}
 	appEnvName, err := o.prompt.SelectOne(
 		fmt.Sprintf(applicationLogAppNamePrompt),
 		applicationLogAppNameHelpPrompt,
@@ -284,12 +459,61 @@ func (o *appLogsOpts) askAppEnvName() error {
 	if err != nil {
 		return fmt.Errorf("select deployed applications for project %s: %w", o.ProjectName(), err)
 	}
-	o.appName = appEnvs[appEnvName].appName
-	o.envName = appEnvs[appEnvName].envName
+	o.targets = []*appEnv{appEnvs[appEnvName]}
 
 	return nil
 }
 
+// showsMultipleAppEnvs returns true if the logs of all the deployed applications and environments matching the flags are shown,
+// instead of picking a single one.
+func (o *appLogsOpts) showsMultipleAppEnvs() bool {
+	return o.all || len(o.appNames) > 1 || len(o.envNames) > 1
+}
+
+func (o *appLogsOpts) selectedAppNames() ([]string, error) {
+	if len(o.appNames) == 0 {
+		appNames, err := o.retrieveAllAppNames()
+		if err != nil {
+			return nil, err
+		}
+		if len(appNames) == 0 {
+			return nil, fmt.Errorf("no applications found in project %s", color.HighlightUserInput(o.ProjectName()))
+		}
+		return appNames, nil
+	}
+	var appNames []string
+	for _, name := range o.appNames {
+		app, err := o.storeSvc.GetApplication(o.ProjectName(), name)
+		if err != nil {
+			return nil, fmt.Errorf("get application: %w", err)
+		}
+		appNames = append(appNames, app.Name)
+	}
+	return appNames, nil
+}
+
+func (o *appLogsOpts) selectedEnvs() ([]*archer.Environment, error) {
+	if len(o.envNames) == 0 {
+		envs, err := o.storeSvc.ListEnvironments(o.ProjectName())
+		if err != nil {
+			return nil, fmt.Errorf("list environments: %w", err)
+		}
+		if len(envs) == 0 {
+			return nil, fmt.Errorf("no environments found in project %s", color.HighlightUserInput(o.ProjectName()))
+		}
+		return envs, nil
+	}
+	var envs []*archer.Environment
+	for _, name := range o.envNames {
+		env, err := o.storeSvc.GetEnvironment(o.ProjectName(), name)
+		if err != nil {
+			return nil, fmt.Errorf("get environment: %w", err)
+		}
+		envs = append(envs, env)
+	}
+	return envs, nil
+}
+
 func (o *appLogsOpts) retrieveProjectNames() ([]string, error) {
 	projs, err := o.storeSvc.ListProjects()
 	if err != nil {
@@ -317,8 +541,9 @@ func (o *appLogsOpts) retrieveAllAppNames() ([]string, error) {
 
 func (o *appLogsOpts) outputLogs(logs []*cloudwatchlogs.Event) error {
 	if !o.shouldOutputJSON {
+		opts := o.generateHumanStringOpts()
 		for _, log := range logs {
-			fmt.Fprintf(o.w, log.HumanString())
+			fmt.Fprint(o.w, log.HumanString(opts...))
 		}
 		return nil
 	}
@@ -332,9 +557,20 @@ func (o *appLogsOpts) outputLogs(logs []*cloudwatchlogs.Event) error {
 	return nil
 }
 
+func (o *appLogsOpts) generateHumanStringOpts() []cloudwatchlogs.HumanStringOpts {
+	var opts []cloudwatchlogs.HumanStringOpts
+	if len(o.fields) != 0 {
+		opts = append(opts, cloudwatchlogs.WithFields(o.fields))
+	}
+	if o.raw {
+		opts = append(opts, cloudwatchlogs.WithRawMessage())
+	}
+	return opts
+}
+
 func (o *appLogsOpts) parseSince() int64 {
 	sinceSec := int64(o.since.Round(time.Second).Seconds())
-	timeNow := time.Now().Add(time.Duration(-sinceSec) * time.Second)
+	timeNow := o.now().Add(time.Duration(-sinceSec) * time.Second)
 	return timeNow.Unix() * 1000
 }
 
@@ -358,10 +594,22 @@ func BuildAppLogsCmd() *cobra.Command {
 		Example: `
   Displays logs of the application "my-app" in environment "test"
 	/code $ ecs-preview app logs -n my-app -e test
+  Displays the interleaved logs of the applications "frontend" and "backend" in environment "test"
+	/code $ ecs-preview app logs -n frontend,backend -e test --follow
+  Displays logs of all the deployed applications in all the environments
+	/code $ ecs-preview app logs --all
   Displays logs in the last hour
 	/code $ ecs-preview app logs --since 1h
   Displays logs from 2006-01-02T15:04:05 to 2006-01-02T15:05:05
-	/code $ ecs-preview app logs --start-time 2006-01-02T15:04:05+00:00 --end-time 2006-01-02T15:05:05+00:00`,
+	/code $ ecs-preview app logs --start-time 2006-01-02T15:04:05+00:00 --end-time 2006-01-02T15:05:05+00:00
+  Follows the logs of the running tasks
+	/code $ ecs-preview app logs --follow
+  Follows the logs containing "ERROR" of the task "1cc0685ad01d4d0f8e4e2c00d1775c56"
+	/code $ ecs-preview app logs --filter ERROR --task-id 1cc0685ad01d4d0f8e4e2c00d1775c56 --follow
+  Displays the "status" and "path" keys of JSON log messages
+	/code $ ecs-preview app logs --fields status,path
+  Exports the logs of an hour to one file per task, run it again to resume an interrupted export
+	/code $ ecs-preview app logs --start-time 2006-01-02T15:00:00+00:00 --end-time 2006-01-02T16:00:00+00:00 --output-dir ./postmortem`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newAppLogOpts(vars)
 			if err != nil {
@@ -377,14 +625,24 @@ func BuildAppLogsCmd() *cobra.Command {
 		}),
 	}
 	// The flags bound by viper are available to all sub-commands through viper.GetString({flagName})
-	cmd.Flags().StringVarP(&vars.appName, nameFlag, nameFlagShort, "", appFlagDescription)
-	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringSliceVarP(&vars.appNames, nameFlag, nameFlagShort, nil, appLogsNamesFlagDescription)
+	cmd.Flags().StringSliceVarP(&vars.envNames, envFlag, envFlagShort, nil, appLogsEnvsFlagDescription)
+	cmd.Flags().BoolVar(&vars.all, allFlag, false, appLogsAllFlagDescription)
 	cmd.Flags().StringVar(&vars.humanStartTime, startTimeFlag, "", startTimeFlagDescription)
 	cmd.Flags().StringVar(&vars.humanEndTime, endTimeFlag, "", endTimeFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
 	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
 	cmd.Flags().DurationVar(&vars.since, sinceFlag, 0, sinceFlagDescription)
 	cmd.Flags().IntVar(&vars.limit, limitFlag, 10, limitFlagDescription)
+	cmd.Flags().StringVar(&vars.filterPattern, filterFlag, "", filterFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.taskIDs, taskIDFlag, nil, taskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.logStreamPrefix, logStreamPrefixFlag, "", logStreamPrefixFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.fields, fieldsFlag, nil, fieldsFlagDescription)
+	cmd.Flags().BoolVar(&vars.raw, rawFlag, false, rawFlagDescription)
+	cmd.Flags().StringVar(&vars.outputDir, stackOutputDirFlag, "", exportOutputDirFlagDescription)
+	cmd.Flags().BoolVar(&vars.merge, mergeFlag, false, mergeFlagDescription)
 	cmd.Flags().StringVarP(&vars.projectName, projectFlag, projectFlagShort, "", projectFlagDescription)
+
+	cmd.AddCommand(BuildAppLogsQueryCmd())
 	return cmd
 }
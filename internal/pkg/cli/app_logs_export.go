@@ -0,0 +1,400 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	"github.com/spf13/afero"
+)
+
+const (
+	exportProgressFileName = ".export-progress.json"
+	exportMergedFileName   = "logs.ndjson"
+	exportPartsDirName     = ".merge"       // Holds the events of each application and environment until they're merged.
+	fmtExportTaskFileName  = "%s.ndjson"    // Named after the task ID.
+	fmtExportPartFileName  = "%s-%s.ndjson" // Named after the application and the environment.
+
+	exportMaxLineSize = 2 * 1024 * 1024 // Log events are up to 256 KB, escaping their message can make them longer.
+
+	fmtExportLogsStart      = "Exporting the logs of %s in environment %s."
+	fmtExportLogsInProgress = "Exporting the logs of %s in environment %s, %d log events so far."
+	fmtExportLogsFailed     = "Failed to export the logs of %s in environment %s."
+	fmtExportLogsComplete   = "Exported %d log events of %s in environment %s."
+	fmtExportLogsSkipped    = "Already exported the logs of %s in environment %s.\n"
+)
+
+// exportProgress records the flags of an export and how far it went, so that an interrupted export can be resumed.
+type exportProgress struct {
+	StartTime       int64                            `json:"startTime"`
+	EndTime         int64                            `json:"endTime"`
+	FilterPattern   string                           `json:"filterPattern,omitempty"`
+	TaskIDs         []string                         `json:"taskIDs,omitempty"`
+	LogStreamPrefix string                           `json:"logStreamPrefix,omitempty"`
+	Merge           bool                             `json:"merge"`
+	Targets         map[string]*exportTargetProgress `json:"targets"` // Keyed by appEnv.String().
+	// Sizes of the exported files when the progress was saved, keyed by their path in the output directory.
+	// Resuming truncates the files to these sizes so that a page written after the last save isn't duplicated.
+	Files map[string]int64 `json:"files,omitempty"`
+}
+
+type exportTargetProgress struct {
+	NextToken string `json:"nextToken,omitempty"` // Pagination token of the next page of log events to export.
+	Events    int    `json:"events"`
+	Done      bool   `json:"done"`
+}
+
+// exportLogs writes all the log events of the time window to NDJSON files in the output directory,
+// one file per task or a single merged file. The pagination tokens are saved along with the sizes of
+// the files after each page so that running the same command again resumes an interrupted export.
+// Merged exports write the events of each application and environment to their own file first, and
+// interleave them in timestamp order once they're all exported.
+func (o *appLogsOpts) exportLogs() error {
+	if err := o.fs.MkdirAll(o.outputDir, 0755); err != nil {
+		return fmt.Errorf("create directory %s: %w", o.outputDir, err)
+	}
+	progress, resumed, err := o.loadExportProgress()
+	if err != nil {
+		return err
+	}
+
+	files := make(map[string]afero.File) // Keyed by file path.
+	defer func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}()
+	for _, target := range o.targets {
+		if err := o.exportAppEnvLogs(target, progress, files, resumed); err != nil {
+			return err
+		}
+	}
+	for path, f := range files {
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("close file %s: %w", path, err)
+		}
+		delete(files, path)
+	}
+	if o.merge {
+		if err := o.mergeExportedFiles(); err != nil {
+			return err
+		}
+	}
+	// The export is complete, running the command again starts a new export.
+	if err := o.fs.Remove(filepath.Join(o.outputDir, exportProgressFileName)); err != nil {
+		return fmt.Errorf("remove export progress file: %w", err)
+	}
+	return nil
+}
+
+func (o *appLogsOpts) exportAppEnvLogs(target *appEnv, progress *exportProgress, files map[string]afero.File, resumed bool) error {
+	tp, ok := progress.Targets[target.String()]
+	if !ok {
+		tp = &exportTargetProgress{}
+		progress.Targets[target.String()] = tp
+	}
+	if tp.Done {
+		log.Infof(fmtExportLogsSkipped, color.HighlightUserInput(target.appName), color.HighlightUserInput(target.envName))
+		return nil
+	}
+
+	o.prog.Start(fmt.Sprintf(fmtExportLogsStart, color.HighlightUserInput(target.appName), color.HighlightUserInput(target.envName)))
+	opts := append(o.generateFilterLogEventOpts(o.taskLogStreams(target.appName, o.taskIDs)),
+		cloudwatchlogs.WithFilterLimit(cwGetLogEventsLimitMax)) // Exports aren't capped by --limit.
+	backoff := &cloudwatchlogs.Backoff{}
+	for !tp.Done {
+		page, err := o.cwlogsSvc[target.envName].FilterTaskLogEventsPage(o.logGroupName(target), tp.NextToken, opts...)
+		if err != nil {
+			if cloudwatchlogs.IsThrottled(err) {
+				o.sleep(backoff.Next(true))
+				continue
+			}
+			o.prog.Stop(log.Serrorf(fmtExportLogsFailed, color.HighlightUserInput(target.appName), color.HighlightUserInput(target.envName)))
+			return err
+		}
+		if err := o.writeExportedEvents(target, o.labelEvents(target, page.Events), files, progress, resumed); err != nil {
+			o.prog.Stop(log.Serrorf(fmtExportLogsFailed, color.HighlightUserInput(target.appName), color.HighlightUserInput(target.envName)))
+			return err
+		}
+		tp.Events += len(page.Events)
+		tp.NextToken = page.NextToken
+		tp.Done = page.NextToken == ""
+		if err := o.recordExportedFiles(progress, files); err != nil {
+			o.prog.Stop(log.Serrorf(fmtExportLogsFailed, color.HighlightUserInput(target.appName), color.HighlightUserInput(target.envName)))
+			return err
+		}
+		if err := o.saveExportProgress(progress); err != nil {
+			o.prog.Stop(log.Serrorf(fmtExportLogsFailed, color.HighlightUserInput(target.appName), color.HighlightUserInput(target.envName)))
+			return err
+		}
+		o.prog.Start(fmt.Sprintf(fmtExportLogsInProgress, color.HighlightUserInput(target.appName), color.HighlightUserInput(target.envName), tp.Events))
+	}
+	o.prog.Stop(log.Ssuccessf(fmtExportLogsComplete, tp.Events, color.HighlightUserInput(target.appName), color.HighlightUserInput(target.envName)))
+	return nil
+}
+
+// writeExportedEvents appends the events as JSON lines to the file of their task, or to the merged file.
+func (o *appLogsOpts) writeExportedEvents(target *appEnv, events []*cloudwatchlogs.Event, files map[string]afero.File, progress *exportProgress, resumed bool) error {
+	for _, event := range events {
+		f, err := o.exportFile(o.exportFilePath(target, event), files, progress, resumed)
+		if err != nil {
+			return err
+		}
+		data, err := event.JSONString()
+		if err != nil {
+			return err
+		}
+		if _, err := f.WriteString(data); err != nil {
+			return fmt.Errorf("write log event to file %s: %w", f.Name(), err)
+		}
+	}
+	return nil
+}
+
+func (o *appLogsOpts) exportFilePath(target *appEnv, event *cloudwatchlogs.Event) string {
+	if o.merge {
+		return o.exportPartPath(target)
+	}
+	dir := o.outputDir
+	if len(o.targets) > 1 {
+		dir = filepath.Join(dir, fmt.Sprintf("%s-%s", target.appName, target.envName))
+	}
+	return filepath.Join(dir, fmt.Sprintf(fmtExportTaskFileName, event.TaskID))
+}
+
+func (o *appLogsOpts) exportPartPath(target *appEnv) string {
+	return filepath.Join(o.outputDir, exportPartsDirName, fmt.Sprintf(fmtExportPartFileName, target.appName, target.envName))
+}
+
+// mergeExportedFiles interleaves the events exported for each application and environment in timestamp order
+// into the merged file, the way "app logs" merges the logs of several applications, then removes their files.
+// The events of each file are already in timestamp order.
+func (o *appLogsOpts) mergeExportedFiles() error {
+	var parts []*exportedEvents
+	defer func() {
+		for _, part := range parts {
+			part.file.Close()
+		}
+	}()
+	for _, target := range o.targets {
+		path := o.exportPartPath(target)
+		f, err := o.fs.Open(path)
+		if errors.Is(err, os.ErrNotExist) {
+			// There are no log events for the application in the environment.
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("open file %s: %w", path, err)
+		}
+		part := &exportedEvents{file: f, scanner: bufio.NewScanner(f)}
+		part.scanner.Buffer(nil, exportMaxLineSize)
+		parts = append(parts, part)
+		if err := part.next(); err != nil {
+			return err
+		}
+	}
+
+	path := filepath.Join(o.outputDir, exportMergedFileName)
+	merged, err := o.fs.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("open file %s: %w", path, err)
+	}
+	defer merged.Close()
+	w := bufio.NewWriter(merged)
+	for {
+		var earliest *exportedEvents
+		for _, part := range parts {
+			if part.done {
+				continue
+			}
+			// Events with the same timestamp keep the order of the applications and environments.
+			if earliest == nil || part.timestamp < earliest.timestamp {
+				earliest = part
+			}
+		}
+		if earliest == nil {
+			break
+		}
+		if _, err := w.WriteString(earliest.line + "\n"); err != nil {
+			return fmt.Errorf("write log event to file %s: %w", path, err)
+		}
+		if err := earliest.next(); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("write log events to file %s: %w", path, err)
+	}
+	if err := merged.Close(); err != nil {
+		return fmt.Errorf("close file %s: %w", path, err)
+	}
+	if err := o.fs.RemoveAll(filepath.Join(o.outputDir, exportPartsDirName)); err != nil {
+		return fmt.Errorf("remove exported files of the applications: %w", err)
+	}
+	return nil
+}
+
+// exportedEvents reads the events of an exported file one line at a time.
+type exportedEvents struct {
+	file    afero.File
+	scanner *bufio.Scanner
+
+	line      string
+	timestamp int64
+	done      bool
+}
+
+// next reads the following event of the file, or marks the file as done.
+func (e *exportedEvents) next() error {
+	if !e.scanner.Scan() {
+		e.done = true
+		if err := e.scanner.Err(); err != nil {
+			return fmt.Errorf("read file %s: %w", e.file.Name(), err)
+		}
+		return nil
+	}
+	e.line = e.scanner.Text()
+	var event cloudwatchlogs.Event
+	if err := json.Unmarshal([]byte(e.line), &event); err != nil {
+		return fmt.Errorf("unmarshal log event of file %s: %w", e.file.Name(), err)
+	}
+	e.timestamp = event.Timestamp
+	return nil
+}
+
+// exportFile opens the file at the path the first time it's used. Files are truncated when
+// starting a new export, and written after their saved size when resuming one.
+func (o *appLogsOpts) exportFile(path string, files map[string]afero.File, progress *exportProgress, resumed bool) (afero.File, error) {
+	if f, ok := files[path]; ok {
+		return f, nil
+	}
+	if err := o.fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("create directory %s: %w", filepath.Dir(path), err)
+	}
+	flag := os.O_CREATE | os.O_WRONLY
+	if !resumed {
+		flag |= os.O_TRUNC
+	}
+	f, err := o.fs.OpenFile(path, flag, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("open file %s: %w", path, err)
+	}
+	files[path] = f
+	if !resumed {
+		return f, nil
+	}
+	// Files that weren't saved in the progress were only written after the last save.
+	size := progress.Files[o.exportFileKey(path)]
+	if err := f.Truncate(size); err != nil {
+		return nil, fmt.Errorf("truncate file %s: %w", path, err)
+	}
+	if _, err := f.Seek(size, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("seek file %s: %w", path, err)
+	}
+	return f, nil
+}
+
+// recordExportedFiles saves the sizes of the open files in the progress.
+func (o *appLogsOpts) recordExportedFiles(progress *exportProgress, files map[string]afero.File) error {
+	if progress.Files == nil {
+		progress.Files = make(map[string]int64)
+	}
+	for path, f := range files {
+		size, err := f.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return fmt.Errorf("get size of file %s: %w", path, err)
+		}
+		progress.Files[o.exportFileKey(path)] = size
+	}
+	return nil
+}
+
+// exportFileKey returns the path of the file relative to the output directory.
+func (o *appLogsOpts) exportFileKey(path string) string {
+	rel, err := filepath.Rel(o.outputDir, path)
+	if err != nil {
+		return path
+	}
+	return filepath.ToSlash(rel)
+}
+
+// loadExportProgress returns the progress of the unfinished export in the output directory if there is one,
+// or starts a new export. The time window of a resumed export is the one of the original export.
+func (o *appLogsOpts) loadExportProgress() (*exportProgress, bool, error) {
+	data, err := afero.ReadFile(o.fs, filepath.Join(o.outputDir, exportProgressFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		if o.endTime == 0 {
+			// Fix the end of the window so that resuming the export doesn't include newer logs.
+			o.endTime = o.now().Unix() * 1000
+		}
+		return &exportProgress{
+			StartTime:       o.startTime,
+			EndTime:         o.endTime,
+			FilterPattern:   o.filterPattern,
+			TaskIDs:         o.taskIDs,
+			LogStreamPrefix: o.logStreamPrefix,
+			Merge:           o.merge,
+			Targets:         make(map[string]*exportTargetProgress),
+		}, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("read export progress file: %w", err)
+	}
+	var progress exportProgress
+	if err := json.Unmarshal(data, &progress); err != nil {
+		return nil, false, fmt.Errorf("unmarshal export progress file: %w", err)
+	}
+	if !o.matchesExport(&progress) {
+		return nil, false, fmt.Errorf("directory %s contains an unfinished export with different flags, run the same command to resume it or pick another directory", o.outputDir)
+	}
+	if progress.Targets == nil {
+		progress.Targets = make(map[string]*exportTargetProgress)
+	}
+	o.startTime, o.endTime = progress.StartTime, progress.EndTime
+	log.Infof("Resuming the export in %s.\n", color.HighlightResource(o.outputDir))
+	return &progress, true, nil
+}
+
+// matchesExport returns false if the flags select other log events than the ones of the export.
+// Relative or default time windows are resolved again on each run so they always match.
+func (o *appLogsOpts) matchesExport(progress *exportProgress) bool {
+	if o.humanStartTime != "" && o.startTime != progress.StartTime {
+		return false
+	}
+	if o.humanEndTime != "" && o.endTime != progress.EndTime {
+		return false
+	}
+	if o.filterPattern != progress.FilterPattern || o.logStreamPrefix != progress.LogStreamPrefix || o.merge != progress.Merge {
+		return false
+	}
+	if len(o.taskIDs) != len(progress.TaskIDs) {
+		return false
+	}
+	for i := range o.taskIDs {
+		if o.taskIDs[i] != progress.TaskIDs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (o *appLogsOpts) saveExportProgress(progress *exportProgress) error {
+	data, err := json.Marshal(progress)
+	if err != nil {
+		return fmt.Errorf("marshal export progress: %w", err)
+	}
+	if err := afero.WriteFile(o.fs, filepath.Join(o.outputDir, exportProgressFileName), data, 0644); err != nil {
+		return fmt.Errorf("write export progress file: %w", err)
+	}
+	return nil
+}
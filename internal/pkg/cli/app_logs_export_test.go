@@ -0,0 +1,307 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/cloudwatchlogs"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppLogs_ExportLogs(t *testing.T) {
+	const (
+		mockOutputDir = "logs"
+		mockStartTime = int64(1000)
+		mockEndTime   = int64(2000)
+	)
+	mockLogGroup := fmt.Sprintf(logGroupNamePattern, "mockProject", "mockEnv", "mockApp")
+	firstLine := "{\"taskID\":\"task1\",\"ingestionTime\":0,\"message\":\"first\",\"timestamp\":1100}\n"
+	secondLine := "{\"taskID\":\"task2\",\"ingestionTime\":0,\"message\":\"second\",\"timestamp\":1200}\n"
+	thirdLine := "{\"taskID\":\"task1\",\"ingestionTime\":0,\"message\":\"third\",\"timestamp\":1300}\n"
+
+	testCases := map[string]struct {
+		inputTargets       []*appEnv
+		inputMerge         bool
+		inputFilterPattern string
+
+		setupFs          func(fs afero.Fs)
+		mockcwlogService func(ctrl *gomock.Controller) map[string]cwlogService
+		mockProg         func(m *climocks.Mockprogress)
+
+		wantedError        error
+		wantedFiles        map[string]string
+		wantedNoProgress   bool
+		wantedNextToken    string
+		wantedEventsSoFar  int
+		wantedFileSizes    map[string]int64
+		wantedMissingPaths []string
+	}{
+		"writes one file per task until there are no more pages": {
+			inputTargets: []*appEnv{{appName: "mockApp", envName: "mockEnv"}},
+
+			setupFs: func(fs afero.Fs) {},
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				m := climocks.NewMockcwlogService(ctrl)
+				gomock.InOrder(
+					m.EXPECT().FilterTaskLogEventsPage(mockLogGroup, "", gomock.Any()).Return(&cloudwatchlogs.LogEventsPage{
+						Events: []*cloudwatchlogs.Event{
+							{TaskID: "task1", Message: "first", Timestamp: 1100},
+							{TaskID: "task2", Message: "second", Timestamp: 1200},
+						},
+						NextToken: "mockToken",
+					}, nil),
+					m.EXPECT().FilterTaskLogEventsPage(mockLogGroup, "mockToken", gomock.Any()).Return(&cloudwatchlogs.LogEventsPage{
+						Events: []*cloudwatchlogs.Event{
+							{TaskID: "task1", Message: "third", Timestamp: 1300},
+						},
+					}, nil),
+				)
+				return map[string]cwlogService{"mockEnv": m}
+			},
+			mockProg: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtExportLogsStart, "mockApp", "mockEnv"))
+				m.EXPECT().Start(fmt.Sprintf(fmtExportLogsInProgress, "mockApp", "mockEnv", 2))
+				m.EXPECT().Start(fmt.Sprintf(fmtExportLogsInProgress, "mockApp", "mockEnv", 3))
+				m.EXPECT().Stop(gomock.Any())
+			},
+
+			wantedFiles: map[string]string{
+				filepath.Join(mockOutputDir, "task1.ndjson"): firstLine + thirdLine,
+				filepath.Join(mockOutputDir, "task2.ndjson"): secondLine,
+			},
+			wantedNoProgress: true,
+		},
+		"merges the labeled logs of several applications in a single file in timestamp order": {
+			inputTargets: []*appEnv{
+				{appName: "mockApp", envName: "mockEnv"},
+				{appName: "otherApp", envName: "mockEnv"},
+			},
+			inputMerge: true,
+
+			setupFs: func(fs afero.Fs) {},
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				m := climocks.NewMockcwlogService(ctrl)
+				m.EXPECT().FilterTaskLogEventsPage(mockLogGroup, "", gomock.Any()).Return(&cloudwatchlogs.LogEventsPage{
+					Events: []*cloudwatchlogs.Event{
+						{TaskID: "task1", Message: "first", Timestamp: 1100},
+						{TaskID: "task1", Message: "third", Timestamp: 1300},
+					},
+				}, nil)
+				m.EXPECT().FilterTaskLogEventsPage(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockEnv", "otherApp"), "", gomock.Any()).Return(&cloudwatchlogs.LogEventsPage{
+					Events: []*cloudwatchlogs.Event{{TaskID: "task2", Message: "second", Timestamp: 1200}},
+				}, nil)
+				return map[string]cwlogService{"mockEnv": m}
+			},
+			mockProg: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any()).Times(4)
+				m.EXPECT().Stop(gomock.Any()).Times(2)
+			},
+
+			wantedFiles: map[string]string{
+				filepath.Join(mockOutputDir, exportMergedFileName): "{\"appName\":\"mockApp\",\"envName\":\"mockEnv\",\"taskID\":\"task1\",\"ingestionTime\":0,\"message\":\"first\",\"timestamp\":1100}\n" +
+					"{\"appName\":\"otherApp\",\"envName\":\"mockEnv\",\"taskID\":\"task2\",\"ingestionTime\":0,\"message\":\"second\",\"timestamp\":1200}\n" +
+					"{\"appName\":\"mockApp\",\"envName\":\"mockEnv\",\"taskID\":\"task1\",\"ingestionTime\":0,\"message\":\"third\",\"timestamp\":1300}\n",
+			},
+			wantedMissingPaths: []string{filepath.Join(mockOutputDir, exportPartsDirName)},
+			wantedNoProgress:   true,
+		},
+		"merges the exported files again if the export was interrupted while merging": {
+			inputTargets: []*appEnv{
+				{appName: "mockApp", envName: "mockEnv"},
+				{appName: "otherApp", envName: "mockEnv"},
+			},
+			inputMerge: true,
+
+			setupFs: func(fs afero.Fs) {
+				afero.WriteFile(fs, filepath.Join(mockOutputDir, exportPartsDirName, "mockApp-mockEnv.ndjson"), []byte(firstLine+thirdLine), 0644)
+				afero.WriteFile(fs, filepath.Join(mockOutputDir, exportPartsDirName, "otherApp-mockEnv.ndjson"), []byte(secondLine), 0644)
+				afero.WriteFile(fs, filepath.Join(mockOutputDir, exportMergedFileName), []byte(firstLine), 0644)
+				afero.WriteFile(fs, filepath.Join(mockOutputDir, exportProgressFileName),
+					[]byte(`{"startTime":1000,"endTime":2000,"merge":true,"targets":{"mockApp (mockEnv)":{"events":2,"done":true},"otherApp (mockEnv)":{"events":1,"done":true}}}`), 0644)
+			},
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				return nil
+			},
+			mockProg: func(m *climocks.Mockprogress) {},
+
+			wantedFiles: map[string]string{
+				filepath.Join(mockOutputDir, exportMergedFileName): firstLine + secondLine + thirdLine,
+			},
+			wantedMissingPaths: []string{filepath.Join(mockOutputDir, exportPartsDirName)},
+			wantedNoProgress:   true,
+		},
+		"resumes an interrupted export from its pagination token": {
+			inputTargets: []*appEnv{{appName: "mockApp", envName: "mockEnv"}},
+
+			setupFs: func(fs afero.Fs) {
+				afero.WriteFile(fs, filepath.Join(mockOutputDir, "task1.ndjson"), []byte(firstLine), 0644)
+				afero.WriteFile(fs, filepath.Join(mockOutputDir, exportProgressFileName),
+					[]byte(`{"startTime":1000,"endTime":2000,"merge":false,"targets":{"mockApp (mockEnv)":{"nextToken":"mockToken","events":1}},"files":{"task1.ndjson":72}}`), 0644)
+			},
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				m := climocks.NewMockcwlogService(ctrl)
+				m.EXPECT().FilterTaskLogEventsPage(mockLogGroup, "mockToken", gomock.Any()).Return(&cloudwatchlogs.LogEventsPage{
+					Events: []*cloudwatchlogs.Event{{TaskID: "task1", Message: "third", Timestamp: 1300}},
+				}, nil)
+				return map[string]cwlogService{"mockEnv": m}
+			},
+			mockProg: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any()).Times(2)
+				m.EXPECT().Stop(gomock.Any())
+			},
+
+			wantedFiles: map[string]string{
+				filepath.Join(mockOutputDir, "task1.ndjson"): firstLine + thirdLine,
+			},
+			wantedNoProgress: true,
+		},
+		"discards the events written after the last saved progress when resuming": {
+			inputTargets: []*appEnv{{appName: "mockApp", envName: "mockEnv"}},
+
+			setupFs: func(fs afero.Fs) {
+				// The export was interrupted after writing the second page but before saving its token.
+				afero.WriteFile(fs, filepath.Join(mockOutputDir, "task1.ndjson"), []byte(firstLine+thirdLine), 0644)
+				afero.WriteFile(fs, filepath.Join(mockOutputDir, "task2.ndjson"), []byte(secondLine), 0644)
+				afero.WriteFile(fs, filepath.Join(mockOutputDir, exportProgressFileName),
+					[]byte(`{"startTime":1000,"endTime":2000,"merge":false,"targets":{"mockApp (mockEnv)":{"nextToken":"mockToken","events":1}},"files":{"task1.ndjson":72}}`), 0644)
+			},
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				m := climocks.NewMockcwlogService(ctrl)
+				m.EXPECT().FilterTaskLogEventsPage(mockLogGroup, "mockToken", gomock.Any()).Return(&cloudwatchlogs.LogEventsPage{
+					Events: []*cloudwatchlogs.Event{
+						{TaskID: "task2", Message: "second", Timestamp: 1200},
+						{TaskID: "task1", Message: "third", Timestamp: 1300},
+					},
+				}, nil)
+				return map[string]cwlogService{"mockEnv": m}
+			},
+			mockProg: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any()).Times(2)
+				m.EXPECT().Stop(gomock.Any())
+			},
+
+			wantedFiles: map[string]string{
+				filepath.Join(mockOutputDir, "task1.ndjson"): firstLine + thirdLine,
+				filepath.Join(mockOutputDir, "task2.ndjson"): secondLine,
+			},
+			wantedNoProgress: true,
+		},
+		"returns error if the unfinished export has different flags": {
+			inputTargets:       []*appEnv{{appName: "mockApp", envName: "mockEnv"}},
+			inputFilterPattern: "ERROR",
+
+			setupFs: func(fs afero.Fs) {
+				afero.WriteFile(fs, filepath.Join(mockOutputDir, exportProgressFileName),
+					[]byte(`{"startTime":1000,"endTime":2000,"merge":false,"targets":{}}`), 0644)
+			},
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				return nil
+			},
+			mockProg: func(m *climocks.Mockprogress) {},
+
+			wantedError: errors.New("directory logs contains an unfinished export with different flags, run the same command to resume it or pick another directory"),
+		},
+		"keeps the pagination token if a page fails": {
+			inputTargets: []*appEnv{{appName: "mockApp", envName: "mockEnv"}},
+
+			setupFs: func(fs afero.Fs) {},
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				m := climocks.NewMockcwlogService(ctrl)
+				gomock.InOrder(
+					m.EXPECT().FilterTaskLogEventsPage(mockLogGroup, "", gomock.Any()).Return(&cloudwatchlogs.LogEventsPage{
+						Events:    []*cloudwatchlogs.Event{{TaskID: "task1", Message: "first", Timestamp: 1100}},
+						NextToken: "mockToken",
+					}, nil),
+					m.EXPECT().FilterTaskLogEventsPage(mockLogGroup, "mockToken", gomock.Any()).Return(nil, errors.New("some error")),
+				)
+				return map[string]cwlogService{"mockEnv": m}
+			},
+			mockProg: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any()).Times(2)
+				m.EXPECT().Stop(gomock.Any())
+			},
+
+			wantedError: errors.New("some error"),
+			wantedFiles: map[string]string{
+				filepath.Join(mockOutputDir, "task1.ndjson"): firstLine,
+			},
+			wantedNextToken:   "mockToken",
+			wantedEventsSoFar: 1,
+			wantedFileSizes:   map[string]int64{"task1.ndjson": int64(len(firstLine))},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fs := afero.NewMemMapFs()
+			tc.setupFs(fs)
+			mockProg := climocks.NewMockprogress(ctrl)
+			tc.mockProg(mockProg)
+
+			appLogs := &appLogsOpts{
+				appLogsVars: appLogsVars{
+					limit:         10,
+					filterPattern: tc.inputFilterPattern,
+					outputDir:     mockOutputDir,
+					merge:         tc.inputMerge,
+					GlobalOpts: &GlobalOpts{
+						projectName: "mockProject",
+					},
+				},
+				startTime: mockStartTime,
+				endTime:   mockEndTime,
+				targets:   tc.inputTargets,
+				cwlogsSvc: tc.mockcwlogService(ctrl),
+				fs:        fs,
+				prog:      mockProg,
+				sleep:     func(time.Duration) {},
+			}
+
+			// WHEN
+			err := appLogs.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			for path, wanted := range tc.wantedFiles {
+				got, err := afero.ReadFile(fs, path)
+				require.NoError(t, err)
+				require.Equal(t, wanted, string(got))
+			}
+			for _, path := range tc.wantedMissingPaths {
+				exists, err := afero.Exists(fs, path)
+				require.NoError(t, err)
+				require.False(t, exists, "%s should not exist", path)
+			}
+			progressPath := filepath.Join(mockOutputDir, exportProgressFileName)
+			exists, err := afero.Exists(fs, progressPath)
+			require.NoError(t, err)
+			if tc.wantedNoProgress {
+				require.False(t, exists)
+			}
+			if tc.wantedNextToken != "" {
+				progress, resumed, err := appLogs.loadExportProgress()
+				require.NoError(t, err)
+				require.True(t, resumed)
+				require.Equal(t, tc.wantedNextToken, progress.Targets["mockApp (mockEnv)"].NextToken)
+				require.Equal(t, tc.wantedEventsSoFar, progress.Targets["mockApp (mockEnv)"].Events)
+				require.Equal(t, tc.wantedFileSizes, progress.Files)
+			}
+		})
+	}
+}
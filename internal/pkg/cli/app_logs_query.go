@@ -0,0 +1,202 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appLogsQueryPrompt     = "What Logs Insights query would you like to run?"
+	appLogsQueryHelpPrompt = `A CloudWatch Logs Insights query, for example:
+fields @timestamp, @message | filter @message like /ERROR/ | stats count(*) by bin(1m)`
+
+	fmtQueryLogsStart    = "Running the query against the logs of %s in environment %s."
+	fmtQueryLogsFailed   = "Failed to run the query against the logs of %s in environment %s."
+	fmtQueryLogsComplete = "Found %d results in the logs of %s in environment %s."
+
+	// Output formats of the query results.
+	queryOutputTable = "table"
+	queryOutputCSV   = "csv"
+	queryOutputJSON  = "json"
+
+	queryDefaultLimit    = 1000
+	queryDefaultSince    = 1 * time.Hour
+	queryResultsInterval = 1 * time.Second
+)
+
+var queryOutputFormats = []string{queryOutputTable, queryOutputCSV, queryOutputJSON}
+
+type appLogsQueryVars struct {
+	appLogsVars
+	appName      string
+	envName      string
+	query        string
+	outputFormat string
+}
+
+type appLogsQueryOpts struct {
+	*appLogsOpts
+	query        string
+	outputFormat string
+}
+
+func newAppLogsQueryOpts(vars appLogsQueryVars) (*appLogsQueryOpts, error) {
+	// Queries run against the log group of a single application in a single environment.
+	if vars.appName != "" {
+		vars.appNames = []string{vars.appName}
+	}
+	if vars.envName != "" {
+		vars.envNames = []string{vars.envName}
+	}
+	logsOpts, err := newAppLogOpts(vars.appLogsVars)
+	if err != nil {
+		return nil, err
+	}
+	return &appLogsQueryOpts{
+		appLogsOpts:  logsOpts,
+		query:        vars.query,
+		outputFormat: vars.outputFormat,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *appLogsQueryOpts) Validate() error {
+	if err := o.appLogsOpts.Validate(); err != nil {
+		return err
+	}
+	if !contains(o.outputFormat, queryOutputFormats) {
+		return fmt.Errorf("invalid output format %s, must be one of: %s", o.outputFormat, queryOutputFormats)
+	}
+	// Logs Insights queries require a time range, default to the last hour.
+	now := o.now()
+	if o.startTime == 0 {
+		o.startTime = now.Add(-queryDefaultSince).Unix() * 1000
+	}
+	if o.endTime == 0 {
+		o.endTime = now.Unix() * 1000
+	}
+	if o.startTime > o.endTime {
+		return errors.New("the start time must be before the end time")
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *appLogsQueryOpts) Ask() error {
+	if err := o.appLogsOpts.Ask(); err != nil {
+		return err
+	}
+	if o.query != "" {
+		return nil
+	}
+	query, err := o.prompt.Get(appLogsQueryPrompt, appLogsQueryHelpPrompt, nil)
+	if err != nil {
+		return fmt.Errorf("prompt for the query: %w", err)
+	}
+	o.query = query
+	return nil
+}
+
+// Execute runs the Logs Insights query against the log group of the application and writes the results.
+func (o *appLogsQueryOpts) Execute() error {
+	target := o.targets[0]
+	svc := o.cwlogsSvc[target.envName]
+	queryID, err := svc.StartQuery(o.logGroupName(target), o.query, o.startTime, o.endTime, o.limit)
+	if err != nil {
+		return err
+	}
+
+	o.prog.Start(fmt.Sprintf(fmtQueryLogsStart, color.HighlightUserInput(target.appName), color.HighlightUserInput(target.envName)))
+	var results *cloudwatchlogs.QueryResults
+	for {
+		results, err = svc.QueryResults(queryID)
+		if err != nil {
+			o.prog.Stop(log.Serrorf(fmtQueryLogsFailed, color.HighlightUserInput(target.appName), color.HighlightUserInput(target.envName)))
+			return err
+		}
+		if results.Done() {
+			break
+		}
+		o.sleep(queryResultsInterval)
+	}
+	if !results.Complete() {
+		o.prog.Stop(log.Serrorf(fmtQueryLogsFailed, color.HighlightUserInput(target.appName), color.HighlightUserInput(target.envName)))
+		return fmt.Errorf("query %s ended with status %s", queryID, results.Status)
+	}
+	o.prog.Stop(log.Ssuccessf(fmtQueryLogsComplete, len(results.Rows), color.HighlightUserInput(target.appName), color.HighlightUserInput(target.envName)))
+
+	return o.outputResults(results)
+}
+
+func (o *appLogsQueryOpts) outputResults(results *cloudwatchlogs.QueryResults) error {
+	switch o.outputFormat {
+	case queryOutputCSV:
+		data, err := results.CSVString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	case queryOutputJSON:
+		data, err := results.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	default:
+		fmt.Fprint(o.w, results.HumanString())
+	}
+	return nil
+}
+
+// BuildAppLogsQueryCmd builds the command for running a Logs Insights query against the logs of an application.
+func BuildAppLogsQueryCmd() *cobra.Command {
+	vars := appLogsQueryVars{
+		appLogsVars: appLogsVars{
+			GlobalOpts: NewGlobalOpts(),
+		},
+	}
+	cmd := &cobra.Command{
+		Use:   "query",
+		Short: "Runs a CloudWatch Logs Insights query against the logs of a deployed application.",
+		Long: `Runs a CloudWatch Logs Insights query against the logs of a deployed application.
+Queries the logs of the last hour unless a time range is provided.`,
+
+		Example: `
+  Counts the errors per minute of the application "my-app" in environment "test"
+	/code $ ecs-preview app logs query -n my-app -e test --query 'filter @message like /ERROR/ | stats count(*) by bin(1m)'
+  Writes the p99 latency of the last day from structured logs in CSV format
+	/code $ ecs-preview app logs query --since 24h --query 'stats pct(latency, 99) by bin(1h)' --output csv`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newAppLogsQueryOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, nameFlag, nameFlagShort, "", appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.query, queryFlag, queryFlagShort, "", queryFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFlag, queryOutputTable, queryOutputFlagDescription)
+	cmd.Flags().StringVar(&vars.humanStartTime, startTimeFlag, "", queryStartTimeFlagDescription)
+	cmd.Flags().StringVar(&vars.humanEndTime, endTimeFlag, "", queryEndTimeFlagDescription)
+	cmd.Flags().DurationVar(&vars.since, sinceFlag, 0, querySinceFlagDescription)
+	cmd.Flags().IntVar(&vars.limit, limitFlag, queryDefaultLimit, queryLimitFlagDescription)
+	cmd.Flags().StringVarP(&vars.projectName, projectFlag, projectFlagShort, "", projectFlagDescription)
+	return cmd
+}
@@ -0,0 +1,317 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/cloudwatchlogs"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppLogsQuery_Validate(t *testing.T) {
+	mockNow := time.Unix(1600000000, 0)
+	testCases := map[string]struct {
+		inputOutput    string
+		inputStartTime string
+		inputEndTime   string
+
+		wantedError     error
+		wantedTimeRange bool
+	}{
+		"defaults to a time range": {
+			inputOutput: queryOutputTable,
+
+			wantedTimeRange: true,
+		},
+		"returns error if the output format is invalid": {
+			inputOutput: "yaml",
+
+			wantedError: fmt.Errorf("invalid output format yaml, must be one of: [table csv json]"),
+		},
+		"returns error if the start time is after the end time": {
+			inputOutput:    queryOutputCSV,
+			inputStartTime: "1971-01-01T01:01:01+00:00",
+			inputEndTime:   "1970-01-01T01:01:01+00:00",
+
+			wantedError: fmt.Errorf("the start time must be before the end time"),
+		},
+		"returns error if the time flags are invalid": {
+			inputOutput:    queryOutputJSON,
+			inputStartTime: "badStartTime",
+
+			wantedError: fmt.Errorf("invalid argument badStartTime for \"--start-time\" flag: reading time value badStartTime: parsing time \"badStartTime\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"badStartTime\" as \"2006\""),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &appLogsQueryOpts{
+				appLogsOpts: &appLogsOpts{
+					appLogsVars: appLogsVars{
+						limit:          queryDefaultLimit,
+						humanStartTime: tc.inputStartTime,
+						humanEndTime:   tc.inputEndTime,
+						GlobalOpts:     &GlobalOpts{},
+					},
+					now: func() time.Time { return mockNow },
+				},
+				outputFormat: tc.inputOutput,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.wantedTimeRange {
+				require.Equal(t, mockNow.Add(-time.Hour).Unix()*1000, opts.startTime)
+				require.Equal(t, mockNow.Unix()*1000, opts.endTime)
+			}
+		})
+	}
+}
+
+func TestAppLogsQuery_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputQuery   string
+		mockPrompter func(m *climocks.Mockprompter)
+
+		wantedQuery string
+		wantedError error
+	}{
+		"with the query flag set": {
+			inputQuery:   "stats count(*)",
+			mockPrompter: func(m *climocks.Mockprompter) {},
+
+			wantedQuery: "stats count(*)",
+		},
+		"prompts for the query": {
+			mockPrompter: func(m *climocks.Mockprompter) {
+				m.EXPECT().Get(appLogsQueryPrompt, appLogsQueryHelpPrompt, nil).Return("stats count(*)", nil)
+			},
+
+			wantedQuery: "stats count(*)",
+		},
+		"returns error if fail to prompt for the query": {
+			mockPrompter: func(m *climocks.Mockprompter) {
+				m.EXPECT().Get(appLogsQueryPrompt, appLogsQueryHelpPrompt, nil).Return("", errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("prompt for the query: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStoreReader := climocks.NewMockstoreReader(ctrl)
+			mockStoreReader.EXPECT().GetApplication("mockProject", "mockApp").Return(&archer.Application{
+				Name: "mockApp",
+			}, nil)
+			mockStoreReader.EXPECT().GetEnvironment("mockProject", "mockEnv").Return(&archer.Environment{
+				Name: "mockEnv",
+			}, nil)
+			mockcwlogService := climocks.NewMockcwlogService(ctrl)
+			mockcwlogService.EXPECT().LogGroupExists(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockEnv", "mockApp")).Return(true, nil)
+			mockPrompter := climocks.NewMockprompter(ctrl)
+			tc.mockPrompter(mockPrompter)
+
+			opts := &appLogsQueryOpts{
+				appLogsOpts: &appLogsOpts{
+					appLogsVars: appLogsVars{
+						envNames: []string{"mockEnv"},
+						appNames: []string{"mockApp"},
+						GlobalOpts: &GlobalOpts{
+							projectName: "mockProject",
+							prompt:      mockPrompter,
+						},
+					},
+					storeSvc:      mockStoreReader,
+					initCwLogsSvc: func(*appLogsOpts, *archer.Environment) error { return nil },
+					cwlogsSvc: map[string]cwlogService{
+						"mockEnv": mockcwlogService,
+					},
+				},
+				query: tc.inputQuery,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedQuery, opts.query)
+			}
+		})
+	}
+}
+
+func TestAppLogsQuery_Execute(t *testing.T) {
+	const (
+		mockQuery   = "stats count(*) by bin(1m)"
+		mockQueryID = "mockQueryID"
+	)
+	mockLogGroup := fmt.Sprintf(logGroupNamePattern, "mockProject", "mockEnv", "mockApp")
+	mockResults := &cloudwatchlogs.QueryResults{
+		Status: "Complete",
+		Fields: []string{"bin(1m)", "count(*)"},
+		Rows: [][]string{
+			{"2020-04-01 10:00:00.000", "12"},
+		},
+	}
+	testCases := map[string]struct {
+		inputOutput      string
+		mockcwlogService func(m *climocks.MockcwlogService)
+		mockProg         func(m *climocks.Mockprogress)
+
+		wantedError   error
+		wantedContent string
+	}{
+		"polls the results until the query completes and writes them as a table": {
+			inputOutput: queryOutputTable,
+			mockcwlogService: func(m *climocks.MockcwlogService) {
+				gomock.InOrder(
+					m.EXPECT().StartQuery(mockLogGroup, mockQuery, int64(1000), int64(2000), 100).Return(mockQueryID, nil),
+					m.EXPECT().QueryResults(mockQueryID).Return(&cloudwatchlogs.QueryResults{Status: "Running"}, nil),
+					m.EXPECT().QueryResults(mockQueryID).Return(mockResults, nil),
+				)
+			},
+			mockProg: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any())
+				m.EXPECT().Stop(gomock.Any())
+			},
+
+			wantedContent: "bin(1m)                  count(*)\n2020-04-01 10:00:00.000  12\n",
+		},
+		"writes the results in CSV": {
+			inputOutput: queryOutputCSV,
+			mockcwlogService: func(m *climocks.MockcwlogService) {
+				m.EXPECT().StartQuery(mockLogGroup, mockQuery, int64(1000), int64(2000), 100).Return(mockQueryID, nil)
+				m.EXPECT().QueryResults(mockQueryID).Return(mockResults, nil)
+			},
+			mockProg: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any())
+				m.EXPECT().Stop(gomock.Any())
+			},
+
+			wantedContent: "bin(1m),count(*)\n2020-04-01 10:00:00.000,12\n",
+		},
+		"writes the results in JSON": {
+			inputOutput: queryOutputJSON,
+			mockcwlogService: func(m *climocks.MockcwlogService) {
+				m.EXPECT().StartQuery(mockLogGroup, mockQuery, int64(1000), int64(2000), 100).Return(mockQueryID, nil)
+				m.EXPECT().QueryResults(mockQueryID).Return(mockResults, nil)
+			},
+			mockProg: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any())
+				m.EXPECT().Stop(gomock.Any())
+			},
+
+			wantedContent: "[{\"bin(1m)\":\"2020-04-01 10:00:00.000\",\"count(*)\":\"12\"}]\n",
+		},
+		"returns error if fail to start the query": {
+			inputOutput: queryOutputTable,
+			mockcwlogService: func(m *climocks.MockcwlogService) {
+				m.EXPECT().StartQuery(mockLogGroup, mockQuery, int64(1000), int64(2000), 100).Return("", errors.New("some error"))
+			},
+			mockProg: func(m *climocks.Mockprogress) {},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"returns error if fail to get the results": {
+			inputOutput: queryOutputTable,
+			mockcwlogService: func(m *climocks.MockcwlogService) {
+				m.EXPECT().StartQuery(mockLogGroup, mockQuery, int64(1000), int64(2000), 100).Return(mockQueryID, nil)
+				m.EXPECT().QueryResults(mockQueryID).Return(nil, errors.New("some error"))
+			},
+			mockProg: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any())
+				m.EXPECT().Stop(gomock.Any())
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"returns error if the query does not complete": {
+			inputOutput: queryOutputTable,
+			mockcwlogService: func(m *climocks.MockcwlogService) {
+				m.EXPECT().StartQuery(mockLogGroup, mockQuery, int64(1000), int64(2000), 100).Return(mockQueryID, nil)
+				m.EXPECT().QueryResults(mockQueryID).Return(&cloudwatchlogs.QueryResults{Status: "Timeout"}, nil)
+			},
+			mockProg: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any())
+				m.EXPECT().Stop(gomock.Any())
+			},
+
+			wantedError: fmt.Errorf("query mockQueryID ended with status Timeout"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcwlogService := climocks.NewMockcwlogService(ctrl)
+			mockProg := climocks.NewMockprogress(ctrl)
+			tc.mockcwlogService(mockcwlogService)
+			tc.mockProg(mockProg)
+
+			b := &bytes.Buffer{}
+			opts := &appLogsQueryOpts{
+				appLogsOpts: &appLogsOpts{
+					appLogsVars: appLogsVars{
+						limit: 100,
+						GlobalOpts: &GlobalOpts{
+							projectName: "mockProject",
+						},
+					},
+					startTime: 1000,
+					endTime:   2000,
+					targets: []*appEnv{
+						{
+							appName: "mockApp",
+							envName: "mockEnv",
+						},
+					},
+					cwlogsSvc: map[string]cwlogService{
+						"mockEnv": mockcwlogService,
+					},
+					prog:  mockProg,
+					sleep: func(time.Duration) {},
+					w:     b,
+				},
+				query:        mockQuery,
+				outputFormat: tc.inputOutput,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
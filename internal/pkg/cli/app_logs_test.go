@@ -15,6 +15,8 @@ import (
 	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	sdkcloudwatchlogs "github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -29,20 +31,28 @@ func TestAppLogs_Validate(t *testing.T) {
 		mockEndTime      = "1971-01-01T01:01:01+00:00"
 		mockBadEndTime   = "badEndTime"
 	)
+	mockNow := time.Unix(1600000000, 0)
 	testCases := map[string]struct {
-		inputProject     string
-		inputApplication string
-		inputLimit       int
-		inputFollow      bool
-		inputEnvName     string
-		inputStartTime   string
-		inputEndTime     string
-		inputSince       time.Duration
+		inputProject      string
+		inputApplication  []string
+		inputLimit        int
+		inputFollow       bool
+		inputEnvName      []string
+		inputStartTime    string
+		inputEndTime      string
+		inputSince        time.Duration
+		inputTaskIDs      []string
+		inputStreamPrefix string
+		inputFields       []string
+		inputRaw          bool
+		inputOutputDir    string
+		inputMerge        bool
 
 		mockStoreReader  func(m *climocks.MockstoreReader)
 		mockcwlogService func(ctrl *gomock.Controller) map[string]cwlogService
 
-		wantedError error
+		wantedError     error
+		wantedStartTime int64
 	}{
 		"with no flag set": {
 			// default value for limit and since flags
@@ -67,6 +77,17 @@ func TestAppLogs_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("some error"),
 		},
+		"converts the since flag to a start time": {
+			inputLimit: 10,
+			inputSince: mockSince,
+
+			mockStoreReader: func(m *climocks.MockstoreReader) {},
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				return nil
+			},
+
+			wantedStartTime: mockNow.Add(-mockSince).Unix() * 1000,
+		},
 		"returns error if since and startTime flags are set together": {
 			inputSince:     mockSince,
 			inputStartTime: mockStartTime,
@@ -89,6 +110,17 @@ func TestAppLogs_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("only one of --follow or --end-time may be used"),
 		},
+		"returns error if task-id and log-stream-prefix flags are set together": {
+			inputTaskIDs:      []string{"mockTaskID"},
+			inputStreamPrefix: "ecs/mockApp",
+
+			mockStoreReader: func(m *climocks.MockstoreReader) {},
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				return nil
+			},
+
+			wantedError: fmt.Errorf("only one of --task-id or --log-stream-prefix may be used"),
+		},
 		"returns error if invalid start time flag value": {
 			inputStartTime: mockBadStartTime,
 
@@ -119,6 +151,40 @@ func TestAppLogs_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("--since must be greater than 0"),
 		},
+		"returns error if fields and raw flags are both set": {
+			inputFields: []string{"status"},
+			inputRaw:    true,
+
+			mockStoreReader: func(m *climocks.MockstoreReader) {},
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				return nil
+			},
+
+			wantedError: fmt.Errorf("only one of --fields or --raw may be used"),
+		},
+		"returns error if follow and output-dir flags are both set": {
+			inputLimit:     10,
+			inputFollow:    true,
+			inputOutputDir: "logs",
+
+			mockStoreReader: func(m *climocks.MockstoreReader) {},
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				return nil
+			},
+
+			wantedError: fmt.Errorf("only one of --follow or --output-dir may be used"),
+		},
+		"returns error if merge flag is set without output-dir": {
+			inputLimit: 10,
+			inputMerge: true,
+
+			mockStoreReader: func(m *climocks.MockstoreReader) {},
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				return nil
+			},
+
+			wantedError: fmt.Errorf("--merge requires --output-dir"),
+		},
 		"returns error if limit value is below limit": {
 			inputLimit: -1,
 
@@ -151,13 +217,19 @@ func TestAppLogs_Validate(t *testing.T) {
 
 			appLogs := &appLogsOpts{
 				appLogsVars: appLogsVars{
-					follow:         tc.inputFollow,
-					limit:          tc.inputLimit,
-					envName:        tc.inputEnvName,
-					humanStartTime: tc.inputStartTime,
-					humanEndTime:   tc.inputEndTime,
-					since:          tc.inputSince,
-					appName:        tc.inputApplication,
+					follow:          tc.inputFollow,
+					limit:           tc.inputLimit,
+					envNames:        tc.inputEnvName,
+					humanStartTime:  tc.inputStartTime,
+					humanEndTime:    tc.inputEndTime,
+					since:           tc.inputSince,
+					appNames:        tc.inputApplication,
+					taskIDs:         tc.inputTaskIDs,
+					logStreamPrefix: tc.inputStreamPrefix,
+					fields:          tc.inputFields,
+					raw:             tc.inputRaw,
+					outputDir:       tc.inputOutputDir,
+					merge:           tc.inputMerge,
 					GlobalOpts: &GlobalOpts{
 						projectName: tc.inputProject,
 					},
@@ -165,6 +237,7 @@ func TestAppLogs_Validate(t *testing.T) {
 				storeSvc:      mockStoreReader,
 				initCwLogsSvc: func(*appLogsOpts, *archer.Environment) error { return nil },
 				cwlogsSvc:     tc.mockcwlogService(ctrl),
+				now:           func() time.Time { return mockNow },
 			}
 
 			// WHEN
@@ -176,6 +249,9 @@ func TestAppLogs_Validate(t *testing.T) {
 			} else {
 				require.Nil(t, err)
 			}
+			if tc.wantedStartTime != 0 {
+				require.Equal(t, tc.wantedStartTime, appLogs.startTime)
+			}
 		})
 	}
 }
@@ -183,19 +259,21 @@ func TestAppLogs_Validate(t *testing.T) {
 func TestAppLogs_Ask(t *testing.T) {
 	testCases := map[string]struct {
 		inputProject     string
-		inputApplication string
-		inputEnvName     string
+		inputApplication []string
+		inputEnvName     []string
+		inputAll         bool
 
 		mockStoreReader  func(m *climocks.MockstoreReader)
 		mockcwlogService func(ctrl *gomock.Controller) map[string]cwlogService
 		mockPrompter     func(m *climocks.Mockprompter)
 
-		wantedError error
+		wantedTargets []*appEnv
+		wantedError   error
 	}{
 		"with all flag set": {
 			inputProject:     "mockProject",
-			inputApplication: "mockApp",
-			inputEnvName:     "mockEnv",
+			inputApplication: []string{"mockApp"},
+			inputEnvName:     []string{"mockEnv"},
 
 			mockStoreReader: func(m *climocks.MockstoreReader) {
 				m.EXPECT().GetApplication("mockProject", "mockApp").Return(&archer.Application{
@@ -218,8 +296,8 @@ func TestAppLogs_Ask(t *testing.T) {
 		},
 		"with all flag set and return error if fail to get application": {
 			inputProject:     "mockProject",
-			inputApplication: "mockApp",
-			inputEnvName:     "mockEnv",
+			inputApplication: []string{"mockApp"},
+			inputEnvName:     []string{"mockEnv"},
 
 			mockStoreReader: func(m *climocks.MockstoreReader) {
 				m.EXPECT().GetApplication("mockProject", "mockApp").Return(nil, errors.New("some error"))
@@ -233,8 +311,8 @@ func TestAppLogs_Ask(t *testing.T) {
 		},
 		"with all flag set and return error if fail to get environment": {
 			inputProject:     "mockProject",
-			inputApplication: "mockApp",
-			inputEnvName:     "mockEnv",
+			inputApplication: []string{"mockApp"},
+			inputEnvName:     []string{"mockEnv"},
 
 			mockStoreReader: func(m *climocks.MockstoreReader) {
 				m.EXPECT().GetApplication("mockProject", "mockApp").Return(&archer.Application{
@@ -249,9 +327,81 @@ func TestAppLogs_Ask(t *testing.T) {
 
 			wantedError: fmt.Errorf("get environment: some error"),
 		},
+		"with the all flag set shows every deployed application": {
+			inputProject: "mockProject",
+			inputAll:     true,
+
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().ListApplications("mockProject").Return([]*archer.Application{
+					&archer.Application{
+						Name: "mockFrontend",
+					},
+					&archer.Application{
+						Name: "mockBackend",
+					},
+				}, nil)
+				m.EXPECT().ListEnvironments("mockProject").Return([]*archer.Environment{
+					&archer.Environment{
+						Name: "mockTestEnv",
+					},
+					&archer.Environment{
+						Name: "mockProdEnv",
+					},
+				}, nil)
+			},
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				m := climocks.NewMockcwlogService(ctrl)
+				cwlogServices := make(map[string]cwlogService)
+				m.EXPECT().LogGroupExists(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockTestEnv", "mockFrontend")).Return(true, nil)
+				m.EXPECT().LogGroupExists(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockProdEnv", "mockFrontend")).Return(false, nil)
+				m.EXPECT().LogGroupExists(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockTestEnv", "mockBackend")).Return(true, nil)
+				m.EXPECT().LogGroupExists(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockProdEnv", "mockBackend")).Return(true, nil)
+				cwlogServices["mockTestEnv"] = m
+				cwlogServices["mockProdEnv"] = m
+				return cwlogServices
+			},
+			mockPrompter: func(m *climocks.Mockprompter) {},
+
+			wantedTargets: []*appEnv{
+				{appName: "mockFrontend", envName: "mockTestEnv"},
+				{appName: "mockBackend", envName: "mockTestEnv"},
+				{appName: "mockBackend", envName: "mockProdEnv"},
+			},
+		},
+		"with several app names set shows each of them": {
+			inputProject:     "mockProject",
+			inputApplication: []string{"mockFrontend", "mockBackend"},
+			inputEnvName:     []string{"mockEnv"},
+
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().GetApplication("mockProject", "mockFrontend").Return(&archer.Application{
+					Name: "mockFrontend",
+				}, nil)
+				m.EXPECT().GetApplication("mockProject", "mockBackend").Return(&archer.Application{
+					Name: "mockBackend",
+				}, nil)
+				m.EXPECT().GetEnvironment("mockProject", "mockEnv").Return(&archer.Environment{
+					Name: "mockEnv",
+				}, nil)
+			},
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				m := climocks.NewMockcwlogService(ctrl)
+				cwlogServices := make(map[string]cwlogService)
+				m.EXPECT().LogGroupExists(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockEnv", "mockFrontend")).Return(true, nil)
+				m.EXPECT().LogGroupExists(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockEnv", "mockBackend")).Return(true, nil)
+				cwlogServices["mockEnv"] = m
+				return cwlogServices
+			},
+			mockPrompter: func(m *climocks.Mockprompter) {},
+
+			wantedTargets: []*appEnv{
+				{appName: "mockFrontend", envName: "mockEnv"},
+				{appName: "mockBackend", envName: "mockEnv"},
+			},
+		},
 		"with only app flag set and not deployed in one of envs": {
 			inputProject:     "mockProject",
-			inputApplication: "mockApp",
+			inputApplication: []string{"mockApp"},
 
 			mockStoreReader: func(m *climocks.MockstoreReader) {
 				m.EXPECT().GetApplication("mockProject", "mockApp").Return(&archer.Application{
@@ -288,7 +438,7 @@ func TestAppLogs_Ask(t *testing.T) {
 		},
 		"with only env flag set": {
 			inputProject: "mockProject",
-			inputEnvName: "mockEnv",
+			inputEnvName: []string{"mockEnv"},
 
 			mockStoreReader: func(m *climocks.MockstoreReader) {
 				m.EXPECT().GetEnvironment("mockProject", "mockEnv").Return(&archer.Environment{
@@ -623,8 +773,9 @@ func TestAppLogs_Ask(t *testing.T) {
 
 			appLogs := &appLogsOpts{
 				appLogsVars: appLogsVars{
-					envName: tc.inputEnvName,
-					appName: tc.inputApplication,
+					envNames: tc.inputEnvName,
+					appNames: tc.inputApplication,
+					all:      tc.inputAll,
 					GlobalOpts: &GlobalOpts{
 						projectName: tc.inputProject,
 						prompt:      mockPrompter,
@@ -644,13 +795,17 @@ func TestAppLogs_Ask(t *testing.T) {
 			} else {
 				require.Nil(t, err)
 			}
+			if tc.wantedTargets != nil {
+				require.Equal(t, tc.wantedTargets, appLogs.targets)
+			}
 		})
 	}
 }
 
 func TestAppLogs_Execute(t *testing.T) {
-	mockNextToken := map[string]*string{
-		"mockLogStreamName": aws.String("mockNextToken"),
+	mockCursor := &cloudwatchlogs.LogEventsCursor{
+		Timestamp: 1,
+		EventIDs:  map[string]bool{"mockEventID": true},
 	}
 	logEvents := []*cloudwatchlogs.Event{
 		&cloudwatchlogs.Event{
@@ -682,9 +837,18 @@ func TestAppLogs_Execute(t *testing.T) {
 		inputApplication string
 		inputFollow      bool
 		inputEnvName     string
+		inputTargets     []*appEnv
+		inputLimit       int
 		inputJSON        bool
+		inputFields      []string
+		inputRaw         bool
+		inputFilter      string
+		inputTaskIDs     []string
+		inputPrefix      string
 
-		mockcwlogService func(ctrl *gomock.Controller) map[string]cwlogService
+		mockcwlogService      func(ctrl *gomock.Controller) map[string]cwlogService
+		mockTasksDescriber    func(m *climocks.MockrunningTasksDescriber)
+		initTasksDescriberErr error
 
 		wantedError   error
 		wantedContent string
@@ -740,25 +904,221 @@ func TestAppLogs_Execute(t *testing.T) {
 			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
 				m := climocks.NewMockcwlogService(ctrl)
 				cwlogServices := make(map[string]cwlogService)
-				m.EXPECT().TaskLogEvents(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockEnv", "mockApp"), nil, gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{
-					Events:     logEvents,
-					NextTokens: mockNextToken,
+				m.EXPECT().FilterTaskLogEvents(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockEnv", "mockApp"), nil, gomock.Any()).Return(&cloudwatchlogs.FilteredLogEventsOutput{
+					Events: logEvents,
+					Cursor: mockCursor,
 				}, nil)
-				m.EXPECT().TaskLogEvents(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockEnv", "mockApp"), mockNextToken, gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{
-					Events:     moreLogEvents,
-					NextTokens: nil,
+				m.EXPECT().FilterTaskLogEvents(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockEnv", "mockApp"), mockCursor, gomock.Any()).Return(nil, awserr.New("ThrottlingException", "Rate exceeded", nil))
+				m.EXPECT().FilterTaskLogEvents(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockEnv", "mockApp"), mockCursor, gomock.Any()).Return(&cloudwatchlogs.FilteredLogEventsOutput{
+					Events: moreLogEvents,
+					Cursor: mockCursor,
 				}, nil)
 				cwlogServices["mockEnv"] = m
 				return cwlogServices
 			},
+			mockTasksDescriber: func(m *climocks.MockrunningTasksDescriber) {
+				gomock.InOrder(
+					m.EXPECT().RunningTaskIDs().Return([]string{"123456789"}, nil),
+					m.EXPECT().RunningTaskIDs().Return(nil, nil),
+					m.EXPECT().RunningTaskIDs().Return([]string{"123456789"}, nil).Times(2),
+					m.EXPECT().RunningTaskIDs().Return(nil, errors.New("some error")),
+				)
+			},
 
-			wantedError: nil,
+			wantedError: fmt.Errorf("list running tasks of application mockApp in environment mockEnv: some error"),
 			wantedContent: `1234567 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1" 200 -
 1234567 10.0.0.00 - - [01/Jan/1970 01:01:01] "FATA some error" - -
 1234567 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warning" - -
 1234567 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1" 404 -
 `,
 		},
+		"returns error if fail to describe the running tasks": {
+			inputProject:     "mockProject",
+			inputApplication: "mockApp",
+			inputEnvName:     "mockEnv",
+			inputFollow:      true,
+
+			initTasksDescriberErr: errors.New("some error"),
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"returns error if fail to list the running tasks": {
+			inputProject:     "mockProject",
+			inputApplication: "mockApp",
+			inputEnvName:     "mockEnv",
+			inputFollow:      true,
+
+			mockTasksDescriber: func(m *climocks.MockrunningTasksDescriber) {
+				m.EXPECT().RunningTaskIDs().Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("list running tasks of application mockApp in environment mockEnv: some error"),
+		},
+		"returns error if following logs fails with an error other than throttling": {
+			inputProject:     "mockProject",
+			inputApplication: "mockApp",
+			inputEnvName:     "mockEnv",
+			inputFollow:      true,
+			inputPrefix:      "ecs/mockApp",
+
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				m := climocks.NewMockcwlogService(ctrl)
+				cwlogServices := make(map[string]cwlogService)
+				m.EXPECT().FilterTaskLogEvents(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockEnv", "mockApp"), nil, gomock.Any()).Return(nil, errors.New("some error"))
+				cwlogServices["mockEnv"] = m
+				return cwlogServices
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"with filter flags set": {
+			inputProject:     "mockProject",
+			inputApplication: "mockApp",
+			inputEnvName:     "mockEnv",
+			inputFilter:      "FATA",
+			inputTaskIDs:     []string{"123456789"},
+
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				m := climocks.NewMockcwlogService(ctrl)
+				cwlogServices := make(map[string]cwlogService)
+				m.EXPECT().FilterTaskLogEvents(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockEnv", "mockApp"), nil, gomock.Any()).
+					Return(&cloudwatchlogs.FilteredLogEventsOutput{
+						Events: logEvents[1:2],
+					}, nil)
+
+				cwlogServices["mockEnv"] = m
+				return cwlogServices
+			},
+
+			wantedError: nil,
+			wantedContent: `1234567 10.0.0.00 - - [01/Jan/1970 01:01:01] "FATA some error" - -
+`,
+		},
+		"with filter and follow flags set": {
+			inputProject:     "mockProject",
+			inputApplication: "mockApp",
+			inputEnvName:     "mockEnv",
+			inputFilter:      "GET",
+			inputFollow:      true,
+			inputJSON:        true,
+
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				m := climocks.NewMockcwlogService(ctrl)
+				cwlogServices := make(map[string]cwlogService)
+				m.EXPECT().FilterTaskLogEvents(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockEnv", "mockApp"), nil, gomock.Any()).
+					Return(&cloudwatchlogs.FilteredLogEventsOutput{
+						Events: logEvents[0:1],
+						Cursor: mockCursor,
+					}, nil)
+				m.EXPECT().FilterTaskLogEvents(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockEnv", "mockApp"), mockCursor, gomock.Any()).
+					Return(&cloudwatchlogs.FilteredLogEventsOutput{
+						Events: moreLogEvents,
+						Cursor: mockCursor,
+					}, nil)
+
+				cwlogServices["mockEnv"] = m
+				return cwlogServices
+			},
+
+			mockTasksDescriber: func(m *climocks.MockrunningTasksDescriber) {
+				gomock.InOrder(
+					m.EXPECT().RunningTaskIDs().Return([]string{"123456789"}, nil).Times(2),
+					m.EXPECT().RunningTaskIDs().Return(nil, errors.New("some error")),
+				)
+			},
+
+			wantedError:   fmt.Errorf("list running tasks of application mockApp in environment mockEnv: some error"),
+			wantedContent: "{\"taskID\":\"123456789\",\"ingestionTime\":0,\"message\":\"10.0.0.00 - - [01/Jan/1970 01:01:01] \\\"GET / HTTP/1.1\\\" 200 -\",\"timestamp\":0}\n{\"taskID\":\"123456789\",\"ingestionTime\":0,\"message\":\"10.0.0.00 - - [01/Jan/1970 01:01:01] \\\"GET / HTTP/1.1\\\" 404 -\",\"timestamp\":0}\n",
+		},
+		"returns error if fail to filter event logs": {
+			inputProject:     "mockProject",
+			inputApplication: "mockApp",
+			inputEnvName:     "mockEnv",
+			inputFilter:      "FATA",
+
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				m := climocks.NewMockcwlogService(ctrl)
+				cwlogServices := make(map[string]cwlogService)
+				m.EXPECT().FilterTaskLogEvents(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockEnv", "mockApp"), nil, gomock.Any()).Return(nil, errors.New("some error"))
+				cwlogServices["mockEnv"] = m
+				return cwlogServices
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"renders JSON log messages with the picked fields": {
+			inputProject:     "mockProject",
+			inputApplication: "mockApp",
+			inputEnvName:     "mockEnv",
+			inputFields:      []string{"status"},
+
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				m := climocks.NewMockcwlogService(ctrl)
+				cwlogServices := make(map[string]cwlogService)
+				m.EXPECT().TaskLogEvents(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockEnv", "mockApp"), nil, gomock.Any()).
+					Return(&cloudwatchlogs.LogEventsOutput{
+						Events: []*cloudwatchlogs.Event{
+							{TaskID: "123456789", Message: `{"level":"error","msg":"request failed","status":500,"path":"/"}`},
+						},
+					}, nil)
+				cwlogServices["mockEnv"] = m
+				return cwlogServices
+			},
+
+			wantedContent: "1234567 ERROR request failed status=500\n",
+		},
+		"with raw flag set": {
+			inputProject:     "mockProject",
+			inputApplication: "mockApp",
+			inputEnvName:     "mockEnv",
+			inputRaw:         true,
+
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				m := climocks.NewMockcwlogService(ctrl)
+				cwlogServices := make(map[string]cwlogService)
+				m.EXPECT().TaskLogEvents(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockEnv", "mockApp"), nil, gomock.Any()).
+					Return(&cloudwatchlogs.LogEventsOutput{
+						Events: []*cloudwatchlogs.Event{
+							{TaskID: "123456789", Message: `{"level":"error","msg":"request failed"}`},
+						},
+					}, nil)
+				cwlogServices["mockEnv"] = m
+				return cwlogServices
+			},
+
+			wantedContent: "1234567 {\"level\":\"error\",\"msg\":\"request failed\"}\n",
+		},
+		"interleaves the latest logs of several applications": {
+			inputProject: "mockProject",
+			inputTargets: []*appEnv{
+				{appName: "mockApp", envName: "mockEnv"},
+				{appName: "otherApp", envName: "mockEnv"},
+			},
+			inputLimit: 2,
+			inputJSON:  true,
+
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				m := climocks.NewMockcwlogService(ctrl)
+				cwlogServices := make(map[string]cwlogService)
+				m.EXPECT().TaskLogEvents(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockEnv", "mockApp"), nil, gomock.Any()).
+					Return(&cloudwatchlogs.LogEventsOutput{
+						Events: []*cloudwatchlogs.Event{
+							{TaskID: "123456789", Message: "first", Timestamp: 1},
+							{TaskID: "123456789", Message: "third", Timestamp: 3},
+						},
+					}, nil)
+				m.EXPECT().TaskLogEvents(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockEnv", "otherApp"), nil, gomock.Any()).
+					Return(&cloudwatchlogs.LogEventsOutput{
+						Events: []*cloudwatchlogs.Event{
+							{TaskID: "987654321", Message: "second", Timestamp: 2},
+						},
+					}, nil)
+				cwlogServices["mockEnv"] = m
+				return cwlogServices
+			},
+
+			wantedContent: "{\"appName\":\"otherApp\",\"envName\":\"mockEnv\",\"taskID\":\"987654321\",\"ingestionTime\":0,\"message\":\"second\",\"timestamp\":2}\n{\"appName\":\"mockApp\",\"envName\":\"mockEnv\",\"taskID\":\"123456789\",\"ingestionTime\":0,\"message\":\"third\",\"timestamp\":3}\n",
+		},
 		"returns error if fail to get event logs": {
 			inputProject:     "mockProject",
 			inputApplication: "mockApp",
@@ -781,20 +1141,52 @@ func TestAppLogs_Execute(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
+			targets := tc.inputTargets
+			if targets == nil {
+				targets = []*appEnv{
+					{
+						appName: tc.inputApplication,
+						envName: tc.inputEnvName,
+					},
+				}
+			}
+			limit := tc.inputLimit
+			if limit == 0 {
+				limit = 10
+			}
 			b := &bytes.Buffer{}
 			appLogs := &appLogsOpts{
 				appLogsVars: appLogsVars{
 					follow:           tc.inputFollow,
-					envName:          tc.inputEnvName,
-					appName:          tc.inputApplication,
+					limit:            limit,
 					shouldOutputJSON: tc.inputJSON,
+					fields:           tc.inputFields,
+					raw:              tc.inputRaw,
+					filterPattern:    tc.inputFilter,
+					taskIDs:          tc.inputTaskIDs,
+					logStreamPrefix:  tc.inputPrefix,
 					GlobalOpts: &GlobalOpts{
 						projectName: tc.inputProject,
 					},
 				},
+				targets:       targets,
 				initCwLogsSvc: func(*appLogsOpts, *archer.Environment) error { return nil },
-				cwlogsSvc:     tc.mockcwlogService(ctrl),
-				w:             b,
+				initTasksDescriber: func(o *appLogsOpts, target *appEnv) error {
+					if tc.initTasksDescriberErr != nil {
+						return tc.initTasksDescriberErr
+					}
+					m := climocks.NewMockrunningTasksDescriber(ctrl)
+					tc.mockTasksDescriber(m)
+					o.tasksDescribers[target.String()] = m
+					return nil
+				},
+				tasksDescribers: make(map[string]runningTasksDescriber),
+				sleep:           func(time.Duration) {},
+				now:             func() time.Time { return time.Unix(1600000000, 0) },
+				w:               b,
+			}
+			if tc.mockcwlogService != nil {
+				appLogs.cwlogsSvc = tc.mockcwlogService(ctrl)
 			}
 
 			// WHEN
@@ -805,8 +1197,67 @@ func TestAppLogs_Execute(t *testing.T) {
 				require.EqualError(t, err, tc.wantedError.Error())
 			} else {
 				require.Nil(t, err)
-				require.Equal(t, tc.wantedContent, b.String(), "expected output content match")
 			}
+			require.Equal(t, tc.wantedContent, b.String(), "expected output content match")
+		})
+	}
+}
+
+func TestAppLogs_Execute_FollowStartTime(t *testing.T) {
+	mockNow := time.Unix(1600000000, 0)
+	testCases := map[string]struct {
+		inputStartTime int64
+
+		wantedStartTime int64
+	}{
+		"starts following from the recent logs without a start time": {
+			wantedStartTime: mockNow.Add(-followLookback).Unix() * 1000,
+		},
+		"starts following from the start time": {
+			inputStartTime: 1234,
+
+			wantedStartTime: 1234,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := climocks.NewMockcwlogService(ctrl)
+			m.EXPECT().FilterTaskLogEvents(fmt.Sprintf(logGroupNamePattern, "mockProject", "mockEnv", "mockApp"), nil, gomock.Any()).
+				DoAndReturn(func(_ string, _ *cloudwatchlogs.LogEventsCursor, opts ...cloudwatchlogs.FilterLogEventsOpts) (*cloudwatchlogs.FilteredLogEventsOutput, error) {
+					in := &sdkcloudwatchlogs.FilterLogEventsInput{}
+					for _, opt := range opts {
+						opt(in)
+					}
+					require.Equal(t, tc.wantedStartTime, aws.Int64Value(in.StartTime))
+					return nil, errors.New("some error")
+				})
+
+			appLogs := &appLogsOpts{
+				appLogsVars: appLogsVars{
+					follow:          true,
+					limit:           10,
+					logStreamPrefix: "ecs/mockApp",
+					GlobalOpts: &GlobalOpts{
+						projectName: "mockProject",
+					},
+				},
+				startTime: tc.inputStartTime,
+				targets:   []*appEnv{{appName: "mockApp", envName: "mockEnv"}},
+				cwlogsSvc: map[string]cwlogService{"mockEnv": m},
+				sleep:     func(time.Duration) {},
+				now:       func() time.Time { return mockNow },
+				w:         &bytes.Buffer{},
+			}
+
+			// WHEN
+			err := appLogs.Execute()
+
+			// THEN
+			require.EqualError(t, err, "some error")
 		})
 	}
 }
@@ -140,6 +140,7 @@ func (o *showAppOpts) retrieveData() (*describe.WebApp, error) {
 				Environment: env.Name,
 				URL:         webAppURI.DNSName,
 				Path:        webAppURI.Path,
+				Aliases:     webAppURI.Aliases,
 			})
 
 			webAppECSParams, err := o.describer.ECSParams(env.Name)
@@ -505,6 +505,7 @@ func TestAppShow_Execute(t *testing.T) {
 				m.EXPECT().URI("prod").Return(&describe.WebAppURI{
 					DNSName: "my-pr-Publi.us-west-2.elb.amazonaws.com",
 					Path:    "/backend",
+					Aliases: []string{"example.com"},
 				}, nil)
 				m.EXPECT().ECSParams("test").Return(&describe.WebAppECSParams{
 					ContainerPort: "80",
@@ -567,6 +568,7 @@ Routes
   Environment       URL                                      Path
   test              my-pr-Publi.us-west-2.elb.amazonaws.com  /frontend
   prod              my-pr-Publi.us-west-2.elb.amazonaws.com  /backend
+  -                 example.com                              /backend
 
 Variables
 
@@ -0,0 +1,246 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	applicationStatusProjectNamePrompt     = "Which project's application would you like to check?"
+	applicationStatusProjectNameHelpPrompt = "A project groups all of your applications together."
+	applicationStatusAppNamePrompt         = "Which application of %s would you like to check?"
+	applicationStatusAppNameHelpPrompt     = "The running tasks, deployments, targets and alarms of the application will be shown."
+	applicationStatusEnvNamePrompt         = "Which environment of %s would you like to check?"
+	applicationStatusEnvNameHelpPrompt     = "The status of the application deployed in this environment will be shown."
+)
+
+type appStatusVars struct {
+	*GlobalOpts
+	shouldOutputJSON bool
+	appName          string
+	envName          string
+}
+
+type appStatusOpts struct {
+	appStatusVars
+
+	w             io.Writer
+	storeSvc      storeReader
+	describer     appStatusDescriber
+	initDescriber func(*appStatusOpts, *archer.Environment) error // Overriden in tests.
+}
+
+func newAppStatusOpts(vars appStatusVars) (*appStatusOpts, error) {
+	ssmStore, err := store.New()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	return &appStatusOpts{
+		appStatusVars: vars,
+		w:             log.OutputWriter,
+		storeSvc:      ssmStore,
+		initDescriber: func(o *appStatusOpts, env *archer.Environment) error {
+			d, err := describe.NewAppStatusDescriber(env, o.appName)
+			if err != nil {
+				return fmt.Errorf("creating status describer for application %s in environment %s: %w", o.appName, env.Name, err)
+			}
+			o.describer = d
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *appStatusOpts) Validate() error {
+	if o.ProjectName() != "" {
+		if _, err := o.storeSvc.GetProject(o.ProjectName()); err != nil {
+			return err
+		}
+	}
+	if o.appName != "" {
+		if _, err := o.storeSvc.GetApplication(o.ProjectName(), o.appName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.storeSvc.GetEnvironment(o.ProjectName(), o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *appStatusOpts) Ask() error {
+	if err := o.askProject(); err != nil {
+		return err
+	}
+	if err := o.askAppName(); err != nil {
+		return err
+	}
+	return o.askEnvName()
+}
+
+// Execute shows the running tasks, deployments, load balancer targets and alarms of the application.
+func (o *appStatusOpts) Execute() error {
+	env, err := o.storeSvc.GetEnvironment(o.ProjectName(), o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment: %w", err)
+	}
+	if err := o.initDescriber(o, env); err != nil {
+		return err
+	}
+	status, err := o.describer.Describe()
+	if err != nil {
+		return fmt.Errorf("describe status of application %s in environment %s: %w", o.appName, o.envName, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := status.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	fmt.Fprint(o.w, status.HumanString())
+	return nil
+}
+
+func (o *appStatusOpts) askProject() error {
+	if o.ProjectName() != "" {
+		return nil
+	}
+	projs, err := o.storeSvc.ListProjects()
+	if err != nil {
+		return fmt.Errorf("list projects: %w", err)
+	}
+	if len(projs) == 0 {
+		return fmt.Errorf("no project found: run %s please", color.HighlightCode("project init"))
+	}
+	var projNames []string
+	for _, proj := range projs {
+		projNames = append(projNames, proj.Name)
+	}
+	proj, err := o.prompt.SelectOne(
+		applicationStatusProjectNamePrompt,
+		applicationStatusProjectNameHelpPrompt,
+		projNames,
+	)
+	if err != nil {
+		return fmt.Errorf("select projects: %w", err)
+	}
+	o.projectName = proj
+	return nil
+}
+
+func (o *appStatusOpts) askAppName() error {
+	if o.appName != "" {
+		return nil
+	}
+	apps, err := o.storeSvc.ListApplications(o.ProjectName())
+	if err != nil {
+		return fmt.Errorf("list applications for project %s: %w", o.ProjectName(), err)
+	}
+	if len(apps) == 0 {
+		return fmt.Errorf("no applications found in project %s", color.HighlightUserInput(o.ProjectName()))
+	}
+	if len(apps) == 1 {
+		o.appName = apps[0].Name
+		log.Infof("Only found one application, defaulting to: %s\n", color.HighlightUserInput(o.appName))
+		return nil
+	}
+	var appNames []string
+	for _, app := range apps {
+		appNames = append(appNames, app.Name)
+	}
+	appName, err := o.prompt.SelectOne(
+		fmt.Sprintf(applicationStatusAppNamePrompt, color.HighlightUserInput(o.ProjectName())),
+		applicationStatusAppNameHelpPrompt,
+		appNames,
+	)
+	if err != nil {
+		return fmt.Errorf("select applications for project %s: %w", o.ProjectName(), err)
+	}
+	o.appName = appName
+	return nil
+}
+
+func (o *appStatusOpts) askEnvName() error {
+	if o.envName != "" {
+		return nil
+	}
+	envs, err := o.storeSvc.ListEnvironments(o.ProjectName())
+	if err != nil {
+		return fmt.Errorf("list environments: %w", err)
+	}
+	if len(envs) == 0 {
+		return fmt.Errorf("no environments found in project %s", color.HighlightUserInput(o.ProjectName()))
+	}
+	if len(envs) == 1 {
+		o.envName = envs[0].Name
+		log.Infof("Only found one environment, defaulting to: %s\n", color.HighlightUserInput(o.envName))
+		return nil
+	}
+	var envNames []string
+	for _, env := range envs {
+		envNames = append(envNames, env.Name)
+	}
+	envName, err := o.prompt.SelectOne(
+		fmt.Sprintf(applicationStatusEnvNamePrompt, color.HighlightUserInput(o.appName)),
+		applicationStatusEnvNameHelpPrompt,
+		envNames,
+	)
+	if err != nil {
+		return fmt.Errorf("select environments for project %s: %w", o.ProjectName(), err)
+	}
+	o.envName = envName
+	return nil
+}
+
+// BuildAppStatusCmd builds the command for showing the runtime status of a deployed application.
+func BuildAppStatusCmd() *cobra.Command {
+	vars := appStatusVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Shows the status of a deployed application.",
+		Long: `Shows the status of a deployed application in an environment, including its desired, running and pending task counts,
+its deployments, the health of its tasks and load balancer targets, and its CloudWatch alarms in ALARM state.`,
+
+		Example: `
+  Shows the status of the application "my-app" in the environment "test"
+  /code $ ecs-preview app status -n my-app -e test
+
+  Shows the status of the application "my-app" in the environment "test" in JSON format
+  /code $ ecs-preview app status -n my-app -e test --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newAppStatusOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, nameFlag, nameFlagShort, "", appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().StringVarP(&vars.projectName, projectFlag, projectFlagShort, "", projectFlagDescription)
+	return cmd
+}
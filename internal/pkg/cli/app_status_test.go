@@ -0,0 +1,304 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppStatus_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputProject     string
+		inputApplication string
+		inputEnvironment string
+		mockStoreReader  func(m *climocks.MockstoreReader)
+
+		wantedError error
+	}{
+		"valid project, application and environment names": {
+			inputProject:     "my-project",
+			inputApplication: "my-app",
+			inputEnvironment: "test",
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().GetProject("my-project").Return(&archer.Project{Name: "my-project"}, nil)
+				m.EXPECT().GetApplication("my-project", "my-app").Return(&archer.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetEnvironment("my-project", "test").Return(&archer.Environment{Name: "test"}, nil)
+			},
+		},
+		"invalid project name": {
+			inputProject: "my-project",
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().GetProject("my-project").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"invalid environment name": {
+			inputProject:     "my-project",
+			inputEnvironment: "test",
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().GetProject("my-project").Return(&archer.Project{Name: "my-project"}, nil)
+				m.EXPECT().GetEnvironment("my-project", "test").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStoreReader := climocks.NewMockstoreReader(ctrl)
+			tc.mockStoreReader(mockStoreReader)
+
+			opts := &appStatusOpts{
+				appStatusVars: appStatusVars{
+					appName: tc.inputApplication,
+					envName: tc.inputEnvironment,
+					GlobalOpts: &GlobalOpts{
+						projectName: tc.inputProject,
+					},
+				},
+				storeSvc: mockStoreReader,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAppStatus_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputProject     string
+		inputApplication string
+		inputEnvironment string
+
+		mockStoreReader func(m *climocks.MockstoreReader)
+		mockPrompt      func(m *climocks.Mockprompter)
+
+		wantedProject string
+		wantedApp     string
+		wantedEnv     string
+		wantedError   error
+	}{
+		"skips prompting if all the names are set": {
+			inputProject:     "my-project",
+			inputApplication: "my-app",
+			inputEnvironment: "test",
+
+			mockStoreReader: func(m *climocks.MockstoreReader) {},
+			mockPrompt:      func(m *climocks.Mockprompter) {},
+
+			wantedProject: "my-project",
+			wantedApp:     "my-app",
+			wantedEnv:     "test",
+		},
+		"prompts for the project, application and environment": {
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().ListProjects().Return([]*archer.Project{{Name: "my-project"}, {Name: "other-project"}}, nil)
+				m.EXPECT().ListApplications("my-project").Return([]*archer.Application{{Name: "my-app"}, {Name: "other-app"}}, nil)
+				m.EXPECT().ListEnvironments("my-project").Return([]*archer.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().SelectOne(applicationStatusProjectNamePrompt, applicationStatusProjectNameHelpPrompt, []string{"my-project", "other-project"}).Return("my-project", nil)
+				m.EXPECT().SelectOne(fmt.Sprintf(applicationStatusAppNamePrompt, "my-project"), applicationStatusAppNameHelpPrompt, []string{"my-app", "other-app"}).Return("my-app", nil)
+				m.EXPECT().SelectOne(fmt.Sprintf(applicationStatusEnvNamePrompt, "my-app"), applicationStatusEnvNameHelpPrompt, []string{"test", "prod"}).Return("prod", nil)
+			},
+
+			wantedProject: "my-project",
+			wantedApp:     "my-app",
+			wantedEnv:     "prod",
+		},
+		"defaults to the only application and environment": {
+			inputProject: "my-project",
+
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().ListApplications("my-project").Return([]*archer.Application{{Name: "my-app"}}, nil)
+				m.EXPECT().ListEnvironments("my-project").Return([]*archer.Environment{{Name: "test"}}, nil)
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {},
+
+			wantedProject: "my-project",
+			wantedApp:     "my-app",
+			wantedEnv:     "test",
+		},
+		"returns error if there are no applications": {
+			inputProject: "my-project",
+
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().ListApplications("my-project").Return(nil, nil)
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {},
+
+			wantedError: fmt.Errorf("no applications found in project my-project"),
+		},
+		"returns error if fail to list environments": {
+			inputProject:     "my-project",
+			inputApplication: "my-app",
+
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().ListEnvironments("my-project").Return(nil, errors.New("some error"))
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {},
+
+			wantedError: fmt.Errorf("list environments: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStoreReader := climocks.NewMockstoreReader(ctrl)
+			mockPrompter := climocks.NewMockprompter(ctrl)
+			tc.mockStoreReader(mockStoreReader)
+			tc.mockPrompt(mockPrompter)
+
+			opts := &appStatusOpts{
+				appStatusVars: appStatusVars{
+					appName: tc.inputApplication,
+					envName: tc.inputEnvironment,
+					GlobalOpts: &GlobalOpts{
+						prompt:      mockPrompter,
+						projectName: tc.inputProject,
+					},
+				},
+				storeSvc: mockStoreReader,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedProject, opts.ProjectName(), "expected project name to match")
+				require.Equal(t, tc.wantedApp, opts.appName, "expected application name to match")
+				require.Equal(t, tc.wantedEnv, opts.envName, "expected environment name to match")
+			}
+		})
+	}
+}
+
+func TestAppStatus_Execute(t *testing.T) {
+	mockStatus := &describe.AppStatus{
+		Project:      "my-project",
+		AppName:      "my-app",
+		Environment:  "test",
+		DesiredCount: 1,
+		RunningCount: 1,
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+
+		mockStoreReader func(m *climocks.MockstoreReader)
+		mockDescriber   func(m *climocks.MockappStatusDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"returns error if fail to get the environment": {
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().GetEnvironment("my-project", "test").Return(nil, errors.New("some error"))
+			},
+			mockDescriber: func(m *climocks.MockappStatusDescriber) {},
+
+			wantedError: fmt.Errorf("get environment: some error"),
+		},
+		"returns wrapped error if fail to describe the status": {
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().GetEnvironment("my-project", "test").Return(&archer.Environment{Name: "test"}, nil)
+			},
+			mockDescriber: func(m *climocks.MockappStatusDescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("describe status of application my-app in environment test: some error"),
+		},
+		"prints the status in JSON format": {
+			shouldOutputJSON: true,
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().GetEnvironment("my-project", "test").Return(&archer.Environment{Name: "test"}, nil)
+			},
+			mockDescriber: func(m *climocks.MockappStatusDescriber) {
+				m.EXPECT().Describe().Return(mockStatus, nil)
+			},
+
+			wantedContent: "{\"project\":\"my-project\",\"appName\":\"my-app\",\"environment\":\"test\",\"desiredCount\":1,\"runningCount\":1,\"pendingCount\":0,\"deployments\":null,\"tasks\":null,\"targets\":null,\"alarms\":null}\n",
+		},
+		"prints the status in human format": {
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().GetEnvironment("my-project", "test").Return(&archer.Environment{Name: "test"}, nil)
+			},
+			mockDescriber: func(m *climocks.MockappStatusDescriber) {
+				m.EXPECT().Describe().Return(mockStatus, nil)
+			},
+
+			wantedContent: mockStatus.HumanString(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockStoreReader := climocks.NewMockstoreReader(ctrl)
+			mockDescriber := climocks.NewMockappStatusDescriber(ctrl)
+			tc.mockStoreReader(mockStoreReader)
+			tc.mockDescriber(mockDescriber)
+
+			opts := &appStatusOpts{
+				appStatusVars: appStatusVars{
+					appName:          "my-app",
+					envName:          "test",
+					shouldOutputJSON: tc.shouldOutputJSON,
+					GlobalOpts: &GlobalOpts{
+						projectName: "my-project",
+					},
+				},
+				w:        b,
+				storeSvc: mockStoreReader,
+				initDescriber: func(o *appStatusOpts, env *archer.Environment) error {
+					o.describer = mockDescriber
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -11,6 +11,9 @@ import (
 // Interfaces for deploying resources through CloudFormation. Facilitates mocking.
 type environmentDeployer interface {
 	DeployEnvironment(env *deploy.CreateEnvironmentInput) error
+	UpdateEnvironment(env *deploy.CreateEnvironmentInput) error
+	UpdateEnvironmentDomain(env *deploy.CreateEnvironmentInput) error
+	HTTPSListenerImporters(projectName, envName string) ([]string, error)
 	StreamEnvironmentCreation(env *deploy.CreateEnvironmentInput) (<-chan []deploy.ResourceEvent, <-chan deploy.CreateEnvironmentResponse)
 	DeleteEnvironment(projName, envName string) error
 }
@@ -20,6 +23,7 @@ type pipelineDeployer interface {
 	UpdatePipeline(env *deploy.CreatePipelineInput) error
 	PipelineExists(env *deploy.CreatePipelineInput) (bool, error)
 	AddPipelineResourcesToProject(project *archer.Project, region string) error
+	DeletePipeline(projectName, pipelineName string) error
 	projectResourcesGetter
 	// TODO: Add StreamPipelineCreation method
 }
@@ -29,6 +33,7 @@ type projectDeployer interface {
 	AddAppToProject(project *archer.Project, appName string) error
 	AddEnvToProject(project *archer.Project, env *archer.Environment) error
 	DelegateDNSPermissions(project *archer.Project, accountID string) error
+	UpdateProjectDomain(project *archer.Project, accountIDs []string) error
 	DeleteProject(name string) error
 }
 
@@ -4,13 +4,101 @@
 package cli
 
 import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	awssession "github.com/aws/aws-sdk-go/aws/session"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/aws/amazon-ecs-cli-v2/cmd/ecs-preview/template"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/session"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/group"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
 )
 
+// envCredentialVars holds the flags used to access the account of an environment.
+type envCredentialVars struct {
+	EnvProfile string // AWS named profile of the environment's account.
+	RoleARN    string // IAM role to assume in the environment's account instead of using a named profile.
+	ExternalID string // External ID to pass when assuming RoleARN.
+}
+
+// validate returns an error if the credential flags can't be used together.
+func (v envCredentialVars) validate() error {
+	if v.EnvProfile != "" && v.RoleARN != "" {
+		return fmt.Errorf("only one of --%s or --%s may be used", profileFlag, roleARNFlag)
+	}
+	if v.ExternalID != "" && v.RoleARN == "" {
+		return fmt.Errorf("--%s requires --%s", externalIDFlag, roleARNFlag)
+	}
+	if v.RoleARN != "" && !arn.IsARN(v.RoleARN) {
+		return fmt.Errorf("--%s %s is not a valid ARN", roleARNFlag, v.RoleARN)
+	}
+	return nil
+}
+
+// session returns a session against the environment's account.
+// If a role is provided, the role is assumed from the default session in the default session's region.
+// Otherwise, the session is created from the named profile.
+func (v envCredentialVars) session(provider *session.Provider) (*awssession.Session, error) {
+	if v.RoleARN == "" {
+		sess, err := provider.FromProfile(v.EnvProfile)
+		if err != nil {
+			return nil, fmt.Errorf("create session from profile %s: %w", v.EnvProfile, err)
+		}
+		return sess, nil
+	}
+	defaultSess, err := provider.Default()
+	if err != nil {
+		return nil, err
+	}
+	region := aws.StringValue(defaultSess.Config.Region)
+	if region == "" {
+		return nil, errors.New("no region found in the default session to assume the role in, set the AWS_REGION environment variable please")
+	}
+	sess, err := provider.FromRoleWithExternalID(v.RoleARN, v.ExternalID, region)
+	if err != nil {
+		return nil, fmt.Errorf("create session from role %s: %w", v.RoleARN, err)
+	}
+	return sess, nil
+}
+
+// envDeployInput returns the input to deploy the environment described by the manifest in the project.
+func envDeployInput(project *archer.Project, toolsAccountPrincipalARN string, mft *manifest.EnvironmentManifest) *deploy.CreateEnvironmentInput {
+	in := &deploy.CreateEnvironmentInput{
+		Name:                     mft.Name,
+		Project:                  project.Name,
+		Prod:                     mft.Prod,
+		PublicLoadBalancer:       mft.PublicLoadBalancer,
+		ToolsAccountPrincipalARN: toolsAccountPrincipalARN,
+		ProjectDNSName:           project.Domain,
+		ImportCertARN:            mft.CertificateARN,
+		ContainerInsights:        mft.Cluster.ContainerInsights,
+		FargateSpot:              mft.Cluster.FargateSpot,
+	}
+	if vpc := mft.Network.VPC; vpc != nil {
+		in.AdjustVPC = &deploy.AdjustVPCConfig{
+			CIDR:               vpc.CIDR,
+			PublicSubnetCIDRs:  vpc.PublicSubnetCIDRs,
+			PrivateSubnetCIDRs: vpc.PrivateSubnetCIDRs,
+			Endpoints:          vpc.Endpoints,
+		}
+	}
+	if vpc := mft.Network.ImportVPC; vpc != nil {
+		in.ImportVPC = &deploy.ImportVPCConfig{
+			ID:               vpc.ID,
+			PublicSubnetIDs:  vpc.PublicSubnetIDs,
+			PrivateSubnetIDs: vpc.PrivateSubnetIDs,
+		}
+	}
+	return in
+}
+
 // BuildEnvCmd is the top level command for environments
 func BuildEnvCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -25,6 +113,8 @@ An environment represents a deployment stage.`,
 
 	cmd.AddCommand(BuildEnvInitCmd())
 	cmd.AddCommand(BuildEnvListCmd())
+	cmd.AddCommand(BuildEnvShowCmd())
+	cmd.AddCommand(BuildEnvDeployCmd())
 	cmd.AddCommand(BuildEnvDeleteCmd())
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -42,8 +42,8 @@ type resourceGetter interface {
 
 type deleteEnvVars struct {
 	*GlobalOpts
+	envCredentialVars
 	EnvName          string
-	EnvProfile       string
 	SkipConfirmation bool
 }
 
@@ -76,12 +76,12 @@ func newDeleteEnvOpts(vars deleteEnvVars) (*deleteEnvOpts, error) {
 		profileConfig: cfg,
 		prog:          termprogress.NewSpinner(),
 		initProfileClients: func(o *deleteEnvOpts) error {
-			profileSess, err := session.NewProvider().FromProfile(o.EnvProfile)
+			envSess, err := o.session(session.NewProvider())
 			if err != nil {
-				return fmt.Errorf("cannot create session from profile %s: %w", o.EnvProfile, err)
+				return err
 			}
-			o.rgClient = resourcegroupstaggingapi.New(profileSess)
-			o.deployClient = cloudformation.New(profileSess)
+			o.rgClient = resourcegroupstaggingapi.New(envSess)
+			o.deployClient = cloudformation.New(envSess)
 			return nil
 		},
 	}, nil
@@ -94,7 +94,7 @@ func (o *deleteEnvOpts) Validate() error {
 			return err
 		}
 	}
-	return nil
+	return o.envCredentialVars.validate()
 }
 
 // Ask prompts for fields that are required but not passed in.
@@ -211,7 +211,7 @@ func (o *deleteEnvOpts) askEnvName() error {
 }
 
 func (o *deleteEnvOpts) askProfile() error {
-	if o.EnvProfile != "" {
+	if o.EnvProfile != "" || o.RoleARN != "" {
 		return nil
 	}
 
@@ -256,7 +256,7 @@ func (o *deleteEnvOpts) deleteStack() bool {
 
 func (o *deleteEnvOpts) deleteFromStore() {
 	if err := o.storeClient.DeleteEnvironment(o.ProjectName(), o.EnvName); err != nil {
-		log.Infof("Failed to remove environment %s from project %s store: %w\n", o.EnvName, o.ProjectName(), err)
+		log.Infof("Failed to remove environment %s from project %s store: %v\n", o.EnvName, o.ProjectName(), err)
 	}
 }
 
@@ -273,7 +273,10 @@ func BuildEnvDeleteCmd() *cobra.Command {
   /code $ ecs-preview env delete --name test --profile default
 
   Delete the "test" environment without prompting.
-	/code $ ecs-preview env delete --name test --profile default --yes`,
+  /code $ ecs-preview env delete --name test --profile default --yes
+
+  Delete the "test" environment by assuming a role in its account.
+  /code $ ecs-preview env delete --name test --role-arn arn:aws:iam::123456789012:role/admin`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDeleteEnvOpts(vars)
 			if err != nil {
@@ -290,6 +293,8 @@ func BuildEnvDeleteCmd() *cobra.Command {
 	}
 	cmd.Flags().StringVarP(&vars.EnvName, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.EnvProfile, profileFlag, "", profileFlagDescription)
+	cmd.Flags().StringVar(&vars.RoleARN, roleARNFlag, "", roleARNFlagDescription)
+	cmd.Flags().StringVar(&vars.ExternalID, externalIDFlag, "", externalIDFlagDescription)
 	cmd.Flags().BoolVar(&vars.SkipConfirmation, yesFlag, false, yesFlagDescription)
 	return cmd
 }
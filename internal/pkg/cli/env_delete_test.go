@@ -190,8 +190,10 @@ func TestDeleteEnvOpts_Ask(t *testing.T) {
 			defer ctrl.Finish()
 			opts := &deleteEnvOpts{
 				deleteEnvVars: deleteEnvVars{
-					EnvName:    tc.inEnvName,
-					EnvProfile: tc.inEnvProfile,
+					EnvName: tc.inEnvName,
+					envCredentialVars: envCredentialVars{
+						EnvProfile: tc.inEnvProfile,
+					},
 					GlobalOpts: &GlobalOpts{
 						projectName: testProject,
 					},
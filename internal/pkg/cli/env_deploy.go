@@ -0,0 +1,250 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/identity"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/profile"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/session"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	termprogress "github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envDeployNamePrompt     = "Which environment would you like to deploy?"
+	envDeployNameHelpPrompt = "The environment's manifest in your workspace will be applied to it."
+
+	fmtEnvDeployProfilePrompt  = "Which named profile should we use to update %s?"
+	envDeployProfileHelpPrompt = "The AWS CLI named profile with the permissions to update an environment."
+)
+
+const (
+	fmtUpdateEnvStart    = "Updating the infrastructure for the %s environment."
+	fmtUpdateEnvFailed   = "Failed to update the infrastructure for the %s environment."
+	fmtUpdateEnvComplete = "Updated the infrastructure for the %s environment."
+)
+
+type deployEnvVars struct {
+	*GlobalOpts
+	envCredentialVars        // AWS profile or role used to update the environment.
+	EnvName           string // Name of the environment.
+}
+
+type deployEnvOpts struct {
+	deployEnvVars
+
+	// Interfaces to interact with dependencies.
+	storeSvc      storeReader
+	ws            wsEnvManifestReader
+	identity      identityService
+	envDeployer   environmentDeployer
+	profileConfig profileNames
+	prog          progress
+
+	// initProfileClients is overriden in tests.
+	initProfileClients func(*deployEnvOpts) error
+}
+
+func newDeployEnvOpts(vars deployEnvVars) (*deployEnvOpts, error) {
+	store, err := store.New()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	sessProvider := session.NewProvider()
+	defaultSession, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := profile.NewConfig()
+	if err != nil {
+		return nil, fmt.Errorf("read named profiles: %w", err)
+	}
+
+	return &deployEnvOpts{
+		deployEnvVars: vars,
+		storeSvc:      store,
+		ws:            ws,
+		identity:      identity.New(defaultSession),
+		profileConfig: cfg,
+		prog:          termprogress.NewSpinner(),
+		initProfileClients: func(o *deployEnvOpts) error {
+			envSess, err := o.session(sessProvider)
+			if err != nil {
+				return err
+			}
+			o.envDeployer = cloudformation.New(envSess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values passed by the user are invalid.
+func (o *deployEnvOpts) Validate() error {
+	if o.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if _, err := o.storeSvc.GetProject(o.ProjectName()); err != nil {
+		return err
+	}
+	if o.EnvName != "" {
+		if _, err := o.storeSvc.GetEnvironment(o.ProjectName(), o.EnvName); err != nil {
+			return err
+		}
+	}
+	return o.envCredentialVars.validate()
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *deployEnvOpts) Ask() error {
+	if err := o.askEnvName(); err != nil {
+		return err
+	}
+	return o.askEnvProfile()
+}
+
+// Execute applies the environment's manifest in the workspace to its CloudFormation stack.
+func (o *deployEnvOpts) Execute() error {
+	project, err := o.storeSvc.GetProject(o.ProjectName())
+	if err != nil {
+		return err
+	}
+	env, err := o.storeSvc.GetEnvironment(o.ProjectName(), o.EnvName)
+	if err != nil {
+		return err
+	}
+	mft, err := o.envManifest()
+	if err != nil {
+		return err
+	}
+	if mft.Prod != env.Prod {
+		log.Warningf("Environment %s can't be changed to or from a production environment after it's created, ignoring the %s field.\n",
+			color.HighlightUserInput(o.EnvName), color.HighlightCode("prod"))
+		mft.Prod = env.Prod
+	}
+	if err := o.initProfileClients(o); err != nil {
+		return err
+	}
+	caller, err := o.identity.Get()
+	if err != nil {
+		return fmt.Errorf("get identity: %w", err)
+	}
+
+	o.prog.Start(fmt.Sprintf(fmtUpdateEnvStart, color.HighlightUserInput(o.EnvName)))
+	if err := o.envDeployer.UpdateEnvironment(envDeployInput(project, caller.RootUserARN, mft)); err != nil {
+		o.prog.Stop(log.Serrorf(fmtUpdateEnvFailed, color.HighlightUserInput(o.EnvName)))
+		return err
+	}
+	o.prog.Stop(log.Ssuccessf(fmtUpdateEnvComplete, color.HighlightUserInput(o.EnvName)))
+	return nil
+}
+
+func (o *deployEnvOpts) envManifest() (*manifest.EnvironmentManifest, error) {
+	data, err := o.ws.ReadEnvironmentManifest(o.EnvName)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest for environment %s: %w", o.EnvName, err)
+	}
+	mft, err := manifest.UnmarshalEnvironment(data)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal manifest for environment %s: %w", o.EnvName, err)
+	}
+	if mft.Name != o.EnvName {
+		return nil, fmt.Errorf("manifest for environment %s has a different name %s", o.EnvName, mft.Name)
+	}
+	return mft, nil
+}
+
+func (o *deployEnvOpts) askEnvName() error {
+	if o.EnvName != "" {
+		return nil
+	}
+
+	envs, err := o.storeSvc.ListEnvironments(o.ProjectName())
+	if err != nil {
+		return fmt.Errorf("list environments for project %s: %w", o.ProjectName(), err)
+	}
+	if len(envs) == 0 {
+		return fmt.Errorf("couldn't find any environment in the project %s, run %s first please", o.ProjectName(), color.HighlightCode("env init"))
+	}
+	if len(envs) == 1 {
+		o.EnvName = envs[0].Name
+		return nil
+	}
+	var names []string
+	for _, env := range envs {
+		names = append(names, env.Name)
+	}
+	name, err := o.prompt.SelectOne(envDeployNamePrompt, envDeployNameHelpPrompt, names)
+	if err != nil {
+		return fmt.Errorf("select environment for project %s: %w", o.ProjectName(), err)
+	}
+	o.EnvName = name
+	return nil
+}
+
+func (o *deployEnvOpts) askEnvProfile() error {
+	if o.EnvProfile != "" || o.RoleARN != "" {
+		return nil
+	}
+
+	names := o.profileConfig.Names()
+	if len(names) == 0 {
+		return errNamedProfilesNotFound
+	}
+
+	profile, err := o.prompt.SelectOne(
+		fmt.Sprintf(fmtEnvDeployProfilePrompt, color.HighlightUserInput(o.EnvName)),
+		envDeployProfileHelpPrompt,
+		names)
+	if err != nil {
+		return fmt.Errorf("prompt to get the profile name: %w", err)
+	}
+	o.EnvProfile = profile
+	return nil
+}
+
+// BuildEnvDeployCmd builds the command for applying an environment's manifest.
+func BuildEnvDeployCmd() *cobra.Command {
+	vars := deployEnvVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "deploy",
+		Short: "Deploys the manifest of an environment in your workspace.",
+		Long:  "Updates an existing environment with the changes to its manifest under ecs-project/environments.",
+		Example: `
+  Applies the manifest of the test environment with your "default" AWS profile.
+  /code $ ecs-preview env deploy --name test --profile default`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDeployEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.EnvName, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVar(&vars.EnvProfile, profileFlag, "", profileFlagDescription)
+	cmd.Flags().StringVar(&vars.RoleARN, roleARNFlag, "", roleARNFlagDescription)
+	cmd.Flags().StringVar(&vars.ExternalID, externalIDFlag, "", externalIDFlagDescription)
+	return cmd
+}
@@ -0,0 +1,359 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/identity"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployEnvOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inProjectName string
+		inEnvName     string
+		inProfile     string
+		inRoleARN     string
+
+		mockStoreReader func(m *climocks.MockstoreReader)
+
+		wantedError error
+	}{
+		"no project in workspace": {
+			mockStoreReader: func(m *climocks.MockstoreReader) {},
+
+			wantedError: errNoProjectInWorkspace,
+		},
+		"invalid environment name": {
+			inProjectName: "phonetool",
+			inEnvName:     "test",
+
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"invalid credential flags": {
+			inProjectName: "phonetool",
+			inProfile:     "default",
+			inRoleARN:     "arn:aws:iam::123456789012:role/admin",
+
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool"}, nil)
+			},
+
+			wantedError: errors.New("only one of --profile or --role-arn may be used"),
+		},
+		"valid flags": {
+			inProjectName: "phonetool",
+			inEnvName:     "test",
+			inProfile:     "default",
+
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{Name: "test"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStoreReader := climocks.NewMockstoreReader(ctrl)
+			tc.mockStoreReader(mockStoreReader)
+
+			opts := &deployEnvOpts{
+				deployEnvVars: deployEnvVars{
+					GlobalOpts: &GlobalOpts{projectName: tc.inProjectName},
+					envCredentialVars: envCredentialVars{
+						EnvProfile: tc.inProfile,
+						RoleARN:    tc.inRoleARN,
+					},
+					EnvName: tc.inEnvName,
+				},
+				storeSvc: mockStoreReader,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeployEnvOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvName string
+		inRoleARN string
+
+		mockStoreReader func(m *climocks.MockstoreReader)
+		mockProfiles    func(m *climocks.MockprofileNames)
+		mockPrompt      func(m *climocks.Mockprompter)
+
+		wantedEnvName string
+		wantedProfile string
+		wantedError   error
+	}{
+		"prompts for the environment and profile": {
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{
+					{Name: "test"},
+					{Name: "prod"},
+				}, nil)
+			},
+			mockProfiles: func(m *climocks.MockprofileNames) {
+				m.EXPECT().Names().Return([]string{"default", "prod-admin"})
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().SelectOne(envDeployNamePrompt, envDeployNameHelpPrompt, []string{"test", "prod"}).Return("prod", nil)
+				m.EXPECT().SelectOne(fmt.Sprintf(fmtEnvDeployProfilePrompt, "prod"), envDeployProfileHelpPrompt, []string{"default", "prod-admin"}).Return("prod-admin", nil)
+			},
+
+			wantedEnvName: "prod",
+			wantedProfile: "prod-admin",
+		},
+		"skips prompting for the profile if a role is provided": {
+			inEnvName: "test",
+			inRoleARN: "arn:aws:iam::123456789012:role/admin",
+
+			mockStoreReader: func(m *climocks.MockstoreReader) {},
+			mockProfiles:    func(m *climocks.MockprofileNames) {},
+			mockPrompt:      func(m *climocks.Mockprompter) {},
+
+			wantedEnvName: "test",
+		},
+		"returns error if there are no environments": {
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+			},
+			mockProfiles: func(m *climocks.MockprofileNames) {},
+			mockPrompt:   func(m *climocks.Mockprompter) {},
+
+			wantedError: fmt.Errorf("couldn't find any environment in the project phonetool, run %s first please", "`env init`"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStoreReader := climocks.NewMockstoreReader(ctrl)
+			mockProfiles := climocks.NewMockprofileNames(ctrl)
+			mockPrompt := climocks.NewMockprompter(ctrl)
+			tc.mockStoreReader(mockStoreReader)
+			tc.mockProfiles(mockProfiles)
+			tc.mockPrompt(mockPrompt)
+
+			opts := &deployEnvOpts{
+				deployEnvVars: deployEnvVars{
+					GlobalOpts: &GlobalOpts{
+						projectName: "phonetool",
+						prompt:      mockPrompt,
+					},
+					envCredentialVars: envCredentialVars{
+						RoleARN: tc.inRoleARN,
+					},
+					EnvName: tc.inEnvName,
+				},
+				storeSvc:      mockStoreReader,
+				profileConfig: mockProfiles,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedEnvName, opts.EnvName)
+				require.Equal(t, tc.wantedProfile, opts.EnvProfile)
+			}
+		})
+	}
+}
+
+func TestDeployEnvOpts_Execute(t *testing.T) {
+	const testManifest = `
+name: test
+publicLoadBalancer: true
+network:
+  vpc:
+    cidr: 172.16.0.0/16
+    publicSubnetCIDRs: [172.16.0.0/24, 172.16.1.0/24]
+    privateSubnetCIDRs: [172.16.2.0/24, 172.16.3.0/24]
+`
+	mockStore := func(m *climocks.MockstoreReader) {
+		m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool", Domain: "example.com"}, nil)
+		m.EXPECT().GetEnvironment("phonetool", "test").Return(&archer.Environment{Project: "phonetool", Name: "test"}, nil)
+	}
+	testCases := map[string]struct {
+		mockStoreReader func(m *climocks.MockstoreReader)
+		mockWorkspace   func(m *climocks.MockwsEnvManifestReader)
+		mockIdentity    func(m *climocks.MockidentityService)
+		mockDeployer    func(m *climocks.MockenvironmentDeployer)
+		mockProgress    func(m *climocks.Mockprogress)
+
+		wantedError error
+	}{
+		"returns error if fail to read the manifest": {
+			mockStoreReader: mockStore,
+			mockWorkspace: func(m *climocks.MockwsEnvManifestReader) {
+				m.EXPECT().ReadEnvironmentManifest("test").Return(nil, errors.New("some error"))
+			},
+			mockIdentity: func(m *climocks.MockidentityService) {},
+			mockDeployer: func(m *climocks.MockenvironmentDeployer) {},
+			mockProgress: func(m *climocks.Mockprogress) {},
+
+			wantedError: errors.New("read manifest for environment test: some error"),
+		},
+		"returns error if the manifest is for another environment": {
+			mockStoreReader: mockStore,
+			mockWorkspace: func(m *climocks.MockwsEnvManifestReader) {
+				m.EXPECT().ReadEnvironmentManifest("test").Return([]byte(`name: prod`), nil)
+			},
+			mockIdentity: func(m *climocks.MockidentityService) {},
+			mockDeployer: func(m *climocks.MockenvironmentDeployer) {},
+			mockProgress: func(m *climocks.Mockprogress) {},
+
+			wantedError: errors.New("manifest for environment test has a different name prod"),
+		},
+		"returns error if fail to update the environment": {
+			mockStoreReader: mockStore,
+			mockWorkspace: func(m *climocks.MockwsEnvManifestReader) {
+				m.EXPECT().ReadEnvironmentManifest("test").Return([]byte(testManifest), nil)
+			},
+			mockIdentity: func(m *climocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			},
+			mockDeployer: func(m *climocks.MockenvironmentDeployer) {
+				m.EXPECT().UpdateEnvironment(gomock.Any()).Return(errors.New("some error"))
+			},
+			mockProgress: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtUpdateEnvStart, "test"))
+				m.EXPECT().Stop(log.Serrorf(fmtUpdateEnvFailed, "test"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"ignores the prod field of the manifest": {
+			mockStoreReader: mockStore,
+			mockWorkspace: func(m *climocks.MockwsEnvManifestReader) {
+				m.EXPECT().ReadEnvironmentManifest("test").Return([]byte(`
+name: test
+prod: true
+`), nil)
+			},
+			mockIdentity: func(m *climocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			},
+			mockDeployer: func(m *climocks.MockenvironmentDeployer) {
+				m.EXPECT().UpdateEnvironment(&deploy.CreateEnvironmentInput{
+					Project:                  "phonetool",
+					Name:                     "test",
+					Prod:                     false,
+					ToolsAccountPrincipalARN: "some arn",
+					ProjectDNSName:           "example.com",
+				}).Return(nil)
+			},
+			mockProgress: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtUpdateEnvStart, "test"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtUpdateEnvComplete, "test"))
+			},
+		},
+		"updates the environment with its manifest": {
+			mockStoreReader: mockStore,
+			mockWorkspace: func(m *climocks.MockwsEnvManifestReader) {
+				m.EXPECT().ReadEnvironmentManifest("test").Return([]byte(testManifest), nil)
+			},
+			mockIdentity: func(m *climocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			},
+			mockDeployer: func(m *climocks.MockenvironmentDeployer) {
+				m.EXPECT().UpdateEnvironment(&deploy.CreateEnvironmentInput{
+					Project:                  "phonetool",
+					Name:                     "test",
+					PublicLoadBalancer:       true,
+					ToolsAccountPrincipalARN: "some arn",
+					ProjectDNSName:           "example.com",
+					AdjustVPC: &deploy.AdjustVPCConfig{
+						CIDR:               "172.16.0.0/16",
+						PublicSubnetCIDRs:  []string{"172.16.0.0/24", "172.16.1.0/24"},
+						PrivateSubnetCIDRs: []string{"172.16.2.0/24", "172.16.3.0/24"},
+					},
+				}).Return(nil)
+			},
+			mockProgress: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtUpdateEnvStart, "test"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtUpdateEnvComplete, "test"))
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStoreReader := climocks.NewMockstoreReader(ctrl)
+			mockWorkspace := climocks.NewMockwsEnvManifestReader(ctrl)
+			mockIdentity := climocks.NewMockidentityService(ctrl)
+			mockDeployer := climocks.NewMockenvironmentDeployer(ctrl)
+			mockProgress := climocks.NewMockprogress(ctrl)
+			tc.mockStoreReader(mockStoreReader)
+			tc.mockWorkspace(mockWorkspace)
+			tc.mockIdentity(mockIdentity)
+			tc.mockDeployer(mockDeployer)
+			tc.mockProgress(mockProgress)
+
+			opts := &deployEnvOpts{
+				deployEnvVars: deployEnvVars{
+					GlobalOpts: &GlobalOpts{projectName: "phonetool"},
+					EnvName:    "test",
+				},
+				storeSvc: mockStoreReader,
+				ws:       mockWorkspace,
+				identity: mockIdentity,
+				prog:     mockProgress,
+				initProfileClients: func(o *deployEnvOpts) error {
+					o.envDeployer = mockDeployer
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
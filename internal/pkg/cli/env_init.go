@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
@@ -14,10 +15,13 @@ import (
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/session"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
 	termprogress "github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/workspace"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/spf13/cobra"
 )
 
@@ -48,9 +52,10 @@ var (
 
 type initEnvVars struct {
 	*GlobalOpts
-	EnvName      string // Name of the environment.
-	EnvProfile   string // AWS profile used to create an environment.
-	IsProduction bool   // Marks the environment as "production" to create it with additional guardrails.
+	envCredentialVars        // AWS profile or role used to create an environment.
+	EnvName           string // Name of the environment.
+	IsProduction      bool   // Marks the environment as "production" to create it with additional guardrails.
+	ImportCertARN     string // ACM certificate to use for the HTTPS listener instead of the project's domain.
 }
 
 type initEnvOpts struct {
@@ -64,7 +69,11 @@ type initEnvOpts struct {
 	identity      identityService
 	envIdentity   identityService
 	profileConfig profileNames
+	ws            wsEnvManifestReadWriter
 	prog          progress
+
+	// initProfileClients is overriden in tests.
+	initProfileClients func(*initEnvOpts) error
 }
 
 func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
@@ -73,10 +82,6 @@ func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
 		return nil, err
 	}
 	sessProvider := session.NewProvider()
-	profileSess, err := sessProvider.FromProfile(vars.EnvProfile)
-	if err != nil {
-		return nil, err
-	}
 	defaultSession, err := sessProvider.Default()
 	if err != nil {
 		return nil, err
@@ -85,17 +90,29 @@ func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("read named profiles: %w", err)
 	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
 
 	return &initEnvOpts{
 		initEnvVars:   vars,
 		projectGetter: store,
 		envCreator:    store,
-		envDeployer:   cloudformation.New(profileSess),
 		projDeployer:  cloudformation.New(defaultSession),
 		identity:      identity.New(defaultSession),
-		envIdentity:   identity.New(profileSess),
 		profileConfig: cfg,
+		ws:            ws,
 		prog:          termprogress.NewSpinner(),
+		initProfileClients: func(o *initEnvOpts) error {
+			envSess, err := o.session(sessProvider)
+			if err != nil {
+				return err
+			}
+			o.envDeployer = cloudformation.New(envSess)
+			o.envIdentity = identity.New(envSess)
+			return nil
+		},
 	}, nil
 }
 
@@ -109,7 +126,10 @@ func (o *initEnvOpts) Validate() error {
 	if o.ProjectName() == "" {
 		return fmt.Errorf("no project found: run %s or %s into your workspace please", color.HighlightCode("project init"), color.HighlightCode("cd"))
 	}
-	return nil
+	if o.ImportCertARN != "" && !arn.IsARN(o.ImportCertARN) {
+		return fmt.Errorf("--%s %s is not a valid ARN", importCertARNFlag, o.ImportCertARN)
+	}
+	return o.envCredentialVars.validate()
 }
 
 // Ask asks for fields that are required but not passed in.
@@ -120,28 +140,30 @@ func (o *initEnvOpts) Ask() error {
 	return o.askEnvProfile()
 }
 
-// Execute deploys a new environment with CloudFormation and adds it to SSM.
+// Execute writes the environment's manifest to the workspace, deploys a new environment with CloudFormation and adds it to SSM.
+// If the workspace already has a manifest for the environment, the environment is created from the manifest and the flags must not conflict with it.
 func (o *initEnvOpts) Execute() error {
 	project, err := o.projectGetter.GetProject(o.ProjectName())
 	if err != nil {
 		// Ensure the project actually exists before we do a deployment.
 		return err
 	}
+	if err := o.initProfileClients(o); err != nil {
+		return err
+	}
 	caller, err := o.identity.Get()
 	if err != nil {
 		return fmt.Errorf("get identity: %w", err)
 	}
 
-	// 1. Start creating the CloudFormation stack for the environment.
-	deployEnvInput := &deploy.CreateEnvironmentInput{
-		Name:                     o.EnvName,
-		Project:                  o.ProjectName(),
-		Prod:                     o.IsProduction,
-		PublicLoadBalancer:       true, // TODO: configure this based on user input or application Type needs?
-		ToolsAccountPrincipalARN: caller.RootUserARN,
-		ProjectDNSName:           project.Domain,
+	mft, err := o.envManifest()
+	if err != nil {
+		return err
 	}
 
+	// 1. Start creating the CloudFormation stack for the environment.
+	deployEnvInput := envDeployInput(project, caller.RootUserARN, mft)
+
 	if project.RequiresDNSDelegation() {
 		if err := o.delegateDNSFromProject(project); err != nil {
 			return fmt.Errorf("granting DNS permissions: %w", err)
@@ -193,6 +215,46 @@ func (o *initEnvOpts) Execute() error {
 	return nil
 }
 
+// envManifest returns the environment's manifest from the workspace if it exists, and errors if the flags conflict with it.
+// Otherwise, creates a manifest from the flags and writes it to the workspace.
+func (o *initEnvOpts) envManifest() (*manifest.EnvironmentManifest, error) {
+	data, err := o.ws.ReadEnvironmentManifest(o.EnvName)
+	if err == nil {
+		mft, err := manifest.UnmarshalEnvironment(data)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal manifest for environment %s: %w", o.EnvName, err)
+		}
+		if o.IsProduction && !mft.Prod {
+			return nil, fmt.Errorf("--%s conflicts with the manifest of environment %s, set the prod field in the manifest or remove the flag", prodEnvFlag, o.EnvName)
+		}
+		if o.ImportCertARN != "" && o.ImportCertARN != mft.CertificateARN {
+			return nil, fmt.Errorf("--%s conflicts with the manifest of environment %s, set the certificateARN field in the manifest or remove the flag", importCertARNFlag, o.EnvName)
+		}
+		log.Infof("Found a manifest for environment %s in the workspace, using it.\n", color.HighlightUserInput(o.EnvName))
+		return mft, nil
+	}
+
+	mft := manifest.NewEnvironmentManifest(&manifest.EnvironmentManifestProps{
+		Name:           o.EnvName,
+		Prod:           o.IsProduction,
+		CertificateARN: o.ImportCertARN,
+	})
+	var errNoWorkspace *workspace.ErrWorkspaceNotFound
+	if errors.As(err, &errNoWorkspace) {
+		// Environments can be created outside of a workspace with the --project flag, there is nowhere to write the manifest.
+		return mft, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("read manifest for environment %s: %w", o.EnvName, err)
+	}
+	path, err := o.ws.WriteEnvironmentManifest(mft, o.EnvName)
+	if err != nil {
+		return nil, fmt.Errorf("write manifest for environment %s: %w", o.EnvName, err)
+	}
+	log.Successf("Wrote the manifest for environment %s at '%s'\n", color.HighlightUserInput(o.EnvName), color.HighlightResource(relPath(path)))
+	return mft, nil
+}
+
 func (o *initEnvOpts) delegateDNSFromProject(project *archer.Project) error {
 	envAccount, err := o.envIdentity.Get()
 	if err != nil {
@@ -227,7 +289,7 @@ func (o *initEnvOpts) askEnvName() error {
 }
 
 func (o *initEnvOpts) askEnvProfile() error {
-	if o.EnvProfile != "" {
+	if o.EnvProfile != "" || o.RoleARN != "" {
 		return nil
 	}
 
@@ -306,7 +368,10 @@ func BuildEnvInitCmd() *cobra.Command {
   /code $ ecs-preview env init --name test --profile default
 
   Creates a prod-iad environment using your "prod-admin" AWS profile.
-  /code $ ecs-preview env init --name prod-iad --profile prod-admin --prod`,
+  /code $ ecs-preview env init --name prod-iad --profile prod-admin --prod
+
+  Creates a prod-iad environment by assuming a role in the production account.
+  /code $ ecs-preview env init --name prod-iad --role-arn arn:aws:iam::123456789012:role/admin --external-id my-id --prod`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitEnvOpts(vars)
 			if err != nil {
@@ -323,6 +388,9 @@ func BuildEnvInitCmd() *cobra.Command {
 	}
 	cmd.Flags().StringVarP(&vars.EnvName, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.EnvProfile, profileFlag, "", profileFlagDescription)
+	cmd.Flags().StringVar(&vars.RoleARN, roleARNFlag, "", roleARNFlagDescription)
+	cmd.Flags().StringVar(&vars.ExternalID, externalIDFlag, "", externalIDFlagDescription)
 	cmd.Flags().BoolVar(&vars.IsProduction, prodEnvFlag, false, prodEnvFlagDescription)
+	cmd.Flags().StringVar(&vars.ImportCertARN, importCertARNFlag, "", importCertARNFlagDescription)
 	return cmd
 }
@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
 	termprogress "github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/workspace"
 	"github.com/aws/amazon-ecs-cli-v2/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -25,6 +27,10 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		inEnvName     string
 		inProjectName string
+		inProfile     string
+		inRoleARN     string
+		inExternalID  string
+		inCertARN     string
 
 		wantedErr string
 	}{
@@ -44,6 +50,41 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 
 			wantedErr: "no project found: run `project init` or `cd` into your workspace please",
 		},
+		"valid role ARN with external ID": {
+			inEnvName:     "test-pdx",
+			inProjectName: "phonetool",
+			inRoleARN:     "arn:aws:iam::123456789012:role/admin",
+			inExternalID:  "my-id",
+		},
+		"both profile and role ARN": {
+			inEnvName:     "test-pdx",
+			inProjectName: "phonetool",
+			inProfile:     "default",
+			inRoleARN:     "arn:aws:iam::123456789012:role/admin",
+
+			wantedErr: "only one of --profile or --role-arn may be used",
+		},
+		"external ID without role ARN": {
+			inEnvName:     "test-pdx",
+			inProjectName: "phonetool",
+			inExternalID:  "my-id",
+
+			wantedErr: "--external-id requires --role-arn",
+		},
+		"invalid role ARN": {
+			inEnvName:     "test-pdx",
+			inProjectName: "phonetool",
+			inRoleARN:     "admin",
+
+			wantedErr: "--role-arn admin is not a valid ARN",
+		},
+		"invalid certificate ARN": {
+			inEnvName:     "test-pdx",
+			inProjectName: "phonetool",
+			inCertARN:     "mycert",
+
+			wantedErr: "--import-cert-arn mycert is not a valid ARN",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -51,8 +92,14 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 			// GIVEN
 			opts := &initEnvOpts{
 				initEnvVars: initEnvVars{
-					EnvName:    tc.inEnvName,
-					GlobalOpts: &GlobalOpts{projectName: tc.inProjectName},
+					EnvName:       tc.inEnvName,
+					GlobalOpts:    &GlobalOpts{projectName: tc.inProjectName},
+					ImportCertARN: tc.inCertARN,
+					envCredentialVars: envCredentialVars{
+						EnvProfile: tc.inProfile,
+						RoleARN:    tc.inRoleARN,
+						ExternalID: tc.inExternalID,
+					},
 				},
 			}
 
@@ -77,6 +124,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 	testCases := map[string]struct {
 		inputEnv     string
 		inputProfile string
+		inputRoleARN string
 		inputProject string
 
 		setupMocks func(*climocks.Mockprompter, *climocks.MockprofileNames)
@@ -112,6 +160,17 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 			},
 			wantedError: errNamedProfilesNotFound,
 		},
+		"skips the profile prompt with a role ARN": {
+			inputRoleARN: "arn:aws:iam::123456789012:role/admin",
+			setupMocks: func(mockPrompter *climocks.Mockprompter, mockCfg *climocks.MockprofileNames) {
+				mockPrompter.EXPECT().
+					Get(
+						gomock.Eq(envInitNamePrompt),
+						gomock.Eq(envInitNameHelpPrompt),
+						gomock.Any()).
+					Return(mockEnv, nil)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -124,8 +183,11 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 			// GIVEN
 			addEnv := &initEnvOpts{
 				initEnvVars: initEnvVars{
-					EnvName:    tc.inputEnv,
-					EnvProfile: tc.inputProfile,
+					EnvName: tc.inputEnv,
+					envCredentialVars: envCredentialVars{
+						EnvProfile: tc.inputProfile,
+						RoleARN:    tc.inputRoleARN,
+					},
 					GlobalOpts: &GlobalOpts{
 						prompt:      mockPrompter,
 						projectName: tc.inputProject,
@@ -153,12 +215,15 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 	testCases := map[string]struct {
 		inProjectName string
 		inEnvName     string
+		inProd        bool
+		inCertARN     string
 
 		expectProjectGetter func(m *mocks.MockProjectGetter)
 		expectEnvCreator    func(m *mocks.MockEnvironmentCreator)
 		expectDeployer      func(m *climocks.Mockdeployer)
 		expectIdentity      func(m *climocks.MockidentityService)
 		expectProgress      func(m *climocks.Mockprogress)
+		expectWorkspace     func(m *climocks.MockwsEnvManifestReadWriter)
 
 		wantedErrorS string
 	}{
@@ -204,9 +269,143 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 					Project:                  "phonetool",
 					PublicLoadBalancer:       true,
 					ToolsAccountPrincipalARN: "some arn",
+					FargateSpot:              true,
+					AdjustVPC: &deploy.AdjustVPCConfig{
+						CIDR:               "10.0.0.0/16",
+						PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+						PrivateSubnetCIDRs: []string{"10.0.2.0/24", "10.0.3.0/24"},
+					},
 				}).Return(&cloudformation.ErrStackAlreadyExists{})
 			},
 		},
+		"deploys the environment from its existing manifest": {
+			inProjectName: "phonetool",
+			inEnvName:     "test",
+
+			expectProjectGetter: func(m *mocks.MockProjectGetter) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool"}, nil)
+			},
+			expectIdentity: func(m *climocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			},
+			expectWorkspace: func(m *climocks.MockwsEnvManifestReadWriter) {
+				m.EXPECT().ReadEnvironmentManifest("test").Return([]byte(`
+name: test
+prod: true
+publicLoadBalancer: false
+network:
+  importVPC:
+    id: vpc-1234
+    privateSubnetIDs: [subnet-1, subnet-2]
+`), nil)
+				m.EXPECT().WriteEnvironmentManifest(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectProgress: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtDeployEnvStart, "test"))
+				m.EXPECT().Stop("")
+			},
+			expectDeployer: func(m *climocks.Mockdeployer) {
+				m.EXPECT().DeployEnvironment(&deploy.CreateEnvironmentInput{
+					Name:                     "test",
+					Project:                  "phonetool",
+					Prod:                     true,
+					ToolsAccountPrincipalARN: "some arn",
+					ImportVPC: &deploy.ImportVPCConfig{
+						ID:               "vpc-1234",
+						PrivateSubnetIDs: []string{"subnet-1", "subnet-2"},
+					},
+				}).Return(&cloudformation.ErrStackAlreadyExists{})
+			},
+		},
+		"returns error if the --prod flag conflicts with the existing manifest": {
+			inProjectName: "phonetool",
+			inEnvName:     "test",
+			inProd:        true,
+
+			expectProjectGetter: func(m *mocks.MockProjectGetter) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool"}, nil)
+			},
+			expectIdentity: func(m *climocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			},
+			expectWorkspace: func(m *climocks.MockwsEnvManifestReadWriter) {
+				m.EXPECT().ReadEnvironmentManifest("test").Return([]byte(`name: test`), nil)
+			},
+			wantedErrorS: "--prod conflicts with the manifest of environment test, set the prod field in the manifest or remove the flag",
+		},
+		"returns error if the --import-cert-arn flag conflicts with the existing manifest": {
+			inProjectName: "phonetool",
+			inEnvName:     "test",
+			inCertARN:     "arn:aws:acm:us-west-2:123456789012:certificate/new",
+
+			expectProjectGetter: func(m *mocks.MockProjectGetter) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool"}, nil)
+			},
+			expectIdentity: func(m *climocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			},
+			expectWorkspace: func(m *climocks.MockwsEnvManifestReadWriter) {
+				m.EXPECT().ReadEnvironmentManifest("test").Return([]byte(`
+name: test
+publicLoadBalancer: true
+certificateARN: arn:aws:acm:us-west-2:123456789012:certificate/old
+`), nil)
+			},
+			wantedErrorS: "--import-cert-arn conflicts with the manifest of environment test, set the certificateARN field in the manifest or remove the flag",
+		},
+		"returns error if the existing manifest is invalid": {
+			inProjectName: "phonetool",
+			inEnvName:     "test",
+
+			expectProjectGetter: func(m *mocks.MockProjectGetter) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool"}, nil)
+			},
+			expectIdentity: func(m *climocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			},
+			expectWorkspace: func(m *climocks.MockwsEnvManifestReadWriter) {
+				m.EXPECT().ReadEnvironmentManifest("test").Return([]byte(`prod: true`), nil)
+			},
+			wantedErrorS: `unmarshal manifest for environment test: environment manifest is missing the "name" field`,
+		},
+		"returns error if fail to write the manifest": {
+			inProjectName: "phonetool",
+			inEnvName:     "test",
+
+			expectProjectGetter: func(m *mocks.MockProjectGetter) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool"}, nil)
+			},
+			expectIdentity: func(m *climocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			},
+			expectWorkspace: func(m *climocks.MockwsEnvManifestReadWriter) {
+				m.EXPECT().ReadEnvironmentManifest("test").Return(nil, &os.PathError{Op: "open", Path: "env.yml", Err: os.ErrNotExist})
+				m.EXPECT().WriteEnvironmentManifest(gomock.Any(), "test").Return("", errors.New("some error"))
+			},
+			wantedErrorS: "write manifest for environment test: some error",
+		},
+		"skips writing the manifest outside of a workspace": {
+			inProjectName: "phonetool",
+			inEnvName:     "test",
+
+			expectProjectGetter: func(m *mocks.MockProjectGetter) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool"}, nil)
+			},
+			expectIdentity: func(m *climocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			},
+			expectWorkspace: func(m *climocks.MockwsEnvManifestReadWriter) {
+				m.EXPECT().ReadEnvironmentManifest("test").Return(nil, &workspace.ErrWorkspaceNotFound{})
+				m.EXPECT().WriteEnvironmentManifest(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectProgress: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtDeployEnvStart, "test"))
+				m.EXPECT().Stop("")
+			},
+			expectDeployer: func(m *climocks.Mockdeployer) {
+				m.EXPECT().DeployEnvironment(gomock.Any()).Return(&cloudformation.ErrStackAlreadyExists{})
+			},
+		},
 		"errors if environment change set cannot be accepted": {
 			inProjectName: "phonetool",
 			inEnvName:     "test",
@@ -478,11 +677,20 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			if tc.expectProgress != nil {
 				tc.expectProgress(mockProgress)
 			}
+			mockWorkspace := climocks.NewMockwsEnvManifestReadWriter(ctrl)
+			if tc.expectWorkspace != nil {
+				tc.expectWorkspace(mockWorkspace)
+			} else {
+				mockWorkspace.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return(nil, &os.PathError{Op: "open", Path: "env.yml", Err: os.ErrNotExist}).AnyTimes()
+				mockWorkspace.EXPECT().WriteEnvironmentManifest(gomock.Any(), gomock.Any()).Return("/ecs-project/environments/test/env.yml", nil).AnyTimes()
+			}
 
 			opts := &initEnvOpts{
 				initEnvVars: initEnvVars{
-					EnvName:    tc.inEnvName,
-					GlobalOpts: &GlobalOpts{projectName: tc.inProjectName},
+					EnvName:       tc.inEnvName,
+					GlobalOpts:    &GlobalOpts{projectName: tc.inProjectName},
+					IsProduction:  tc.inProd,
+					ImportCertARN: tc.inCertARN,
 				},
 				projectGetter: mockProjectGetter,
 				envCreator:    mockEnvCreator,
@@ -490,7 +698,11 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				projDeployer:  mockDeployer,
 				identity:      mockIdentity,
 				envIdentity:   mockIdentity,
+				ws:            mockWorkspace,
 				prog:          mockProgress,
+				initProfileClients: func(o *initEnvOpts) error {
+					return nil
+				},
 			}
 
 			// WHEN
@@ -0,0 +1,159 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envShowNamePrompt     = "Which environment would you like to show?"
+	envShowNameHelpPrompt = "The detail of an environment will be shown (e.g., region, account ID, applications)."
+)
+
+type showEnvVars struct {
+	*GlobalOpts
+	shouldOutputJSON bool
+	envName          string
+}
+
+type showEnvOpts struct {
+	showEnvVars
+
+	w             io.Writer
+	storeSvc      storeReader
+	describer     envDescriber
+	initDescriber func(*showEnvOpts) error // Overriden in tests.
+}
+
+func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
+	ssmStore, err := store.New()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+
+	return &showEnvOpts{
+		showEnvVars: vars,
+		storeSvc:    ssmStore,
+		w:           log.OutputWriter,
+		initDescriber: func(o *showEnvOpts) error {
+			d, err := describe.NewEnvDescriber(o.ProjectName(), o.envName)
+			if err != nil {
+				return fmt.Errorf("creating describer for environment %s in project %s: %w", o.envName, o.ProjectName(), err)
+			}
+			o.describer = d
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *showEnvOpts) Validate() error {
+	if o.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if _, err := o.storeSvc.GetProject(o.ProjectName()); err != nil {
+		return err
+	}
+	if o.envName != "" {
+		if _, err := o.storeSvc.GetEnvironment(o.ProjectName(), o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *showEnvOpts) Ask() error {
+	return o.askEnvName()
+}
+
+// Execute shows the environment's configuration, resources and deployed applications.
+func (o *showEnvOpts) Execute() error {
+	if err := o.initDescriber(o); err != nil {
+		return err
+	}
+	env, err := o.describer.Describe()
+	if err != nil {
+		return fmt.Errorf("describe environment %s: %w", o.envName, err)
+	}
+
+	if o.shouldOutputJSON {
+		data, err := env.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(o.w, data)
+	} else {
+		fmt.Fprintf(o.w, env.HumanString())
+	}
+	return nil
+}
+
+func (o *showEnvOpts) askEnvName() error {
+	if o.envName != "" {
+		return nil
+	}
+
+	envs, err := o.storeSvc.ListEnvironments(o.ProjectName())
+	if err != nil {
+		return fmt.Errorf("list environments for project %s: %w", o.ProjectName(), err)
+	}
+	if len(envs) == 0 {
+		return fmt.Errorf("couldn't find any environment in the project %s, run %s first please", o.ProjectName(), color.HighlightCode("env init"))
+	}
+	if len(envs) == 1 {
+		o.envName = envs[0].Name
+		return nil
+	}
+	var names []string
+	for _, env := range envs {
+		names = append(names, env.Name)
+	}
+	name, err := o.prompt.SelectOne(envShowNamePrompt, envShowNameHelpPrompt, names)
+	if err != nil {
+		return fmt.Errorf("select environment for project %s: %w", o.ProjectName(), err)
+	}
+	o.envName = name
+	return nil
+}
+
+// BuildEnvShowCmd builds the command for showing details of an environment.
+func BuildEnvShowCmd() *cobra.Command {
+	vars := showEnvVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Shows info about a deployed environment.",
+		Long:  "Shows info about a deployed environment, including its resources and the applications deployed in it.",
+
+		Example: `
+  Shows info about the environment "test"
+  /code $ ecs-preview env show -n test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newShowEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.envName, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
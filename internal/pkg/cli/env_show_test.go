@@ -0,0 +1,293 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvShow_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputProject    string
+		inputEnv        string
+		mockStoreReader func(m *climocks.MockstoreReader)
+
+		wantedError error
+	}{
+		"no project in workspace": {
+			mockStoreReader: func(m *climocks.MockstoreReader) {},
+
+			wantedError: errNoProjectInWorkspace,
+		},
+		"invalid project name": {
+			inputProject: "my-project",
+
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().GetProject("my-project").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"invalid environment name": {
+			inputProject: "my-project",
+			inputEnv:     "test",
+
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().GetProject("my-project").Return(&archer.Project{Name: "my-project"}, nil)
+				m.EXPECT().GetEnvironment("my-project", "test").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"valid project and environment names": {
+			inputProject: "my-project",
+			inputEnv:     "test",
+
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().GetProject("my-project").Return(&archer.Project{Name: "my-project"}, nil)
+				m.EXPECT().GetEnvironment("my-project", "test").Return(&archer.Environment{Name: "test"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStoreReader := climocks.NewMockstoreReader(ctrl)
+			tc.mockStoreReader(mockStoreReader)
+
+			opts := &showEnvOpts{
+				showEnvVars: showEnvVars{
+					GlobalOpts: &GlobalOpts{
+						projectName: tc.inputProject,
+					},
+					envName: tc.inputEnv,
+				},
+				storeSvc: mockStoreReader,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEnvShow_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputEnv        string
+		mockStoreReader func(m *climocks.MockstoreReader)
+		mockPrompt      func(m *climocks.Mockprompter)
+
+		wantedEnv   string
+		wantedError error
+	}{
+		"skips prompting if the environment is provided": {
+			inputEnv: "test",
+
+			mockStoreReader: func(m *climocks.MockstoreReader) {},
+			mockPrompt:      func(m *climocks.Mockprompter) {},
+
+			wantedEnv: "test",
+		},
+		"selects the only environment without prompting": {
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().ListEnvironments("my-project").Return([]*archer.Environment{
+					{Name: "test"},
+				}, nil)
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {},
+
+			wantedEnv: "test",
+		},
+		"prompts for the environment": {
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().ListEnvironments("my-project").Return([]*archer.Environment{
+					{Name: "test"},
+					{Name: "prod"},
+				}, nil)
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().SelectOne(envShowNamePrompt, envShowNameHelpPrompt, []string{"test", "prod"}).Return("prod", nil)
+			},
+
+			wantedEnv: "prod",
+		},
+		"returns error if there are no environments": {
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().ListEnvironments("my-project").Return(nil, nil)
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {},
+
+			wantedError: fmt.Errorf("couldn't find any environment in the project my-project, run %s first please", "`env init`"),
+		},
+		"returns error if fail to list environments": {
+			mockStoreReader: func(m *climocks.MockstoreReader) {
+				m.EXPECT().ListEnvironments("my-project").Return(nil, errors.New("some error"))
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {},
+
+			wantedError: errors.New("list environments for project my-project: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStoreReader := climocks.NewMockstoreReader(ctrl)
+			mockPrompt := climocks.NewMockprompter(ctrl)
+			tc.mockStoreReader(mockStoreReader)
+			tc.mockPrompt(mockPrompt)
+
+			opts := &showEnvOpts{
+				showEnvVars: showEnvVars{
+					GlobalOpts: &GlobalOpts{
+						projectName: "my-project",
+						prompt:      mockPrompt,
+					},
+					envName: tc.inputEnv,
+				},
+				storeSvc: mockStoreReader,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedEnv, opts.envName)
+			}
+		})
+	}
+}
+
+func TestEnvShow_Execute(t *testing.T) {
+	testEnv := &describe.Env{
+		Name:      "test",
+		Project:   "my-project",
+		Region:    "us-west-2",
+		AccountID: "123456789",
+		Resources: &describe.EnvResources{
+			VPCID:          "vpc-1234",
+			PublicSubnets:  []string{"subnet-1", "subnet-2"},
+			PrivateSubnets: []string{"subnet-3", "subnet-4"},
+			ClusterID:      "my-project-test-Cluster",
+		},
+		Apps: []*describe.EnvApp{
+			{
+				Name: "my-app",
+				URL:  "abc.us-west-2.elb.amazonaws.com",
+				Path: "*",
+			},
+		},
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		mockDescriber    func(m *climocks.MockenvDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"json output": {
+			shouldOutputJSON: true,
+			mockDescriber: func(m *climocks.MockenvDescriber) {
+				m.EXPECT().Describe().Return(testEnv, nil)
+			},
+
+			wantedContent: "{\"name\":\"test\",\"project\":\"my-project\",\"region\":\"us-west-2\",\"accountID\":\"123456789\",\"prod\":false,\"resources\":{\"vpcID\":\"vpc-1234\",\"publicSubnets\":[\"subnet-1\",\"subnet-2\"],\"privateSubnets\":[\"subnet-3\",\"subnet-4\"],\"clusterID\":\"my-project-test-Cluster\"},\"applications\":[{\"name\":\"my-app\",\"url\":\"abc.us-west-2.elb.amazonaws.com\",\"path\":\"*\"}]}\n",
+		},
+		"human output": {
+			mockDescriber: func(m *climocks.MockenvDescriber) {
+				m.EXPECT().Describe().Return(testEnv, nil)
+			},
+
+			wantedContent: `About
+
+  Name              test
+  Project           my-project
+  Region            us-west-2
+  Account ID        123456789
+  Production        false
+
+Resources
+
+  VPC               vpc-1234
+  Public Subnets    subnet-1, subnet-2
+  Private Subnets   subnet-3, subnet-4
+  Cluster           my-project-test-Cluster
+
+Applications
+
+  Name              URL                              Path
+  my-app            abc.us-west-2.elb.amazonaws.com  *
+`,
+		},
+		"returns error if fail to describe the environment": {
+			mockDescriber: func(m *climocks.MockenvDescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe environment test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockDescriber := climocks.NewMockenvDescriber(ctrl)
+			tc.mockDescriber(mockDescriber)
+
+			opts := &showEnvOpts{
+				showEnvVars: showEnvVars{
+					GlobalOpts: &GlobalOpts{
+						projectName: "my-project",
+					},
+					envName:          "test",
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				initDescriber: func(o *showEnvOpts) error {
+					o.describer = mockDescriber
+					return nil
+				},
+				w: b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String(), "expected output content match")
+			}
+		})
+	}
+}
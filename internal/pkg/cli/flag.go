@@ -29,11 +29,30 @@ const (
 	deployFlag            = "deploy"
 	resourcesFlag         = "resources"
 	githubURLFlag         = "github-url"
+	repoURLFlag           = "url"
 	githubAccessTokenFlag = "github-access-token"
 	gitBranchFlag         = "git-branch"
 	envsFlag              = "environments"
 	domainNameFlag        = "domain"
 	localAppFlag          = "local"
+	roleARNFlag           = "role-arn"
+	externalIDFlag        = "external-id"
+	importCertARNFlag     = "import-cert-arn"
+	removeDomainFlag      = "remove-domain"
+	connectionARNFlag     = "connection-arn"
+	connectionTypeFlag    = "connection-type"
+	deleteSecretFlag      = "delete-secret"
+	pipelineFlag          = "pipeline"
+	appsFlag              = "apps"
+	filterFlag            = "filter"
+	taskIDFlag            = "task-id"
+	logStreamPrefixFlag   = "log-stream-prefix"
+	queryFlag             = "query"
+	outputFlag            = "output"
+	allFlag               = "all"
+	fieldsFlag            = "fields"
+	rawFlag               = "raw"
+	mergeFlag             = "merge"
 )
 
 // Short flag names.
@@ -46,10 +65,11 @@ const (
 	appTypeFlagShort = "t"
 
 	dockerFileFlagShort        = "d"
-	githubURLFlagShort         = "u"
+	repoURLFlagShort           = "u"
 	githubAccessTokenFlagShort = "t"
 	gitBranchFlagShort         = "b"
 	envsFlagShort              = "e"
+	queryFlagShort             = "q"
 )
 
 // Descriptions for flags.
@@ -67,15 +87,42 @@ const (
 	stackOutputDirFlagDescription = "Optional. Writes the stack template and template configuration to a directory."
 	prodEnvFlagDescription        = "If the environment contains production services."
 	limitFlagDescription          = "Optional. The maximum number of log events returned."
-	followFlagDescription         = "Optional. Specifies if the logs should be streamed."
-	sinceFlagDescription          = `Optional. Only return logs newer than a relative duration like 5s, 2m, or 3h.
+	followFlagDescription         = `Optional. Specifies if the logs of the running tasks should be streamed.
+Without a start time, starts from the logs of the last minute.`
+	sinceFlagDescription = `Optional. Only return logs newer than a relative duration like 5s, 2m, or 3h.
 Defaults to all logs. Only one of start-time / since may be used.`
 	startTimeFlagDescription = `Optional. Only return logs after a specific date (RFC3339).
 Defaults to all logs. Only one of start-time / since may be used.`
 	endTimeFlagDescription = `Optional. Only return logs before a specific date (RFC3339).
 Defaults to all logs. Only one of end-time / follow may be used.`
+	filterFlagDescription = `Optional. Only return logs matching a CloudWatch Logs filter pattern like "ERROR" or "{ $.status = 500 }".
+Without a start time, only the logs of the last 30 days are searched.`
+	taskIDFlagDescription          = "Optional. Only return logs of the tasks with these IDs."
+	logStreamPrefixFlagDescription = `Optional. Only return logs of the log streams starting with a prefix like "ecs/my-app".
+Only one of task-id / log-stream-prefix may be used.`
+	appLogsNamesFlagDescription = `Optional. Names of the applications, separated by commas.
+The logs of several applications are interleaved in timestamp order.`
+	appLogsEnvsFlagDescription = `Optional. Names of the environments, separated by commas.
+The logs of several environments are interleaved in timestamp order.`
+	appLogsAllFlagDescription = "Optional. Shows the logs of all the deployed applications matching --name in all the environments matching --env."
+	fieldsFlagDescription     = `Optional. Keys of JSON log messages to show after their time, level and message, separated by commas.
+Defaults to all the keys. Only one of fields / raw may be used.`
+	rawFlagDescription             = "Optional. Shows the log messages as they were written, without rendering JSON messages."
+	exportOutputDirFlagDescription = `Optional. Exports all the logs of the time window to NDJSON files in a directory, one file per task.
+Running the same command again resumes an interrupted export.`
+	mergeFlagDescription          = "Optional. Exports the logs of all the tasks to a single file in timestamp order. Requires output-dir."
+	queryFlagDescription          = "CloudWatch Logs Insights query to run."
+	queryOutputFlagDescription    = "Optional. Output format of the query results: table, csv or json."
+	queryStartTimeFlagDescription = `Optional. Only query logs after a specific date (RFC3339).
+Defaults to one hour ago. Only one of start-time / since may be used.`
+	queryEndTimeFlagDescription = `Optional. Only query logs before a specific date (RFC3339).
+Defaults to now.`
+	querySinceFlagDescription = `Optional. Only query logs newer than a relative duration like 5s, 2m, or 3h.
+Defaults to 1h. Only one of start-time / since may be used.`
+	queryLimitFlagDescription        = "Optional. The maximum number of results returned if the query has no limit command."
 	deployTestFlagDescription        = `Deploy your application to a "test" environment.`
 	githubURLFlagDescription         = "GitHub repository URL for your application."
+	repoURLFlagDescription           = "GitHub, Bitbucket, GitHub Enterprise Server or CodeCommit repository URL for your application."
 	githubAccessTokenFlagDescription = "GitHub personal access token for your repository."
 	gitBranchFlagDescription         = "Branch used to trigger your pipeline."
 	pipelineEnvsFlagDescription      = "Environments to add to the pipeline."
@@ -83,4 +130,20 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	resourcesFlagDescription         = "Optional. Show the resources of your application."
 	localAppFlagDescription          = "Only show applications in the current directory."
 	envProfilesFlagDescription       = "Optional. Environments and the profile to use to delete the environment."
+	roleARNFlagDescription           = `Optional. ARN of the IAM role to assume in the environment's account.
+Only one of profile / role-arn may be used.`
+	externalIDFlagDescription    = "Optional. External ID to pass when assuming the role."
+	importCertARNFlagDescription = "Optional. ARN of an existing ACM certificate to use for the HTTPS listener of the load balancer."
+	removeDomainFlagDescription  = "Optional. Removes the custom domain name from the project."
+	connectionARNFlagDescription = `Optional. ARN of an existing AWS CodeStar connection to your repository.
+Required for GitHub Enterprise Server repositories, no GitHub access token is needed when it is set.`
+	connectionTypeFlagDescription = `Optional. Type of the AWS CodeStar connection to your repository: Bitbucket, GitHub or GitHubEnterpriseServer.
+Use GitHub to access a GitHub repository through a connection created by the pipeline instead of an access token.
+GitHubEnterpriseServer is required for repositories on other hosts, along with --connection-arn.`
+	pipelineNameFlagDescription = `Optional. Name of the pipeline.
+Defaults to the pipeline in your workspace, required if your workspace has multiple pipelines.`
+	pipelineInitNameFlagDescription = "Optional. Name of the pipeline. Defaults to a name derived from the project and the repository."
+	pipelineAppsFlagDescription     = "Optional. Applications built and deployed by the pipeline. Defaults to all the applications in your workspace."
+	pipelineFollowFlagDescription   = "Optional. Refreshes the status until the pipeline execution finishes."
+	deleteSecretFlagDescription     = "Optional. Deletes the secret storing the GitHub access token of the pipeline."
 )
@@ -114,18 +114,26 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 	}
 	initEnv := &initEnvOpts{
 		initEnvVars: initEnvVars{
-			GlobalOpts:   NewGlobalOpts(),
+			GlobalOpts: NewGlobalOpts(),
+			envCredentialVars: envCredentialVars{
+				EnvProfile: "default",
+			},
 			EnvName:      defaultEnvironmentName,
-			EnvProfile:   "default",
 			IsProduction: false,
 		},
 		envCreator:    ssm,
 		projectGetter: ssm,
-		envDeployer:   deployer,
 		projDeployer:  deployer, // TODO #317
 		profileConfig: cfg,
+		ws:            ws,
 		prog:          spin,
 		identity:      id,
+		initProfileClients: func(o *initEnvOpts) error {
+			// The test environment is created with the default profile's clients.
+			o.envDeployer = deployer
+			o.envIdentity = id
+			return nil
+		},
 	}
 
 	appDeploy := &appDeployOpts{
@@ -43,11 +43,20 @@ type ecrService interface {
 
 type cwlogService interface {
 	TaskLogEvents(logGroupName string, stringTokens map[string]*string, opts ...cloudwatchlogs.GetLogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error)
+	FilterTaskLogEvents(logGroupName string, cursor *cloudwatchlogs.LogEventsCursor, opts ...cloudwatchlogs.FilterLogEventsOpts) (*cloudwatchlogs.FilteredLogEventsOutput, error)
+	FilterTaskLogEventsPage(logGroupName, token string, opts ...cloudwatchlogs.FilterLogEventsOpts) (*cloudwatchlogs.LogEventsPage, error)
 	LogGroupExists(logGroupName string) (bool, error)
+	StartQuery(logGroupName, query string, startTime, endTime int64, limit int) (string, error)
+	QueryResults(queryID string) (*cloudwatchlogs.QueryResults, error)
+}
+
+type runningTasksDescriber interface {
+	RunningTaskIDs() ([]string, error)
 }
 
 type dockerService interface {
 	Build(uri, tag, path string) error
+	Tag(uri, tag, targetURI string) error
 	Login(uri, username, password string) error
 	Push(uri, tag string) error
 }
@@ -85,6 +94,26 @@ type webAppDescriber interface {
 	StackResources(envName string) ([]*describe.CfnResource, error)
 }
 
+type envDescriber interface {
+	Describe() (*describe.Env, error)
+}
+
+type envHTTPSDescriber interface {
+	HasHTTPSListener() (bool, error)
+}
+
+type pipelineDescriber interface {
+	Describe() (*describe.Pipeline, error)
+}
+
+type pipelineStatusDescriber interface {
+	Describe() (*describe.PipelineStatus, error)
+}
+
+type appStatusDescriber interface {
+	Describe() (*describe.AppStatus, error)
+}
+
 type storeReader interface {
 	archer.ProjectLister
 	archer.ProjectGetter
@@ -94,6 +123,13 @@ type storeReader interface {
 	archer.ApplicationGetter
 }
 
+type projectDomainStore interface {
+	archer.ProjectGetter
+	archer.ProjectUpdater
+	archer.EnvironmentLister
+	ValidateDomain(domain string) error
+}
+
 type wsAppManifestReader interface {
 	ReadAppManifest(appName string) ([]byte, error)
 }
@@ -102,13 +138,24 @@ type wsAppManifestWriter interface {
 	WriteAppManifest(marshaler encoding.BinaryMarshaler, appName string) (string, error)
 }
 
+type wsEnvManifestReader interface {
+	ReadEnvironmentManifest(envName string) ([]byte, error)
+}
+
+type wsEnvManifestReadWriter interface {
+	wsEnvManifestReader
+	WriteEnvironmentManifest(marshaler encoding.BinaryMarshaler, envName string) (string, error)
+}
+
 type wsPipelineManifestReader interface {
-	ReadPipelineManifest() ([]byte, error)
+	PipelineNames() ([]string, error)
+	ReadPipelineManifest(pipelineName string) ([]byte, error)
 }
 
 type wsPipelineWriter interface {
-	WritePipelineBuildspec(marshaler encoding.BinaryMarshaler) (string, error)
-	WritePipelineManifest(marshaler encoding.BinaryMarshaler) (string, error)
+	PipelineNames() ([]string, error)
+	WritePipelineBuildspec(marshaler encoding.BinaryMarshaler, pipelineName string) (string, error)
+	WritePipelineManifest(marshaler encoding.BinaryMarshaler, pipelineName string) (string, error)
 }
 
 type wsAppDeleter interface {
@@ -120,9 +167,15 @@ type wsAppReader interface {
 	wsAppManifestReader
 }
 
+type wsAppPipelineReader interface {
+	wsAppReader
+	wsPipelineManifestReader
+}
+
 type wsPipelineReader interface {
 	AppNames() ([]string, error)
 	wsPipelineManifestReader
+	PipelineBuildspecPath(pipelineName string) (string, error)
 }
 
 type wsProjectManager interface {
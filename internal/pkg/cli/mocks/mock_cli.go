@@ -163,6 +163,20 @@ func (mr *MockprojectServiceMockRecorder) CreateProject(project interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockprojectService)(nil).CreateProject), project)
 }
 
+// UpdateProject mocks base method
+func (m *MockprojectService) UpdateProject(project *archer.Project) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProject", project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProject indicates an expected call of UpdateProject
+func (mr *MockprojectServiceMockRecorder) UpdateProject(project interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockprojectService)(nil).UpdateProject), project)
+}
+
 // DeleteProject mocks base method
 func (m *MockprojectService) DeleteProject(name string) error {
 	m.ctrl.T.Helper()
@@ -389,6 +403,46 @@ func (mr *MockcwlogServiceMockRecorder) TaskLogEvents(logGroupName, stringTokens
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskLogEvents", reflect.TypeOf((*MockcwlogService)(nil).TaskLogEvents), varargs...)
 }
 
+// FilterTaskLogEvents mocks base method
+func (m *MockcwlogService) FilterTaskLogEvents(logGroupName string, cursor *cloudwatchlogs.LogEventsCursor, opts ...cloudwatchlogs.FilterLogEventsOpts) (*cloudwatchlogs.FilteredLogEventsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{logGroupName, cursor}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FilterTaskLogEvents", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.FilteredLogEventsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterTaskLogEvents indicates an expected call of FilterTaskLogEvents
+func (mr *MockcwlogServiceMockRecorder) FilterTaskLogEvents(logGroupName, cursor interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{logGroupName, cursor}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterTaskLogEvents", reflect.TypeOf((*MockcwlogService)(nil).FilterTaskLogEvents), varargs...)
+}
+
+// FilterTaskLogEventsPage mocks base method
+func (m *MockcwlogService) FilterTaskLogEventsPage(logGroupName, token string, opts ...cloudwatchlogs.FilterLogEventsOpts) (*cloudwatchlogs.LogEventsPage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{logGroupName, token}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FilterTaskLogEventsPage", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.LogEventsPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterTaskLogEventsPage indicates an expected call of FilterTaskLogEventsPage
+func (mr *MockcwlogServiceMockRecorder) FilterTaskLogEventsPage(logGroupName, token interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{logGroupName, token}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterTaskLogEventsPage", reflect.TypeOf((*MockcwlogService)(nil).FilterTaskLogEventsPage), varargs...)
+}
+
 // LogGroupExists mocks base method
 func (m *MockcwlogService) LogGroupExists(logGroupName string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -404,6 +458,74 @@ func (mr *MockcwlogServiceMockRecorder) LogGroupExists(logGroupName interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogGroupExists", reflect.TypeOf((*MockcwlogService)(nil).LogGroupExists), logGroupName)
 }
 
+// StartQuery mocks base method
+func (m *MockcwlogService) StartQuery(logGroupName, query string, startTime, endTime int64, limit int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartQuery", logGroupName, query, startTime, endTime, limit)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartQuery indicates an expected call of StartQuery
+func (mr *MockcwlogServiceMockRecorder) StartQuery(logGroupName, query, startTime, endTime, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartQuery", reflect.TypeOf((*MockcwlogService)(nil).StartQuery), logGroupName, query, startTime, endTime, limit)
+}
+
+// QueryResults mocks base method
+func (m *MockcwlogService) QueryResults(queryID string) (*cloudwatchlogs.QueryResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryResults", queryID)
+	ret0, _ := ret[0].(*cloudwatchlogs.QueryResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryResults indicates an expected call of QueryResults
+func (mr *MockcwlogServiceMockRecorder) QueryResults(queryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryResults", reflect.TypeOf((*MockcwlogService)(nil).QueryResults), queryID)
+}
+
+// MockrunningTasksDescriber is a mock of runningTasksDescriber interface
+type MockrunningTasksDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockrunningTasksDescriberMockRecorder
+}
+
+// MockrunningTasksDescriberMockRecorder is the mock recorder for MockrunningTasksDescriber
+type MockrunningTasksDescriberMockRecorder struct {
+	mock *MockrunningTasksDescriber
+}
+
+// NewMockrunningTasksDescriber creates a new mock instance
+func NewMockrunningTasksDescriber(ctrl *gomock.Controller) *MockrunningTasksDescriber {
+	mock := &MockrunningTasksDescriber{ctrl: ctrl}
+	mock.recorder = &MockrunningTasksDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockrunningTasksDescriber) EXPECT() *MockrunningTasksDescriberMockRecorder {
+	return m.recorder
+}
+
+// RunningTaskIDs mocks base method
+func (m *MockrunningTasksDescriber) RunningTaskIDs() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunningTaskIDs")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunningTaskIDs indicates an expected call of RunningTaskIDs
+func (mr *MockrunningTasksDescriberMockRecorder) RunningTaskIDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTaskIDs", reflect.TypeOf((*MockrunningTasksDescriber)(nil).RunningTaskIDs))
+}
+
 // MockdockerService is a mock of dockerService interface
 type MockdockerService struct {
 	ctrl     *gomock.Controller
@@ -441,6 +563,20 @@ func (mr *MockdockerServiceMockRecorder) Build(uri, tag, path interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockdockerService)(nil).Build), uri, tag, path)
 }
 
+// Tag mocks base method
+func (m *MockdockerService) Tag(uri, tag, targetURI string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tag", uri, tag, targetURI)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Tag indicates an expected call of Tag
+func (mr *MockdockerServiceMockRecorder) Tag(uri, tag, targetURI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tag", reflect.TypeOf((*MockdockerService)(nil).Tag), uri, tag, targetURI)
+}
+
 // Login mocks base method
 func (m *MockdockerService) Login(uri, username, password string) error {
 	m.ctrl.T.Helper()
@@ -813,6 +949,196 @@ func (mr *MockwebAppDescriberMockRecorder) StackResources(envName interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackResources", reflect.TypeOf((*MockwebAppDescriber)(nil).StackResources), envName)
 }
 
+// MockenvDescriber is a mock of envDescriber interface
+type MockenvDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvDescriberMockRecorder
+}
+
+// MockenvDescriberMockRecorder is the mock recorder for MockenvDescriber
+type MockenvDescriberMockRecorder struct {
+	mock *MockenvDescriber
+}
+
+// NewMockenvDescriber creates a new mock instance
+func NewMockenvDescriber(ctrl *gomock.Controller) *MockenvDescriber {
+	mock := &MockenvDescriber{ctrl: ctrl}
+	mock.recorder = &MockenvDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockenvDescriber) EXPECT() *MockenvDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method
+func (m *MockenvDescriber) Describe() (*describe.Env, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.Env)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe
+func (mr *MockenvDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockenvDescriber)(nil).Describe))
+}
+
+// MockenvHTTPSDescriber is a mock of envHTTPSDescriber interface
+type MockenvHTTPSDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvHTTPSDescriberMockRecorder
+}
+
+// MockenvHTTPSDescriberMockRecorder is the mock recorder for MockenvHTTPSDescriber
+type MockenvHTTPSDescriberMockRecorder struct {
+	mock *MockenvHTTPSDescriber
+}
+
+// NewMockenvHTTPSDescriber creates a new mock instance
+func NewMockenvHTTPSDescriber(ctrl *gomock.Controller) *MockenvHTTPSDescriber {
+	mock := &MockenvHTTPSDescriber{ctrl: ctrl}
+	mock.recorder = &MockenvHTTPSDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockenvHTTPSDescriber) EXPECT() *MockenvHTTPSDescriberMockRecorder {
+	return m.recorder
+}
+
+// HasHTTPSListener mocks base method
+func (m *MockenvHTTPSDescriber) HasHTTPSListener() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasHTTPSListener")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasHTTPSListener indicates an expected call of HasHTTPSListener
+func (mr *MockenvHTTPSDescriberMockRecorder) HasHTTPSListener() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasHTTPSListener", reflect.TypeOf((*MockenvHTTPSDescriber)(nil).HasHTTPSListener))
+}
+
+// MockpipelineDescriber is a mock of pipelineDescriber interface
+type MockpipelineDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineDescriberMockRecorder
+}
+
+// MockpipelineDescriberMockRecorder is the mock recorder for MockpipelineDescriber
+type MockpipelineDescriberMockRecorder struct {
+	mock *MockpipelineDescriber
+}
+
+// NewMockpipelineDescriber creates a new mock instance
+func NewMockpipelineDescriber(ctrl *gomock.Controller) *MockpipelineDescriber {
+	mock := &MockpipelineDescriber{ctrl: ctrl}
+	mock.recorder = &MockpipelineDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockpipelineDescriber) EXPECT() *MockpipelineDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method
+func (m *MockpipelineDescriber) Describe() (*describe.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe
+func (mr *MockpipelineDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockpipelineDescriber)(nil).Describe))
+}
+
+// MockpipelineStatusDescriber is a mock of pipelineStatusDescriber interface
+type MockpipelineStatusDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineStatusDescriberMockRecorder
+}
+
+// MockpipelineStatusDescriberMockRecorder is the mock recorder for MockpipelineStatusDescriber
+type MockpipelineStatusDescriberMockRecorder struct {
+	mock *MockpipelineStatusDescriber
+}
+
+// NewMockpipelineStatusDescriber creates a new mock instance
+func NewMockpipelineStatusDescriber(ctrl *gomock.Controller) *MockpipelineStatusDescriber {
+	mock := &MockpipelineStatusDescriber{ctrl: ctrl}
+	mock.recorder = &MockpipelineStatusDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockpipelineStatusDescriber) EXPECT() *MockpipelineStatusDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method
+func (m *MockpipelineStatusDescriber) Describe() (*describe.PipelineStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.PipelineStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe
+func (mr *MockpipelineStatusDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockpipelineStatusDescriber)(nil).Describe))
+}
+
+// MockappStatusDescriber is a mock of appStatusDescriber interface
+type MockappStatusDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockappStatusDescriberMockRecorder
+}
+
+// MockappStatusDescriberMockRecorder is the mock recorder for MockappStatusDescriber
+type MockappStatusDescriberMockRecorder struct {
+	mock *MockappStatusDescriber
+}
+
+// NewMockappStatusDescriber creates a new mock instance
+func NewMockappStatusDescriber(ctrl *gomock.Controller) *MockappStatusDescriber {
+	mock := &MockappStatusDescriber{ctrl: ctrl}
+	mock.recorder = &MockappStatusDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockappStatusDescriber) EXPECT() *MockappStatusDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method
+func (m *MockappStatusDescriber) Describe() (*describe.AppStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.AppStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe
+func (mr *MockappStatusDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockappStatusDescriber)(nil).Describe))
+}
+
 // MockstoreReader is a mock of storeReader interface
 type MockstoreReader struct {
 	ctrl     *gomock.Controller
@@ -926,6 +1252,87 @@ func (mr *MockstoreReaderMockRecorder) GetApplication(projectName, applicationNa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplication", reflect.TypeOf((*MockstoreReader)(nil).GetApplication), projectName, applicationName)
 }
 
+// MockprojectDomainStore is a mock of projectDomainStore interface
+type MockprojectDomainStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockprojectDomainStoreMockRecorder
+}
+
+// MockprojectDomainStoreMockRecorder is the mock recorder for MockprojectDomainStore
+type MockprojectDomainStoreMockRecorder struct {
+	mock *MockprojectDomainStore
+}
+
+// NewMockprojectDomainStore creates a new mock instance
+func NewMockprojectDomainStore(ctrl *gomock.Controller) *MockprojectDomainStore {
+	mock := &MockprojectDomainStore{ctrl: ctrl}
+	mock.recorder = &MockprojectDomainStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockprojectDomainStore) EXPECT() *MockprojectDomainStoreMockRecorder {
+	return m.recorder
+}
+
+// GetProject mocks base method
+func (m *MockprojectDomainStore) GetProject(projectName string) (*archer.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProject", projectName)
+	ret0, _ := ret[0].(*archer.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProject indicates an expected call of GetProject
+func (mr *MockprojectDomainStoreMockRecorder) GetProject(projectName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockprojectDomainStore)(nil).GetProject), projectName)
+}
+
+// UpdateProject mocks base method
+func (m *MockprojectDomainStore) UpdateProject(project *archer.Project) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProject", project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProject indicates an expected call of UpdateProject
+func (mr *MockprojectDomainStoreMockRecorder) UpdateProject(project interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockprojectDomainStore)(nil).UpdateProject), project)
+}
+
+// ListEnvironments mocks base method
+func (m *MockprojectDomainStore) ListEnvironments(projectName string) ([]*archer.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnvironments", projectName)
+	ret0, _ := ret[0].([]*archer.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnvironments indicates an expected call of ListEnvironments
+func (mr *MockprojectDomainStoreMockRecorder) ListEnvironments(projectName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockprojectDomainStore)(nil).ListEnvironments), projectName)
+}
+
+// ValidateDomain mocks base method
+func (m *MockprojectDomainStore) ValidateDomain(domain string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateDomain", domain)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateDomain indicates an expected call of ValidateDomain
+func (mr *MockprojectDomainStoreMockRecorder) ValidateDomain(domain interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateDomain", reflect.TypeOf((*MockprojectDomainStore)(nil).ValidateDomain), domain)
+}
+
 // MockwsAppManifestReader is a mock of wsAppManifestReader interface
 type MockwsAppManifestReader struct {
 	ctrl     *gomock.Controller
@@ -1002,6 +1409,97 @@ func (mr *MockwsAppManifestWriterMockRecorder) WriteAppManifest(marshaler, appNa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteAppManifest", reflect.TypeOf((*MockwsAppManifestWriter)(nil).WriteAppManifest), marshaler, appName)
 }
 
+// MockwsEnvManifestReader is a mock of wsEnvManifestReader interface
+type MockwsEnvManifestReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsEnvManifestReaderMockRecorder
+}
+
+// MockwsEnvManifestReaderMockRecorder is the mock recorder for MockwsEnvManifestReader
+type MockwsEnvManifestReaderMockRecorder struct {
+	mock *MockwsEnvManifestReader
+}
+
+// NewMockwsEnvManifestReader creates a new mock instance
+func NewMockwsEnvManifestReader(ctrl *gomock.Controller) *MockwsEnvManifestReader {
+	mock := &MockwsEnvManifestReader{ctrl: ctrl}
+	mock.recorder = &MockwsEnvManifestReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsEnvManifestReader) EXPECT() *MockwsEnvManifestReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadEnvironmentManifest mocks base method
+func (m *MockwsEnvManifestReader) ReadEnvironmentManifest(envName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEnvironmentManifest", envName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadEnvironmentManifest indicates an expected call of ReadEnvironmentManifest
+func (mr *MockwsEnvManifestReaderMockRecorder) ReadEnvironmentManifest(envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentManifest", reflect.TypeOf((*MockwsEnvManifestReader)(nil).ReadEnvironmentManifest), envName)
+}
+
+// MockwsEnvManifestReadWriter is a mock of wsEnvManifestReadWriter interface
+type MockwsEnvManifestReadWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsEnvManifestReadWriterMockRecorder
+}
+
+// MockwsEnvManifestReadWriterMockRecorder is the mock recorder for MockwsEnvManifestReadWriter
+type MockwsEnvManifestReadWriterMockRecorder struct {
+	mock *MockwsEnvManifestReadWriter
+}
+
+// NewMockwsEnvManifestReadWriter creates a new mock instance
+func NewMockwsEnvManifestReadWriter(ctrl *gomock.Controller) *MockwsEnvManifestReadWriter {
+	mock := &MockwsEnvManifestReadWriter{ctrl: ctrl}
+	mock.recorder = &MockwsEnvManifestReadWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsEnvManifestReadWriter) EXPECT() *MockwsEnvManifestReadWriterMockRecorder {
+	return m.recorder
+}
+
+// ReadEnvironmentManifest mocks base method
+func (m *MockwsEnvManifestReadWriter) ReadEnvironmentManifest(envName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEnvironmentManifest", envName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadEnvironmentManifest indicates an expected call of ReadEnvironmentManifest
+func (mr *MockwsEnvManifestReadWriterMockRecorder) ReadEnvironmentManifest(envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentManifest", reflect.TypeOf((*MockwsEnvManifestReadWriter)(nil).ReadEnvironmentManifest), envName)
+}
+
+// WriteEnvironmentManifest mocks base method
+func (m *MockwsEnvManifestReadWriter) WriteEnvironmentManifest(marshaler encoding.BinaryMarshaler, envName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteEnvironmentManifest", marshaler, envName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteEnvironmentManifest indicates an expected call of WriteEnvironmentManifest
+func (mr *MockwsEnvManifestReadWriterMockRecorder) WriteEnvironmentManifest(marshaler, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteEnvironmentManifest", reflect.TypeOf((*MockwsEnvManifestReadWriter)(nil).WriteEnvironmentManifest), marshaler, envName)
+}
+
 // MockwsPipelineManifestReader is a mock of wsPipelineManifestReader interface
 type MockwsPipelineManifestReader struct {
 	ctrl     *gomock.Controller
@@ -1025,19 +1523,34 @@ func (m *MockwsPipelineManifestReader) EXPECT() *MockwsPipelineManifestReaderMoc
 	return m.recorder
 }
 
+// PipelineNames mocks base method
+func (m *MockwsPipelineManifestReader) PipelineNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PipelineNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PipelineNames indicates an expected call of PipelineNames
+func (mr *MockwsPipelineManifestReaderMockRecorder) PipelineNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineNames", reflect.TypeOf((*MockwsPipelineManifestReader)(nil).PipelineNames))
+}
+
 // ReadPipelineManifest mocks base method
-func (m *MockwsPipelineManifestReader) ReadPipelineManifest() ([]byte, error) {
+func (m *MockwsPipelineManifestReader) ReadPipelineManifest(pipelineName string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadPipelineManifest")
+	ret := m.ctrl.Call(m, "ReadPipelineManifest", pipelineName)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReadPipelineManifest indicates an expected call of ReadPipelineManifest
-func (mr *MockwsPipelineManifestReaderMockRecorder) ReadPipelineManifest() *gomock.Call {
+func (mr *MockwsPipelineManifestReaderMockRecorder) ReadPipelineManifest(pipelineName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineManifestReader)(nil).ReadPipelineManifest))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineManifestReader)(nil).ReadPipelineManifest), pipelineName)
 }
 
 // MockwsPipelineWriter is a mock of wsPipelineWriter interface
@@ -1063,34 +1576,49 @@ func (m *MockwsPipelineWriter) EXPECT() *MockwsPipelineWriterMockRecorder {
 	return m.recorder
 }
 
+// PipelineNames mocks base method
+func (m *MockwsPipelineWriter) PipelineNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PipelineNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PipelineNames indicates an expected call of PipelineNames
+func (mr *MockwsPipelineWriterMockRecorder) PipelineNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineNames", reflect.TypeOf((*MockwsPipelineWriter)(nil).PipelineNames))
+}
+
 // WritePipelineBuildspec mocks base method
-func (m *MockwsPipelineWriter) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler) (string, error) {
+func (m *MockwsPipelineWriter) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler, pipelineName string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WritePipelineBuildspec", marshaler)
+	ret := m.ctrl.Call(m, "WritePipelineBuildspec", marshaler, pipelineName)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // WritePipelineBuildspec indicates an expected call of WritePipelineBuildspec
-func (mr *MockwsPipelineWriterMockRecorder) WritePipelineBuildspec(marshaler interface{}) *gomock.Call {
+func (mr *MockwsPipelineWriterMockRecorder) WritePipelineBuildspec(marshaler, pipelineName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineBuildspec", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineBuildspec), marshaler)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineBuildspec", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineBuildspec), marshaler, pipelineName)
 }
 
 // WritePipelineManifest mocks base method
-func (m *MockwsPipelineWriter) WritePipelineManifest(marshaler encoding.BinaryMarshaler) (string, error) {
+func (m *MockwsPipelineWriter) WritePipelineManifest(marshaler encoding.BinaryMarshaler, pipelineName string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WritePipelineManifest", marshaler)
+	ret := m.ctrl.Call(m, "WritePipelineManifest", marshaler, pipelineName)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // WritePipelineManifest indicates an expected call of WritePipelineManifest
-func (mr *MockwsPipelineWriterMockRecorder) WritePipelineManifest(marshaler interface{}) *gomock.Call {
+func (mr *MockwsPipelineWriterMockRecorder) WritePipelineManifest(marshaler, pipelineName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineManifest", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineManifest), marshaler)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineManifest", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineManifest), marshaler, pipelineName)
 }
 
 // MockwsAppDeleter is a mock of wsAppDeleter interface
@@ -1183,6 +1711,89 @@ func (mr *MockwsAppReaderMockRecorder) ReadAppManifest(appName interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAppManifest", reflect.TypeOf((*MockwsAppReader)(nil).ReadAppManifest), appName)
 }
 
+// MockwsAppPipelineReader is a mock of wsAppPipelineReader interface
+type MockwsAppPipelineReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsAppPipelineReaderMockRecorder
+}
+
+// MockwsAppPipelineReaderMockRecorder is the mock recorder for MockwsAppPipelineReader
+type MockwsAppPipelineReaderMockRecorder struct {
+	mock *MockwsAppPipelineReader
+}
+
+// NewMockwsAppPipelineReader creates a new mock instance
+func NewMockwsAppPipelineReader(ctrl *gomock.Controller) *MockwsAppPipelineReader {
+	mock := &MockwsAppPipelineReader{ctrl: ctrl}
+	mock.recorder = &MockwsAppPipelineReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsAppPipelineReader) EXPECT() *MockwsAppPipelineReaderMockRecorder {
+	return m.recorder
+}
+
+// AppNames mocks base method
+func (m *MockwsAppPipelineReader) AppNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppNames indicates an expected call of AppNames
+func (mr *MockwsAppPipelineReaderMockRecorder) AppNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppNames", reflect.TypeOf((*MockwsAppPipelineReader)(nil).AppNames))
+}
+
+// ReadAppManifest mocks base method
+func (m *MockwsAppPipelineReader) ReadAppManifest(appName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAppManifest", appName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAppManifest indicates an expected call of ReadAppManifest
+func (mr *MockwsAppPipelineReaderMockRecorder) ReadAppManifest(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAppManifest", reflect.TypeOf((*MockwsAppPipelineReader)(nil).ReadAppManifest), appName)
+}
+
+// PipelineNames mocks base method
+func (m *MockwsAppPipelineReader) PipelineNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PipelineNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PipelineNames indicates an expected call of PipelineNames
+func (mr *MockwsAppPipelineReaderMockRecorder) PipelineNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineNames", reflect.TypeOf((*MockwsAppPipelineReader)(nil).PipelineNames))
+}
+
+// ReadPipelineManifest mocks base method
+func (m *MockwsAppPipelineReader) ReadPipelineManifest(pipelineName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadPipelineManifest", pipelineName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadPipelineManifest indicates an expected call of ReadPipelineManifest
+func (mr *MockwsAppPipelineReaderMockRecorder) ReadPipelineManifest(pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsAppPipelineReader)(nil).ReadPipelineManifest), pipelineName)
+}
+
 // MockwsPipelineReader is a mock of wsPipelineReader interface
 type MockwsPipelineReader struct {
 	ctrl     *gomock.Controller
@@ -1221,19 +1832,49 @@ func (mr *MockwsPipelineReaderMockRecorder) AppNames() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppNames", reflect.TypeOf((*MockwsPipelineReader)(nil).AppNames))
 }
 
+// PipelineNames mocks base method
+func (m *MockwsPipelineReader) PipelineNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PipelineNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PipelineNames indicates an expected call of PipelineNames
+func (mr *MockwsPipelineReaderMockRecorder) PipelineNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineNames", reflect.TypeOf((*MockwsPipelineReader)(nil).PipelineNames))
+}
+
 // ReadPipelineManifest mocks base method
-func (m *MockwsPipelineReader) ReadPipelineManifest() ([]byte, error) {
+func (m *MockwsPipelineReader) ReadPipelineManifest(pipelineName string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadPipelineManifest")
+	ret := m.ctrl.Call(m, "ReadPipelineManifest", pipelineName)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReadPipelineManifest indicates an expected call of ReadPipelineManifest
-func (mr *MockwsPipelineReaderMockRecorder) ReadPipelineManifest() *gomock.Call {
+func (mr *MockwsPipelineReaderMockRecorder) ReadPipelineManifest(pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadPipelineManifest), pipelineName)
+}
+
+// PipelineBuildspecPath mocks base method
+func (m *MockwsPipelineReader) PipelineBuildspecPath(pipelineName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PipelineBuildspecPath", pipelineName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PipelineBuildspecPath indicates an expected call of PipelineBuildspecPath
+func (mr *MockwsPipelineReaderMockRecorder) PipelineBuildspecPath(pipelineName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadPipelineManifest))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineBuildspecPath", reflect.TypeOf((*MockwsPipelineReader)(nil).PipelineBuildspecPath), pipelineName)
 }
 
 // MockwsProjectManager is a mock of wsProjectManager interface
@@ -48,6 +48,49 @@ func (mr *MockenvironmentDeployerMockRecorder) DeployEnvironment(env interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).DeployEnvironment), env)
 }
 
+// UpdateEnvironment mocks base method
+func (m *MockenvironmentDeployer) UpdateEnvironment(env *deploy.CreateEnvironmentInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment
+func (mr *MockenvironmentDeployerMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).UpdateEnvironment), env)
+}
+
+// UpdateEnvironmentDomain mocks base method
+func (m *MockenvironmentDeployer) UpdateEnvironmentDomain(env *deploy.CreateEnvironmentInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironmentDomain", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironmentDomain indicates an expected call of UpdateEnvironmentDomain
+func (mr *MockenvironmentDeployerMockRecorder) UpdateEnvironmentDomain(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironmentDomain", reflect.TypeOf((*MockenvironmentDeployer)(nil).UpdateEnvironmentDomain), env)
+}
+
+// HTTPSListenerImporters mocks base method
+func (m *MockenvironmentDeployer) HTTPSListenerImporters(projectName, envName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HTTPSListenerImporters", projectName, envName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HTTPSListenerImporters indicates an expected call of HTTPSListenerImporters
+func (mr *MockenvironmentDeployerMockRecorder) HTTPSListenerImporters(projectName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HTTPSListenerImporters", reflect.TypeOf((*MockenvironmentDeployer)(nil).HTTPSListenerImporters), projectName, envName)
+}
+
 // StreamEnvironmentCreation mocks base method
 func (m *MockenvironmentDeployer) StreamEnvironmentCreation(env *deploy.CreateEnvironmentInput) (<-chan []deploy.ResourceEvent, <-chan deploy.CreateEnvironmentResponse) {
 	m.ctrl.T.Helper()
@@ -157,6 +200,20 @@ func (mr *MockpipelineDeployerMockRecorder) AddPipelineResourcesToProject(projec
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipelineResourcesToProject", reflect.TypeOf((*MockpipelineDeployer)(nil).AddPipelineResourcesToProject), project, region)
 }
 
+// DeletePipeline mocks base method
+func (m *MockpipelineDeployer) DeletePipeline(projectName, pipelineName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePipeline", projectName, pipelineName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePipeline indicates an expected call of DeletePipeline
+func (mr *MockpipelineDeployerMockRecorder) DeletePipeline(projectName, pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).DeletePipeline), projectName, pipelineName)
+}
+
 // GetProjectResourcesByRegion mocks base method
 func (m *MockpipelineDeployer) GetProjectResourcesByRegion(project *archer.Project, region string) (*archer.ProjectRegionalResources, error) {
 	m.ctrl.T.Helper()
@@ -266,6 +323,20 @@ func (mr *MockprojectDeployerMockRecorder) DelegateDNSPermissions(project, accou
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateDNSPermissions", reflect.TypeOf((*MockprojectDeployer)(nil).DelegateDNSPermissions), project, accountID)
 }
 
+// UpdateProjectDomain mocks base method
+func (m *MockprojectDeployer) UpdateProjectDomain(project *archer.Project, accountIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectDomain", project, accountIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProjectDomain indicates an expected call of UpdateProjectDomain
+func (mr *MockprojectDeployerMockRecorder) UpdateProjectDomain(project, accountIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectDomain", reflect.TypeOf((*MockprojectDeployer)(nil).UpdateProjectDomain), project, accountIDs)
+}
+
 // DeleteProject mocks base method
 func (m *MockprojectDeployer) DeleteProject(name string) error {
 	m.ctrl.T.Helper()
@@ -370,6 +441,49 @@ func (mr *MockdeployerMockRecorder) DeployEnvironment(env interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvironment", reflect.TypeOf((*Mockdeployer)(nil).DeployEnvironment), env)
 }
 
+// UpdateEnvironment mocks base method
+func (m *Mockdeployer) UpdateEnvironment(env *deploy.CreateEnvironmentInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment
+func (mr *MockdeployerMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*Mockdeployer)(nil).UpdateEnvironment), env)
+}
+
+// UpdateEnvironmentDomain mocks base method
+func (m *Mockdeployer) UpdateEnvironmentDomain(env *deploy.CreateEnvironmentInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironmentDomain", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironmentDomain indicates an expected call of UpdateEnvironmentDomain
+func (mr *MockdeployerMockRecorder) UpdateEnvironmentDomain(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironmentDomain", reflect.TypeOf((*Mockdeployer)(nil).UpdateEnvironmentDomain), env)
+}
+
+// HTTPSListenerImporters mocks base method
+func (m *Mockdeployer) HTTPSListenerImporters(projectName, envName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HTTPSListenerImporters", projectName, envName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HTTPSListenerImporters indicates an expected call of HTTPSListenerImporters
+func (mr *MockdeployerMockRecorder) HTTPSListenerImporters(projectName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HTTPSListenerImporters", reflect.TypeOf((*Mockdeployer)(nil).HTTPSListenerImporters), projectName, envName)
+}
+
 // StreamEnvironmentCreation mocks base method
 func (m *Mockdeployer) StreamEnvironmentCreation(env *deploy.CreateEnvironmentInput) (<-chan []deploy.ResourceEvent, <-chan deploy.CreateEnvironmentResponse) {
 	m.ctrl.T.Helper()
@@ -455,6 +569,20 @@ func (mr *MockdeployerMockRecorder) DelegateDNSPermissions(project, accountID in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateDNSPermissions", reflect.TypeOf((*Mockdeployer)(nil).DelegateDNSPermissions), project, accountID)
 }
 
+// UpdateProjectDomain mocks base method
+func (m *Mockdeployer) UpdateProjectDomain(project *archer.Project, accountIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectDomain", project, accountIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProjectDomain indicates an expected call of UpdateProjectDomain
+func (mr *MockdeployerMockRecorder) UpdateProjectDomain(project, accountIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectDomain", reflect.TypeOf((*Mockdeployer)(nil).UpdateProjectDomain), project, accountIDs)
+}
+
 // DeleteProject mocks base method
 func (m *Mockdeployer) DeleteProject(name string) error {
 	m.ctrl.T.Helper()
@@ -526,6 +654,20 @@ func (mr *MockdeployerMockRecorder) AddPipelineResourcesToProject(project, regio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipelineResourcesToProject", reflect.TypeOf((*Mockdeployer)(nil).AddPipelineResourcesToProject), project, region)
 }
 
+// DeletePipeline mocks base method
+func (m *Mockdeployer) DeletePipeline(projectName, pipelineName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePipeline", projectName, pipelineName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePipeline indicates an expected call of DeletePipeline
+func (mr *MockdeployerMockRecorder) DeletePipeline(projectName, pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePipeline", reflect.TypeOf((*Mockdeployer)(nil).DeletePipeline), projectName, pipelineName)
+}
+
 // GetProjectResourcesByRegion mocks base method
 func (m *Mockdeployer) GetProjectResourcesByRegion(project *archer.Project, region string) (*archer.ProjectRegionalResources, error) {
 	m.ctrl.T.Helper()
@@ -4,8 +4,13 @@
 package cli
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/aws/amazon-ecs-cli-v2/cmd/ecs-preview/template"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/group"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
 	"github.com/spf13/cobra"
 )
 
@@ -19,6 +24,11 @@ func BuildPipelineCmd() *cobra.Command {
 
 	cmd.AddCommand(BuildPipelineInitCmd())
 	cmd.AddCommand(BuildPipelineUpdateCmd())
+	cmd.AddCommand(BuildPipelineValidateCmd())
+	cmd.AddCommand(BuildPipelineStatusCmd())
+	cmd.AddCommand(BuildPipelineListCmd())
+	cmd.AddCommand(BuildPipelineShowCmd())
+	cmd.AddCommand(BuildPipelineDeleteCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -27,3 +37,82 @@ func BuildPipelineCmd() *cobra.Command {
 
 	return cmd
 }
+
+// readPipelineManifest returns the manifest of the pipeline with the name in the workspace.
+// If the name is empty, the workspace must have a single pipeline.
+func readPipelineManifest(ws wsPipelineManifestReader, name string) (*manifest.PipelineManifest, error) {
+	return readPipelineManifestWith(ws, name, manifest.UnmarshalPipeline)
+}
+
+// readPipelineManifestWith is like readPipelineManifest but deserializes the manifest with unmarshal.
+func readPipelineManifestWith(ws wsPipelineManifestReader, name string, unmarshal func([]byte) (*manifest.PipelineManifest, error)) (*manifest.PipelineManifest, error) {
+	if name == "" {
+		names, err := ws.PipelineNames()
+		if err != nil {
+			return nil, fmt.Errorf("list pipelines in workspace: %w", err)
+		}
+		if len(names) > 1 {
+			return nil, fmt.Errorf("there are multiple pipelines in your workspace: %s, please specify the name of the pipeline", strings.Join(names, ", "))
+		}
+		if len(names) == 1 {
+			name = names[0]
+		}
+	}
+	data, err := ws.ReadPipelineManifest(name)
+	if err != nil {
+		return nil, fmt.Errorf("read pipeline manifest: %w", err)
+	}
+	pipeline, err := unmarshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal pipeline manifest: %w", err)
+	}
+	if name != "" && pipeline.Name != name {
+		return nil, fmt.Errorf("pipeline %s not found in your workspace", name)
+	}
+	return pipeline, nil
+}
+
+// pipelineValidationStore provides the environments of the project and the applications of the workspace
+// that a pipeline manifest is validated against.
+type pipelineValidationStore struct {
+	projectName string
+	envLister   archer.EnvironmentLister
+	appNames    []string
+}
+
+// EnvironmentNames returns the names of the environments in the project.
+func (s *pipelineValidationStore) EnvironmentNames() ([]string, error) {
+	envs, err := s.envLister.ListEnvironments(s.projectName)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, env := range envs {
+		names = append(names, env.Name)
+	}
+	return names, nil
+}
+
+// LocalAppNames returns the names of the applications in the workspace.
+func (s *pipelineValidationStore) LocalAppNames() ([]string, error) {
+	return s.appNames, nil
+}
+
+// pipelineAppNames returns the applications built and deployed by the pipeline among the applications in the workspace.
+func pipelineAppNames(pipeline *manifest.PipelineManifest, wsAppNames []string) ([]string, error) {
+	if len(pipeline.Apps) == 0 {
+		return wsAppNames, nil
+	}
+	for _, app := range pipeline.Apps {
+		if !contains(app, wsAppNames) {
+			return nil, fmt.Errorf("application %s of pipeline %s does not exist in your workspace", app, pipeline.Name)
+		}
+	}
+	return pipeline.Apps, nil
+}
+
+// deployedPipelineName returns the name of the pipeline in CodePipeline,
+// which is the name of the stack that the pipeline is deployed with.
+func deployedPipelineName(projectName, pipelineName string) string {
+	return fmt.Sprintf("%s-%s", projectName, pipelineName)
+}
@@ -0,0 +1,225 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/secretsmanager"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/session"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	termprogress "github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	fmtPipelineDeleteConfirmPrompt = "Are you sure you want to delete pipeline %s from project %s?"
+	pipelineDeleteConfirmHelp      = "This will delete the deployment pipeline. The applications it deployed are not deleted."
+
+	fmtDeletePipelineStart    = "Deleting pipeline %s from project %s."
+	fmtDeletePipelineFailed   = "Failed to delete pipeline %s from project %s."
+	fmtDeletePipelineComplete = "Deleted pipeline %s from project %s."
+
+	fmtDeletePipelineSecretStart    = "Scheduling the deletion of secret %s."
+	fmtDeletePipelineSecretFailed   = "Failed to schedule the deletion of secret %s."
+	fmtDeletePipelineSecretComplete = "Scheduled the deletion of secret %s, it can be restored within %d days."
+)
+
+type deletePipelineVars struct {
+	*GlobalOpts
+	PipelineName     string
+	SkipConfirmation bool
+	DeleteSecret     bool
+}
+
+type deletePipelineOpts struct {
+	deletePipelineVars
+
+	pipelineDeployer pipelineDeployer
+	secretsManager   archer.SecretDeleter
+	ws               wsPipelineManifestReader
+	prog             progress
+}
+
+func newDeletePipelineOpts(vars deletePipelineVars) (*deletePipelineOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	secretsManager, err := secretsmanager.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create secrets manager: %w", err)
+	}
+	sess, err := session.NewProvider().Default()
+	if err != nil {
+		return nil, err
+	}
+
+	return &deletePipelineOpts{
+		deletePipelineVars: vars,
+		pipelineDeployer:   cloudformation.New(sess),
+		secretsManager:     secretsManager,
+		ws:                 ws,
+		prog:               termprogress.NewSpinner(),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *deletePipelineOpts) Validate() error {
+	if o.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	return nil
+}
+
+// Ask reads the name of the pipeline from the workspace if it's not passed in,
+// and confirms the deletion with the user.
+func (o *deletePipelineOpts) Ask() error {
+	if o.PipelineName == "" {
+		pipeline, err := readPipelineManifest(o.ws, "")
+		if err != nil {
+			return err
+		}
+		o.PipelineName = pipeline.Name
+	}
+
+	if o.SkipConfirmation {
+		return nil
+	}
+	deleteConfirmed, err := o.prompt.Confirm(
+		fmt.Sprintf(fmtPipelineDeleteConfirmPrompt, o.PipelineName, o.ProjectName()),
+		pipelineDeleteConfirmHelp)
+	if err != nil {
+		return fmt.Errorf("pipeline delete confirmation prompt: %w", err)
+	}
+	if !deleteConfirmed {
+		return errOperationCancelled
+	}
+	return nil
+}
+
+// Execute deletes the pipeline stack and, if requested, the secret storing the GitHub access token of the pipeline.
+func (o *deletePipelineOpts) Execute() error {
+	// Look up the secret before removing the stack so that nothing is deleted if it can't be found.
+	var secretName string
+	if o.DeleteSecret {
+		name, err := o.secretName()
+		if err != nil {
+			return err
+		}
+		secretName = name
+	}
+
+	o.prog.Start(fmt.Sprintf(fmtDeletePipelineStart, color.HighlightUserInput(o.PipelineName), color.HighlightUserInput(o.ProjectName())))
+	if err := o.pipelineDeployer.DeletePipeline(o.ProjectName(), o.PipelineName); err != nil {
+		o.prog.Stop(log.Serrorf(fmtDeletePipelineFailed, color.HighlightUserInput(o.PipelineName), color.HighlightUserInput(o.ProjectName())))
+		return fmt.Errorf("delete pipeline %s: %w", o.PipelineName, err)
+	}
+	o.prog.Stop(log.Ssuccessf(fmtDeletePipelineComplete, color.HighlightUserInput(o.PipelineName), color.HighlightUserInput(o.ProjectName())))
+
+	if secretName == "" {
+		return nil
+	}
+	o.prog.Start(fmt.Sprintf(fmtDeletePipelineSecretStart, color.HighlightUserInput(secretName)))
+	if err := o.secretsManager.DeleteSecret(secretName); err != nil {
+		o.prog.Stop(log.Serrorf(fmtDeletePipelineSecretFailed, color.HighlightUserInput(secretName)))
+		return err
+	}
+	o.prog.Stop(log.Ssuccessf(fmtDeletePipelineSecretComplete, color.HighlightUserInput(secretName), secretsmanager.RecoveryWindowInDays))
+	return nil
+}
+
+// secretName returns the name of the secret created by "pipeline init" for the GitHub access token,
+// or an empty string if the source of the pipeline doesn't use one.
+func (o *deletePipelineOpts) secretName() (string, error) {
+	pipeline, err := readPipelineManifest(o.ws, o.PipelineName)
+	if err != nil {
+		return "", fmt.Errorf("find the secret to delete: %w", err)
+	}
+	if pipeline.Source.ProviderName != manifest.GithubProviderName {
+		log.Infof("Pipeline %s doesn't use a GitHub access token, there is no secret to delete.\n", color.HighlightUserInput(o.PipelineName))
+		return "", nil
+	}
+	secretName, err := pipelineSecretName(pipeline)
+	if err != nil {
+		return "", err
+	}
+	// The secret is named after the project and the repository, so other pipelines of the repository can use it too.
+	names, err := o.ws.PipelineNames()
+	if err != nil {
+		return "", fmt.Errorf("list pipelines in workspace: %w", err)
+	}
+	for _, name := range names {
+		if name == o.PipelineName {
+			continue
+		}
+		other, err := readPipelineManifest(o.ws, name)
+		if err != nil {
+			return "", fmt.Errorf("find the pipelines using the secret %s: %w", secretName, err)
+		}
+		if other.Source.ProviderName != manifest.GithubProviderName {
+			continue
+		}
+		otherSecretName, err := pipelineSecretName(other)
+		if err != nil {
+			return "", err
+		}
+		if otherSecretName == secretName {
+			log.Infof("Secret %s is also used by pipeline %s, it won't be deleted.\n", color.HighlightUserInput(secretName), color.HighlightUserInput(name))
+			return "", nil
+		}
+	}
+	return secretName, nil
+}
+
+// pipelineSecretName returns the name of the secret storing the GitHub access token of the pipeline.
+func pipelineSecretName(pipeline *manifest.PipelineManifest) (string, error) {
+	source := &deploy.Source{
+		ProviderName: pipeline.Source.ProviderName,
+		Properties:   pipeline.Source.Properties,
+	}
+	return source.GitHubPersonalAccessTokenSecretID()
+}
+
+// BuildPipelineDeleteCmd builds the command for deleting a deployed pipeline.
+func BuildPipelineDeleteCmd() *cobra.Command {
+	vars := deletePipelineVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Deletes a deployed pipeline.",
+		Long:  "Deletes the deployment pipeline of your project, and optionally the secret storing its GitHub access token.",
+
+		Example: `
+  Deletes the pipeline in your workspace along with its GitHub access token secret
+  /code $ ecs-preview pipeline delete --delete-secret
+
+  Deletes the pipeline "pipeline-phonetool" without confirmation prompt
+  /code $ ecs-preview pipeline delete -n pipeline-phonetool --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDeletePipelineOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.PipelineName, nameFlag, nameFlagShort, "", pipelineNameFlagDescription)
+	cmd.Flags().BoolVar(&vars.SkipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.DeleteSecret, deleteSecretFlag, false, deleteSecretFlagDescription)
+	return cmd
+}
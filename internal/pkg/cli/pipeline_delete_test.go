@@ -0,0 +1,331 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockGitHubPipelineManifest = `name: pipeline-phonetool
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    access_token_secret: github-token-phonetool-phonetool
+    repository: badgoose/phonetool
+    branch: master
+
+stages:
+  - name: test
+`
+	mockCodeCommitPipelineManifest = `name: pipeline-phonetool
+version: 1
+
+source:
+  provider: CodeCommit
+  properties:
+    repository: phonetool
+    branch: master
+
+stages:
+  - name: test
+`
+)
+
+func TestPipelineDelete_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inPipelineName     string
+		inSkipConfirmation bool
+		mockWorkspace      func(m *climocks.MockwsPipelineManifestReader)
+		mockPrompt         func(m *climocks.Mockprompter)
+
+		wantedPipelineName string
+		wantedError        error
+	}{
+		"reads the name from the pipeline manifest": {
+			inSkipConfirmation: true,
+			mockWorkspace: func(m *climocks.MockwsPipelineManifestReader) {
+				m.EXPECT().PipelineNames().Return(nil, nil)
+				m.EXPECT().ReadPipelineManifest("").Return([]byte(mockGitHubPipelineManifest), nil)
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {},
+
+			wantedPipelineName: "pipeline-phonetool",
+		},
+		"returns error if there are multiple pipelines in the workspace": {
+			mockWorkspace: func(m *climocks.MockwsPipelineManifestReader) {
+				m.EXPECT().PipelineNames().Return([]string{"release", "hotfix"}, nil)
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {},
+
+			wantedError: errors.New("there are multiple pipelines in your workspace: release, hotfix, please specify the name of the pipeline"),
+		},
+		"returns error if fail to read the pipeline manifest": {
+			mockWorkspace: func(m *climocks.MockwsPipelineManifestReader) {
+				m.EXPECT().PipelineNames().Return(nil, nil)
+				m.EXPECT().ReadPipelineManifest("").Return(nil, errors.New("some error"))
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {},
+
+			wantedError: errors.New("read pipeline manifest: some error"),
+		},
+		"returns wrapped error if fail to confirm": {
+			inPipelineName: "pipeline-phonetool",
+			mockWorkspace:  func(m *climocks.MockwsPipelineManifestReader) {},
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().Confirm(fmt.Sprintf(fmtPipelineDeleteConfirmPrompt, "pipeline-phonetool", "phonetool"), pipelineDeleteConfirmHelp).Return(false, errors.New("some error"))
+			},
+
+			wantedError: errors.New("pipeline delete confirmation prompt: some error"),
+		},
+		"returns error if the deletion is cancelled": {
+			inPipelineName: "pipeline-phonetool",
+			mockWorkspace:  func(m *climocks.MockwsPipelineManifestReader) {},
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+
+			wantedError: errOperationCancelled,
+		},
+		"confirms the deletion": {
+			inPipelineName: "pipeline-phonetool",
+			mockWorkspace:  func(m *climocks.MockwsPipelineManifestReader) {},
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+
+			wantedPipelineName: "pipeline-phonetool",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWorkspace := climocks.NewMockwsPipelineManifestReader(ctrl)
+			mockPrompt := climocks.NewMockprompter(ctrl)
+			tc.mockWorkspace(mockWorkspace)
+			tc.mockPrompt(mockPrompt)
+
+			opts := &deletePipelineOpts{
+				deletePipelineVars: deletePipelineVars{
+					GlobalOpts: &GlobalOpts{
+						projectName: "phonetool",
+						prompt:      mockPrompt,
+					},
+					PipelineName:     tc.inPipelineName,
+					SkipConfirmation: tc.inSkipConfirmation,
+				},
+				ws: mockWorkspace,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedPipelineName, opts.PipelineName)
+			}
+		})
+	}
+}
+
+func TestPipelineDelete_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inPipelineName string
+		inDeleteSecret bool
+		mockWorkspace  func(m *climocks.MockwsPipelineManifestReader)
+		mockDeployer   func(m *climocks.MockpipelineDeployer)
+		mockSecrets    func(m *mocks.MockSecretDeleter)
+		mockProg       func(m *climocks.Mockprogress)
+
+		wantedError error
+	}{
+		"returns wrapped error if fail to delete the pipeline": {
+			inPipelineName: "pipeline-phonetool",
+			mockWorkspace:  func(m *climocks.MockwsPipelineManifestReader) {},
+			mockDeployer: func(m *climocks.MockpipelineDeployer) {
+				m.EXPECT().DeletePipeline("phonetool", "pipeline-phonetool").Return(errors.New("some error"))
+			},
+			mockSecrets: func(m *mocks.MockSecretDeleter) {},
+			mockProg: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any())
+				m.EXPECT().Stop(gomock.Any())
+			},
+
+			wantedError: errors.New("delete pipeline pipeline-phonetool: some error"),
+		},
+		"deletes the pipeline without the secret": {
+			inPipelineName: "pipeline-phonetool",
+			mockWorkspace:  func(m *climocks.MockwsPipelineManifestReader) {},
+			mockDeployer: func(m *climocks.MockpipelineDeployer) {
+				m.EXPECT().DeletePipeline("phonetool", "pipeline-phonetool").Return(nil)
+			},
+			mockSecrets: func(m *mocks.MockSecretDeleter) {},
+			mockProg: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any())
+				m.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"returns error without deleting anything if the pipeline is not in the workspace": {
+			inPipelineName: "pipeline-phonetool-backend",
+			inDeleteSecret: true,
+			mockWorkspace: func(m *climocks.MockwsPipelineManifestReader) {
+				m.EXPECT().ReadPipelineManifest("pipeline-phonetool-backend").Return([]byte(mockGitHubPipelineManifest), nil)
+			},
+			mockDeployer: func(m *climocks.MockpipelineDeployer) {},
+			mockSecrets:  func(m *mocks.MockSecretDeleter) {},
+			mockProg:     func(m *climocks.Mockprogress) {},
+
+			wantedError: errors.New("find the secret to delete: pipeline pipeline-phonetool-backend not found in your workspace"),
+		},
+		"skips deleting the secret if the source doesn't use a GitHub access token": {
+			inPipelineName: "pipeline-phonetool",
+			inDeleteSecret: true,
+			mockWorkspace: func(m *climocks.MockwsPipelineManifestReader) {
+				m.EXPECT().ReadPipelineManifest("pipeline-phonetool").Return([]byte(mockCodeCommitPipelineManifest), nil)
+			},
+			mockDeployer: func(m *climocks.MockpipelineDeployer) {
+				m.EXPECT().DeletePipeline("phonetool", "pipeline-phonetool").Return(nil)
+			},
+			mockSecrets: func(m *mocks.MockSecretDeleter) {},
+			mockProg: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any())
+				m.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"skips deleting the secret if another pipeline uses it": {
+			inPipelineName: "pipeline-phonetool",
+			inDeleteSecret: true,
+			mockWorkspace: func(m *climocks.MockwsPipelineManifestReader) {
+				m.EXPECT().ReadPipelineManifest("pipeline-phonetool").Return([]byte(mockGitHubPipelineManifest), nil)
+				m.EXPECT().PipelineNames().Return([]string{"pipeline-phonetool", "pipeline-phonetool-test", "pipeline-phonetool-prod"}, nil)
+				m.EXPECT().ReadPipelineManifest("pipeline-phonetool-test").Return([]byte(`name: pipeline-phonetool-test
+version: 1
+
+source:
+  provider: CodeCommit
+  properties:
+    repository: phonetool
+    branch: master
+
+stages:
+  - name: test
+`), nil)
+				m.EXPECT().ReadPipelineManifest("pipeline-phonetool-prod").Return([]byte(`name: pipeline-phonetool-prod
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    access_token_secret: github-token-phonetool-phonetool
+    repository: badgoose/phonetool
+    branch: release
+
+stages:
+  - name: prod
+`), nil)
+			},
+			mockDeployer: func(m *climocks.MockpipelineDeployer) {
+				m.EXPECT().DeletePipeline("phonetool", "pipeline-phonetool").Return(nil)
+			},
+			mockSecrets: func(m *mocks.MockSecretDeleter) {},
+			mockProg: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any())
+				m.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"returns error if fail to delete the secret": {
+			inPipelineName: "pipeline-phonetool",
+			inDeleteSecret: true,
+			mockWorkspace: func(m *climocks.MockwsPipelineManifestReader) {
+				m.EXPECT().ReadPipelineManifest("pipeline-phonetool").Return([]byte(mockGitHubPipelineManifest), nil)
+				m.EXPECT().PipelineNames().Return([]string{"pipeline-phonetool"}, nil)
+			},
+			mockDeployer: func(m *climocks.MockpipelineDeployer) {
+				m.EXPECT().DeletePipeline("phonetool", "pipeline-phonetool").Return(nil)
+			},
+			mockSecrets: func(m *mocks.MockSecretDeleter) {
+				m.EXPECT().DeleteSecret("github-token-phonetool-phonetool").Return(errors.New("some error"))
+			},
+			mockProg: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any()).Times(2)
+				m.EXPECT().Stop(gomock.Any()).Times(2)
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"deletes the pipeline and the secret": {
+			inPipelineName: "pipeline-phonetool",
+			inDeleteSecret: true,
+			mockWorkspace: func(m *climocks.MockwsPipelineManifestReader) {
+				m.EXPECT().ReadPipelineManifest("pipeline-phonetool").Return([]byte(mockGitHubPipelineManifest), nil)
+				m.EXPECT().PipelineNames().Return([]string{"pipeline-phonetool"}, nil)
+			},
+			mockDeployer: func(m *climocks.MockpipelineDeployer) {
+				m.EXPECT().DeletePipeline("phonetool", "pipeline-phonetool").Return(nil)
+			},
+			mockSecrets: func(m *mocks.MockSecretDeleter) {
+				m.EXPECT().DeleteSecret("github-token-phonetool-phonetool").Return(nil)
+			},
+			mockProg: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any()).Times(2)
+				m.EXPECT().Stop(gomock.Any()).Times(2)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWorkspace := climocks.NewMockwsPipelineManifestReader(ctrl)
+			mockDeployer := climocks.NewMockpipelineDeployer(ctrl)
+			mockSecrets := mocks.NewMockSecretDeleter(ctrl)
+			mockProg := climocks.NewMockprogress(ctrl)
+			tc.mockWorkspace(mockWorkspace)
+			tc.mockDeployer(mockDeployer)
+			tc.mockSecrets(mockSecrets)
+			tc.mockProg(mockProg)
+
+			opts := &deletePipelineOpts{
+				deletePipelineVars: deletePipelineVars{
+					GlobalOpts: &GlobalOpts{
+						projectName: "phonetool",
+					},
+					PipelineName: tc.inPipelineName,
+					DeleteSecret: tc.inDeleteSecret,
+				},
+				pipelineDeployer: mockDeployer,
+				secretsManager:   mockSecrets,
+				ws:               mockWorkspace,
+				prog:             mockProg,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/secretsmanager"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
@@ -30,20 +31,22 @@ import (
 )
 
 const (
-	pipelineAddEnvPrompt              = "Would you like to add an environment to your pipeline?"
-	pipelineAddMoreEnvPrompt          = "Would you like to add another environment to your pipeline?"
-	pipelineAddEnvHelpPrompt          = "Adds an environment that corresponds to a deployment stage in your pipeline. Environments are added sequentially."
-	pipelineAddMoreEnvHelpPrompt      = "Adds another environment that corresponds to a deployment stage in your pipeline. Environments are added sequentially."
-	pipelineSelectEnvPrompt           = "Which environment would you like to add to your pipeline?"
-	pipelineSelectGitHubURLPrompt     = "Which GitHub repository would you like to use for your application?"
-	pipelineSelectGitHubURLHelpPrompt = `The GitHub repository linked to your workspace.
+	pipelineAddEnvPrompt         = "Would you like to add an environment to your pipeline?"
+	pipelineAddMoreEnvPrompt     = "Would you like to add another environment to your pipeline?"
+	pipelineAddEnvHelpPrompt     = "Adds an environment that corresponds to a deployment stage in your pipeline. Environments are added sequentially."
+	pipelineAddMoreEnvHelpPrompt = "Adds another environment that corresponds to a deployment stage in your pipeline. Environments are added sequentially."
+	pipelineSelectEnvPrompt      = "Which environment would you like to add to your pipeline?"
+	pipelineSelectURLPrompt      = "Which repository would you like to use for your application?"
+	pipelineSelectURLHelpPrompt  = `The GitHub, Bitbucket or CodeCommit repository linked to your workspace.
 Pushing to this repository will trigger your pipeline build stage.
-Please enter full repository URL, e.g. "https://github.com/myCompany/myRepo", or the owner/rep, e.g. "myCompany/myRepo"`
+Please enter full repository URL, e.g. "https://github.com/myCompany/myRepo", "https://bitbucket.org/myCompany/myRepo" or "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/myRepo"`
 )
 
 const (
 	buildspecTemplatePath = "cicd/buildspec.yml"
 	githubURL             = "github.com"
+	codecommitURL         = "codecommit"
+	bitbucketURL          = "bitbucket.org"
 	masterBranch          = "master"
 )
 
@@ -55,11 +58,16 @@ var (
 var errNoEnvsInProject = errors.New("there were no more environments found that can be added to your pipeline. Please run `ecs-preview env init` to create a new environment")
 
 type initPipelineVars struct {
+	PipelineName      string
+	Apps              []string
 	Environments      []string
+	RepoURL           string
 	GitHubOwner       string
 	GitHubRepo        string
-	GitHubURL         string
 	GitHubAccessToken string
+	CodeCommitRepo    string
+	ConnectionARN     string
+	ConnectionType    string
 	GitBranch         string
 	PipelineFilename  string
 	*GlobalOpts
@@ -76,7 +84,6 @@ func (b binaryBuffer) MarshalBinary() ([]byte, error) {
 }
 
 type initPipelineOpts struct {
-	// TODO add pipeline file (to write to different file than pipeline.yml?)
 	initPipelineVars
 	// Interfaces to interact with dependencies.
 	workspace      wsPipelineWriter
@@ -89,6 +96,12 @@ type initPipelineOpts struct {
 	buildspecPath string
 	secretName    string
 
+	// The type of the CodeStar connection, and the repository it gives access to,
+	// if the source is authorized through a connection.
+	connectionType  string
+	connectionOwner string
+	connectionRepo  string
+
 	// Caches variables
 	projectEnvs []string
 	repoURLs    []string
@@ -144,10 +157,64 @@ func (o *initPipelineOpts) Validate() error {
 	if o.ProjectName() == "" {
 		return errNoProjectInWorkspace
 	}
+	if o.PipelineName != "" {
+		if err := o.validatePipelineName(); err != nil {
+			return err
+		}
+	}
+	if o.ConnectionType != "" {
+		if err := o.validateConnectionType(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
+// validatePipelineName returns an error if the name of the pipeline is malformed,
+// or if it's already the name of an environment or of another pipeline of the workspace.
+// Environment stacks and pipeline stacks are both named after the project, so they can't share names.
+func (o *initPipelineOpts) validatePipelineName() error {
+	if err := validatePipelineName(o.PipelineName); err != nil {
+		return err
+	}
+	for _, env := range o.projectEnvs {
+		if env == o.PipelineName {
+			return fmt.Errorf("pipeline name %s is already used by an environment of project %s", o.PipelineName, o.ProjectName())
+		}
+	}
+	names, err := o.workspace.PipelineNames()
+	if err != nil {
+		return fmt.Errorf("list pipelines in workspace: %w", err)
+	}
+	for _, name := range names {
+		if name == o.PipelineName {
+			return fmt.Errorf("pipeline %s already exists in your workspace", o.PipelineName)
+		}
+	}
+	return nil
+}
+
+// validateConnectionType returns an error if the connection type isn't supported,
+// or if it's a GitHub Enterprise Server connection that the pipeline stack would have to create.
+func (o *initPipelineOpts) validateConnectionType() error {
+	connectionTypes := []string{manifest.BitbucketConnectionType, manifest.GitHubConnectionType, manifest.GitHubEnterpriseConnectionType}
+	valid := false
+	for _, t := range connectionTypes {
+		if o.ConnectionType == t {
+			valid = true
+		}
+	}
+	if !valid {
+		return fmt.Errorf("invalid connection type %s: must be one of %s", o.ConnectionType, strings.Join(connectionTypes, ", "))
+	}
+	if o.ConnectionType == manifest.GitHubEnterpriseConnectionType && o.ConnectionARN == "" {
+		// Connections to a GitHub Enterprise Server need a host that the CLI can't create for you.
+		return fmt.Errorf("a connection is required for a GitHub Enterprise Server repository: please create one in the AWS console and pass its ARN with `--%s`", connectionARNFlag)
+	}
+	return nil
+}
+
 // Ask prompts for fields that are required but not passed in.
 func (o *initPipelineOpts) Ask() error {
 	var err error
@@ -157,17 +224,27 @@ func (o *initPipelineOpts) Ask() error {
 		}
 	}
 
-	if o.GitHubURL == "" {
-		if err = o.selectGitHubURL(); err != nil {
+	if o.RepoURL == "" {
+		if err = o.selectURL(); err != nil {
 			return err
 		}
 	}
-	if o.GitHubOwner, o.GitHubRepo, err = o.parseOwnerRepoName(o.GitHubURL); err != nil {
-		return err
-	}
-
-	if o.GitHubAccessToken == "" {
-		if err = o.getGitHubAccessToken(); err != nil {
+	switch {
+	case isCodeCommitURL(o.RepoURL):
+		// CodeCommit sources are authorized through the pipeline's IAM role, there is no token to ask for.
+		if o.CodeCommitRepo, err = o.parseCodeCommitRepoName(o.RepoURL); err != nil {
+			return err
+		}
+	case isBitbucketURL(o.RepoURL) || o.ConnectionARN != "" || o.ConnectionType != "":
+		// Connections are authorized in the AWS console, there is no token to ask for either.
+		if err = o.parseConnectionRepo(o.RepoURL); err != nil {
+			return err
+		}
+	case hasHost(o.RepoURL) && !isGitHubURL(o.RepoURL):
+		return fmt.Errorf("the repository %s is not hosted on GitHub, Bitbucket or CodeCommit: pass `--%s %s` and `--%s` for a GitHub Enterprise Server repository",
+			o.RepoURL, connectionTypeFlag, manifest.GitHubEnterpriseConnectionType, connectionARNFlag)
+	default:
+		if err = o.askGitHubRepo(); err != nil {
 			return err
 		}
 	}
@@ -181,22 +258,19 @@ func (o *initPipelineOpts) Ask() error {
 
 // Execute writes the pipeline manifest file.
 func (o *initPipelineOpts) Execute() error {
-	secretName := o.createSecretName()
-	_, err := o.secretsmanager.CreateSecret(secretName, o.GitHubAccessToken)
-
-	if err != nil {
-		var existsErr *secretsmanager.ErrSecretAlreadyExists
-		if !errors.As(err, &existsErr) {
+	if o.usesGitHubAccessToken() {
+		if err := o.storeGitHubAccessToken(); err != nil {
 			return err
 		}
-		log.Successf("Secret already exists for %s! Do nothing.\n", color.HighlightUserInput(o.GitHubRepo))
-	} else {
-		log.Successf("Created the secret %s for pipeline source stage!\n", color.HighlightUserInput(secretName))
 	}
-	o.secretName = secretName
+
+	if o.PipelineName == "" {
+		o.PipelineName = o.createPipelineName()
+	}
 
 	// write pipeline.yml file, populate with:
 	//   - github repo as source
+	//   - applications of the pipeline
 	//   - stage names (environments)
 	//   - enable/disable transition to prod envs
 
@@ -212,7 +286,7 @@ func (o *initPipelineOpts) Execute() error {
 	}
 	o.buildspecPath = buildspecPath
 
-	log.Successf("Wrote the pipeline manifest for %s at '%s'\n", color.HighlightUserInput(o.GitHubRepo), color.HighlightResource(relPath(o.manifestPath)))
+	log.Successf("Wrote the pipeline manifest for %s at '%s'\n", color.HighlightUserInput(o.repoName()), color.HighlightResource(relPath(o.manifestPath)))
 	log.Successf("Wrote the buildspec for the pipeline's build stage at '%s'\n", color.HighlightResource(relPath(o.buildspecPath)))
 	log.Infoln("The manifest contains configurations for your CodePipeline resources, such as your pipeline stages and build steps.")
 	log.Infoln("The buildspec contains the commands to build and push your container images to your ECR repositories.")
@@ -224,11 +298,48 @@ func (o *initPipelineOpts) Execute() error {
 
 // RecommendedActions returns follow-up actions the user can take after successfully executing the command.
 func (o *initPipelineOpts) RecommendedActions() []string {
-	return []string{
+	actions := []string{
 		fmt.Sprintf("Update the %s phase of your buildspec to unit test your applications before pushing the images.", color.HighlightResource("build")),
 		fmt.Sprint("Update your pipeline manifest to add additional stages."),
 		fmt.Sprintf("Run %s to deploy your pipeline for the repository.", color.HighlightCode("ecs-preview pipeline update")),
 	}
+	if o.connectionType != "" && o.ConnectionARN == "" {
+		actions = append(actions, fmt.Sprintf("Complete the pending %s connection created by %s in the AWS console under %s, so that the pipeline can access your repository.",
+			o.connectionType, color.HighlightCode("ecs-preview pipeline update"), color.HighlightResource("Developer Tools > Settings > Connections")))
+	}
+	return actions
+}
+
+func (o *initPipelineOpts) storeGitHubAccessToken() error {
+	secretName := o.createSecretName()
+	_, err := o.secretsmanager.CreateSecret(secretName, o.GitHubAccessToken)
+
+	if err != nil {
+		var existsErr *secretsmanager.ErrSecretAlreadyExists
+		if !errors.As(err, &existsErr) {
+			return err
+		}
+		log.Successf("Secret already exists for %s! Do nothing.\n", color.HighlightUserInput(o.GitHubRepo))
+	} else {
+		log.Successf("Created the secret %s for pipeline source stage!\n", color.HighlightUserInput(secretName))
+	}
+	o.secretName = secretName
+	return nil
+}
+
+// usesGitHubAccessToken returns true if the source is a GitHub repository authorized with a personal access token.
+func (o *initPipelineOpts) usesGitHubAccessToken() bool {
+	return o.CodeCommitRepo == "" && o.connectionType == ""
+}
+
+func (o *initPipelineOpts) repoName() string {
+	if o.CodeCommitRepo != "" {
+		return o.CodeCommitRepo
+	}
+	if o.connectionRepo != "" {
+		return o.connectionRepo
+	}
+	return o.GitHubRepo
 }
 
 func (o *initPipelineOpts) createSecretName() string {
@@ -236,10 +347,30 @@ func (o *initPipelineOpts) createSecretName() string {
 }
 
 func (o *initPipelineOpts) createPipelineName() string {
-	return fmt.Sprintf("pipeline-%s-%s-%s", o.projectName, o.GitHubOwner, o.GitHubRepo)
+	if o.CodeCommitRepo != "" {
+		return fmt.Sprintf("%s%s-%s", pipelineNamePrefix, o.projectName, o.CodeCommitRepo)
+	}
+	if o.connectionRepo != "" {
+		return fmt.Sprintf("%s%s-%s-%s", pipelineNamePrefix, o.projectName, o.connectionOwner, o.connectionRepo)
+	}
+	return fmt.Sprintf("%s%s-%s-%s", pipelineNamePrefix, o.projectName, o.GitHubOwner, o.GitHubRepo)
 }
 
 func (o *initPipelineOpts) createPipelineProvider() (manifest.Provider, error) {
+	if o.CodeCommitRepo != "" {
+		return manifest.NewProvider(&manifest.CodeCommitProperties{
+			Repository: o.RepoURL,
+			Branch:     o.GitBranch,
+		})
+	}
+	if o.connectionType != "" {
+		return manifest.NewProvider(&manifest.ConnectionProperties{
+			Repository:    o.RepoURL,
+			Branch:        o.GitBranch,
+			ProviderType:  o.connectionType,
+			ConnectionARN: o.ConnectionARN,
+		})
+	}
 	config := &manifest.GitHubProperties{
 		OwnerAndRepository:    "https://" + githubURL + "/" + o.GitHubOwner + "/" + o.GitHubRepo,
 		Branch:                o.GitBranch,
@@ -250,19 +381,18 @@ func (o *initPipelineOpts) createPipelineProvider() (manifest.Provider, error) {
 }
 
 func (o *initPipelineOpts) createPipelineManifest() (string, error) {
-	// TODO change this to flag
-	pipelineName := o.createPipelineName()
 	provider, err := o.createPipelineProvider()
 	if err != nil {
 		return "", fmt.Errorf("could not create pipeline: %w", err)
 	}
 
-	manifest, err := manifest.CreatePipeline(pipelineName, provider, o.Environments)
+	manifest, err := manifest.CreatePipeline(o.PipelineName, provider, o.Environments)
 	if err != nil {
 		return "", fmt.Errorf("generate a manifest: %w", err)
 	}
+	manifest.Apps = o.Apps
 
-	manifestPath, err := o.workspace.WritePipelineManifest(manifest)
+	manifestPath, err := o.workspace.WritePipelineManifest(manifest, o.PipelineName)
 	if err != nil {
 		return "", err
 	}
@@ -284,13 +414,18 @@ func (o *initPipelineOpts) createBuildspec() (string, error) {
 	type cicdBuildspecTemplate struct {
 		BinaryS3BucketPath string
 		Version            string
+		PipelineName       string
 	}
-	if err := tmpl.Execute(&buf, cicdBuildspecTemplate{BinaryS3BucketPath: binaryS3BucketPath, Version: version.Version}); err != nil {
+	if err := tmpl.Execute(&buf, cicdBuildspecTemplate{
+		BinaryS3BucketPath: binaryS3BucketPath,
+		Version:            version.Version,
+		PipelineName:       o.PipelineName,
+	}); err != nil {
 		return "", err
 	}
 
 	// TODO remove binaryBuffer after https://github.com/aws/amazon-ecs-cli-v2/issues/661
-	path, err := o.workspace.WritePipelineBuildspec(binaryBuffer{Buffer: &buf})
+	path, err := o.workspace.WritePipelineBuildspec(binaryBuffer{Buffer: &buf}, o.PipelineName)
 	if err != nil {
 		return "", fmt.Errorf("write buildspec to workspace: %w", err)
 	}
@@ -382,20 +517,98 @@ func relPath(fullPath string) string {
 	return relPath
 }
 
-func (o *initPipelineOpts) selectGitHubURL() error {
+func (o *initPipelineOpts) askGitHubRepo() error {
+	var err error
+	if o.GitHubOwner, o.GitHubRepo, err = o.parseOwnerRepoName(o.RepoURL); err != nil {
+		return err
+	}
+	if o.GitHubAccessToken == "" {
+		return o.getGitHubAccessToken()
+	}
+	return nil
+}
+
+func (o *initPipelineOpts) selectURL() error {
 	url, err := o.prompt.SelectOne(
-		pipelineSelectGitHubURLPrompt,
-		pipelineSelectGitHubURLHelpPrompt,
+		pipelineSelectURLPrompt,
+		pipelineSelectURLHelpPrompt,
 		o.repoURLs,
 	)
 	if err != nil {
-		return fmt.Errorf("select GitHub URL: %w", err)
+		return fmt.Errorf("select repository URL: %w", err)
 	}
-	o.GitHubURL = url
+	o.RepoURL = url
 
 	return nil
 }
 
+func isCodeCommitURL(url string) bool {
+	return strings.HasPrefix(url, codecommitURL+":") || strings.Contains(url, "git-"+codecommitURL+".")
+}
+
+func (o *initPipelineOpts) parseCodeCommitRepoName(url string) (string, error) {
+	source := &deploy.Source{
+		ProviderName: manifest.CodeCommitProviderName,
+		Properties: map[string]interface{}{
+			"repository": url,
+		},
+	}
+	repo, err := source.Repository()
+	if err != nil {
+		return "", fmt.Errorf("unable to parse the CodeCommit repository name from %s: please pass the repository URL with the format `--url https://git-codecommit.{region}.amazonaws.com/v1/repos/{repositoryName}`", url)
+	}
+	return repo, nil
+}
+
+func isBitbucketURL(url string) bool {
+	return strings.Contains(url, bitbucketURL)
+}
+
+func isGitHubURL(url string) bool {
+	return strings.Contains(url, githubURL)
+}
+
+// hasHost returns true if the URL has a host, whether it's an HTTPS or an SSH URL.
+func hasHost(url string) bool {
+	return strings.Contains(url, "://") || strings.Contains(url, "@")
+}
+
+// parseConnectionRepo parses the owner and name of a repository accessed through a CodeStar connection,
+// and infers the type of the connection from the host of the repository.
+func (o *initPipelineOpts) parseConnectionRepo(url string) error {
+	source := &deploy.Source{
+		ProviderName: manifest.ConnectionProviderName,
+		Properties: map[string]interface{}{
+			"repository": url,
+		},
+	}
+	owner, err := source.Owner()
+	if err != nil {
+		return fmt.Errorf("unable to parse the repository owner and name from %s: please pass the repository URL with the format `--url https://{host}/{owner}/{repositoryName}`", url)
+	}
+	repo, err := source.Repository()
+	if err != nil {
+		return err
+	}
+	connectionType := o.ConnectionType
+	switch {
+	case isBitbucketURL(url):
+		connectionType = manifest.BitbucketConnectionType
+	case isGitHubURL(url):
+		connectionType = manifest.GitHubConnectionType
+	case o.ConnectionType != manifest.GitHubEnterpriseConnectionType:
+		// Only GitHub Enterprise Server connections can be used with other hosts, and they have to be requested explicitly.
+		return fmt.Errorf("the repository %s is not hosted on GitHub or Bitbucket: pass `--%s %s` for a GitHub Enterprise Server repository",
+			url, connectionTypeFlag, manifest.GitHubEnterpriseConnectionType)
+	}
+	if o.ConnectionType != "" && o.ConnectionType != connectionType {
+		return fmt.Errorf("the repository %s can't be accessed through a %s connection", url, o.ConnectionType)
+	}
+	o.connectionType = connectionType
+	o.connectionOwner, o.connectionRepo = owner, repo
+	return nil
+}
+
 func (o *initPipelineOpts) parseOwnerRepoName(url string) (string, string, error) {
 	regexPattern := regexp.MustCompile(`.*(github.com)(:|\/)`)
 	parsedURL := strings.TrimPrefix(url, regexPattern.FindString(url))
@@ -411,12 +624,15 @@ func (o *initPipelineOpts) parseOwnerRepoName(url string) (string, string, error
 // efekarakus	https://github.com/karakuse/grit.git (fetch)
 // origin	    https://github.com/koke/grit (fetch)
 // koke       git://github.com/koke/grit.git (push)
+// team	      https://git-codecommit.us-west-2.amazonaws.com/v1/repos/grit (fetch)
+// grc	      codecommit::us-west-2://grit (fetch)
+// bb	      git@bitbucket.org:koke/grit.git (fetch)
 func (o *initPipelineOpts) parseGitRemoteResult(s string) ([]string, error) {
 	var urls []string
 	urlSet := make(map[string]bool)
 	items := strings.Split(s, "\n")
 	for _, item := range items {
-		if !strings.Contains(item, githubURL) {
+		if !strings.Contains(item, githubURL) && !strings.Contains(item, codecommitURL) && !strings.Contains(item, bitbucketURL) {
 			continue
 		}
 		cols := strings.Split(item, "\t")
@@ -480,10 +696,36 @@ func BuildPipelineInitCmd() *cobra.Command {
 		Example: `
   Create a pipeline for the applications in your workspace:
 	/code $ ecs-preview pipeline init \
-	  /code  --github-url https://github.com/gitHubUserName/myFrontendApp.git \
+	  /code  --url https://github.com/gitHubUserName/myFrontendApp.git \
 	  /code  --github-access-token file://myGitHubToken \
 	  /code  --environments "stage,prod" \
-	  /code  --deploy`,
+	  /code  --deploy
+  Create a pipeline triggered by a CodeCommit repository:
+	/code $ ecs-preview pipeline init \
+	  /code  --url https://git-codecommit.us-west-2.amazonaws.com/v1/repos/myFrontendApp \
+	  /code  --environments "stage,prod"
+  Create a second pipeline for the "frontend" application triggered by the "hotfix" branch:
+	/code $ ecs-preview pipeline init \
+	  /code  --name hotfix-frontend \
+	  /code  --url https://github.com/gitHubUserName/myRepo.git \
+	  /code  --git-branch hotfix \
+	  /code  --apps frontend \
+	  /code  --environments "prod"
+  Create a pipeline triggered by a Bitbucket repository through a new connection:
+	/code $ ecs-preview pipeline init \
+	  /code  --url https://bitbucket.org/bitbucketUserName/myFrontendApp \
+	  /code  --environments "stage,prod"
+  Create a pipeline triggered by a GitHub repository through a new connection instead of an access token:
+	/code $ ecs-preview pipeline init \
+	  /code  --url https://github.com/gitHubUserName/myFrontendApp \
+	  /code  --connection-type GitHub \
+	  /code  --environments "stage,prod"
+  Create a pipeline triggered by a GitHub Enterprise Server repository through an existing connection:
+	/code $ ecs-preview pipeline init \
+	  /code  --url https://github.example.com/gitHubUserName/myFrontendApp \
+	  /code  --connection-type GitHubEnterpriseServer \
+	  /code  --connection-arn arn:aws:codestar-connections:us-west-2:123456789012:connection/abcd \
+	  /code  --environments "stage,prod"`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitPipelineOpts(vars)
 			if err != nil {
@@ -506,10 +748,16 @@ func BuildPipelineInitCmd() *cobra.Command {
 			return nil
 		}),
 	}
-	cmd.Flags().StringVarP(&vars.GitHubURL, githubURLFlag, githubURLFlagShort, "", githubURLFlagDescription)
+	cmd.Flags().StringVarP(&vars.PipelineName, nameFlag, nameFlagShort, "", pipelineInitNameFlagDescription)
+	cmd.Flags().StringVarP(&vars.RepoURL, repoURLFlag, repoURLFlagShort, "", repoURLFlagDescription)
+	cmd.Flags().StringVar(&vars.RepoURL, githubURLFlag, "", githubURLFlagDescription)
+	cmd.Flags().MarkDeprecated(githubURLFlag, fmt.Sprintf("use --%s instead", repoURLFlag))
 	cmd.Flags().StringVarP(&vars.GitHubAccessToken, githubAccessTokenFlag, githubAccessTokenFlagShort, "", githubAccessTokenFlagDescription)
+	cmd.Flags().StringVar(&vars.ConnectionARN, connectionARNFlag, "", connectionARNFlagDescription)
+	cmd.Flags().StringVar(&vars.ConnectionType, connectionTypeFlag, "", connectionTypeFlagDescription)
 	cmd.Flags().StringVarP(&vars.GitBranch, gitBranchFlag, gitBranchFlagShort, "", gitBranchFlagDescription)
 	cmd.Flags().StringSliceVarP(&vars.Environments, envsFlag, envsFlagShort, []string{}, pipelineEnvsFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.Apps, appsFlag, []string{}, pipelineAppsFlagDescription)
 
 	return cmd
 }
@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"encoding"
 	"errors"
 	"fmt"
 	"os"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/secretsmanager"
 	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
 	archermocks "github.com/aws/amazon-ecs-cli-v2/mocks"
 	"github.com/gobuffalo/packd"
 	"github.com/golang/mock/gomock"
@@ -25,11 +27,16 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 	githubBadURL := "git@github.com:goodGoose/bhaOS"
 	githubReallyBadURL := "reallybadGoose//notEvenAURL"
 	githubToken := "hunter2"
+	codecommitURL := "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/chaOS"
+	bitbucketURL := "https://bitbucket.org/badGoose/chaOS"
+	gheURL := "https://ghe.example.com/badGoose/chaOS"
 	testCases := map[string]struct {
 		inEnvironments      []string
 		inGitHubOwner       string
 		inGitHubRepo        string
 		inGitHubAccessToken string
+		inConnectionARN     string
+		inConnectionType    string
 		inProjectEnvs       []string
 		inURLs              []string
 
@@ -38,9 +45,130 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 		expectedGitHubOwner       string
 		expectedGitHubRepo        string
 		expectedGitHubAccessToken string
+		expectedCodeCommitRepo    string
+		expectedConnectionType    string
+		expectedConnectionRepo    string
 		expectedEnvironments      []string
 		expectedError             error
 	}{
+		"does not ask for a token with a Bitbucket repository": {
+			inEnvironments: []string{"test"},
+			inProjectEnvs:  []string{"test", "prod"},
+			inURLs:         []string{githubURL, bitbucketURL},
+
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), []string{githubURL, bitbucketURL}).Return(bitbucketURL, nil).Times(1)
+				m.EXPECT().GetSecret(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			expectedConnectionType: "Bitbucket",
+			expectedConnectionRepo: "chaOS",
+			expectedEnvironments:   []string{"test"},
+		},
+		"does not ask for a token with a GitHub repository and a connection": {
+			inEnvironments:  []string{"test"},
+			inConnectionARN: "arn:aws:codestar-connections:us-west-2:1234:connection/abcd",
+			inProjectEnvs:   []string{"test", "prod"},
+			inURLs:          []string{githubURL},
+
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), []string{githubURL}).Return(githubURL, nil).Times(1)
+				m.EXPECT().GetSecret(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			expectedConnectionType: "GitHub",
+			expectedConnectionRepo: "chaOS",
+			expectedEnvironments:   []string{"test"},
+		},
+		"does not ask for a token with a GitHub repository and a connection created by the pipeline": {
+			inEnvironments:   []string{"test"},
+			inConnectionType: "GitHub",
+			inProjectEnvs:    []string{"test", "prod"},
+			inURLs:           []string{githubURL},
+
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), []string{githubURL}).Return(githubURL, nil).Times(1)
+				m.EXPECT().GetSecret(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			expectedConnectionType: "GitHub",
+			expectedConnectionRepo: "chaOS",
+			expectedEnvironments:   []string{"test"},
+		},
+		"returns error if the connection type doesn't match the host of the repository": {
+			inEnvironments:   []string{"test"},
+			inConnectionType: "Bitbucket",
+			inProjectEnvs:    []string{"test", "prod"},
+			inURLs:           []string{githubURL},
+
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), []string{githubURL}).Return(githubURL, nil).Times(1)
+			},
+
+			expectedError: errors.New("the repository https://github.com/badGoose/chaOS can't be accessed through a Bitbucket connection"),
+		},
+		"uses the connection of a GitHub Enterprise Server repository": {
+			inEnvironments:   []string{"test"},
+			inConnectionARN:  "arn:aws:codestar-connections:us-west-2:1234:connection/abcd",
+			inConnectionType: "GitHubEnterpriseServer",
+			inProjectEnvs:    []string{"test", "prod"},
+			inURLs:           []string{gheURL},
+
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), []string{gheURL}).Return(gheURL, nil).Times(1)
+			},
+
+			expectedConnectionType: "GitHubEnterpriseServer",
+			expectedConnectionRepo: "chaOS",
+			expectedEnvironments:   []string{"test"},
+		},
+		"returns error if the connection of a repository on another host isn't for a GitHub Enterprise Server": {
+			inEnvironments:  []string{"test"},
+			inConnectionARN: "arn:aws:codestar-connections:us-west-2:1234:connection/abcd",
+			inProjectEnvs:   []string{"test", "prod"},
+			inURLs:          []string{gheURL},
+
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), []string{gheURL}).Return(gheURL, nil).Times(1)
+			},
+
+			expectedError: errors.New("the repository https://ghe.example.com/badGoose/chaOS is not hosted on GitHub or Bitbucket: pass `--connection-type GitHubEnterpriseServer` for a GitHub Enterprise Server repository"),
+		},
+		"returns error if the repository is on an unsupported host": {
+			inEnvironments: []string{"test"},
+			inProjectEnvs:  []string{"test", "prod"},
+			inURLs:         []string{"https://gitlab.com/badGoose/chaOS"},
+
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), []string{"https://gitlab.com/badGoose/chaOS"}).Return("https://gitlab.com/badGoose/chaOS", nil).Times(1)
+			},
+
+			expectedError: errors.New("the repository https://gitlab.com/badGoose/chaOS is not hosted on GitHub, Bitbucket or CodeCommit: pass `--connection-type GitHubEnterpriseServer` and `--connection-arn` for a GitHub Enterprise Server repository"),
+		},
+		"does not ask for a token with a CodeCommit repository": {
+			inEnvironments: []string{"test"},
+			inProjectEnvs:  []string{"test", "prod"},
+			inURLs:         []string{githubURL, codecommitURL},
+
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), []string{githubURL, codecommitURL}).Return(codecommitURL, nil).Times(1)
+				m.EXPECT().GetSecret(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			expectedCodeCommitRepo: "chaOS",
+			expectedEnvironments:   []string{"test"},
+		},
+		"returns error if fail to parse CodeCommit URL": {
+			inEnvironments: []string{"test"},
+			inProjectEnvs:  []string{"test", "prod"},
+			inURLs:         []string{"codecommit::us-west-2://"},
+
+			mockPrompt: func(m *climocks.Mockprompter) {
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), []string{"codecommit::us-west-2://"}).Return("codecommit::us-west-2://", nil).Times(1)
+			},
+
+			expectedError: fmt.Errorf("unable to parse the CodeCommit repository name from codecommit::us-west-2://: please pass the repository URL with the format `--url https://git-codecommit.{region}.amazonaws.com/v1/repos/{repositoryName}`"),
+		},
 		"prompts for all input": {
 			inEnvironments:      []string{},
 			inGitHubOwner:       "",
@@ -55,7 +183,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				m.EXPECT().SelectOne(pipelineSelectEnvPrompt, gomock.Any(), []string{"test", "prod"}).Return("test", nil).Times(1)
 				m.EXPECT().SelectOne(pipelineSelectEnvPrompt, gomock.Any(), []string{"prod"}).Return("prod", nil).Times(1)
 
-				m.EXPECT().SelectOne(pipelineSelectGitHubURLPrompt, gomock.Any(), []string{githubURL, githubBadURL}).Return(githubURL, nil).Times(1)
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), []string{githubURL, githubBadURL}).Return(githubURL, nil).Times(1)
 				m.EXPECT().GetSecret(gomock.Eq("Please enter your GitHub Personal Access Token for your repository: chaOS"), gomock.Any()).Return(githubToken, nil).Times(1)
 			},
 
@@ -113,14 +241,14 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				m.EXPECT().SelectOne(pipelineSelectEnvPrompt, gomock.Any(), []string{"test", "prod"}).Return("test", nil).Times(1)
 				m.EXPECT().SelectOne(pipelineSelectEnvPrompt, gomock.Any(), []string{"prod"}).Return("prod", nil).Times(1)
 
-				m.EXPECT().SelectOne(pipelineSelectGitHubURLPrompt, gomock.Any(), []string{githubURL, githubBadURL}).Return("", errors.New("some error")).Times(1)
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), []string{githubURL, githubBadURL}).Return("", errors.New("some error")).Times(1)
 			},
 
 			expectedGitHubOwner:       "",
 			expectedGitHubRepo:        "",
 			expectedGitHubAccessToken: "",
 			expectedEnvironments:      []string{},
-			expectedError:             fmt.Errorf("select GitHub URL: some error"),
+			expectedError:             fmt.Errorf("select repository URL: some error"),
 		},
 		"returns error if fail to parse GitHub URL": {
 			inEnvironments:      []string{},
@@ -135,7 +263,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				m.EXPECT().SelectOne(pipelineSelectEnvPrompt, gomock.Any(), []string{"test", "prod"}).Return("test", nil).Times(1)
 				m.EXPECT().SelectOne(pipelineSelectEnvPrompt, gomock.Any(), []string{"prod"}).Return("prod", nil).Times(1)
 
-				m.EXPECT().SelectOne(pipelineSelectGitHubURLPrompt, gomock.Any(), []string{githubReallyBadURL}).Return(githubReallyBadURL, nil).Times(1)
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), []string{githubReallyBadURL}).Return(githubReallyBadURL, nil).Times(1)
 			},
 
 			expectedGitHubOwner:       "",
@@ -157,7 +285,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				m.EXPECT().SelectOne(pipelineSelectEnvPrompt, gomock.Any(), []string{"test", "prod"}).Return("test", nil).Times(1)
 				m.EXPECT().SelectOne(pipelineSelectEnvPrompt, gomock.Any(), []string{"prod"}).Return("prod", nil).Times(1)
 
-				m.EXPECT().SelectOne(pipelineSelectGitHubURLPrompt, gomock.Any(), []string{githubURL, githubBadURL}).Return(githubURL, nil).Times(1)
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), []string{githubURL, githubBadURL}).Return(githubURL, nil).Times(1)
 				m.EXPECT().GetSecret(gomock.Eq("Please enter your GitHub Personal Access Token for your repository: chaOS"), gomock.Any()).Return("", errors.New("some error")).Times(1)
 			},
 
@@ -183,6 +311,8 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 					GitHubOwner:       tc.inGitHubOwner,
 					GitHubRepo:        tc.inGitHubRepo,
 					GitHubAccessToken: tc.inGitHubAccessToken,
+					ConnectionARN:     tc.inConnectionARN,
+					ConnectionType:    tc.inConnectionType,
 					GlobalOpts: &GlobalOpts{
 						prompt: mockPrompt,
 					},
@@ -205,6 +335,9 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				require.Equal(t, tc.expectedGitHubOwner, opts.GitHubOwner)
 				require.Equal(t, tc.expectedGitHubRepo, opts.GitHubRepo)
 				require.Equal(t, tc.expectedGitHubAccessToken, opts.GitHubAccessToken)
+				require.Equal(t, tc.expectedCodeCommitRepo, opts.CodeCommitRepo)
+				require.Equal(t, tc.expectedConnectionType, opts.connectionType)
+				require.Equal(t, tc.expectedConnectionRepo, opts.connectionRepo)
 				require.ElementsMatch(t, tc.expectedEnvironments, opts.Environments)
 			}
 		})
@@ -213,15 +346,78 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 
 func TestInitPipelineOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inProjectEnvs []string
-		inProjectName string
+		inProjectEnvs    []string
+		inProjectName    string
+		inPipelineName   string
+		inConnectionARN  string
+		inConnectionType string
+
+		mockWsWriter func(m *climocks.MockwsPipelineWriter)
 
 		expectedError error
 	}{
 		"invalid project name": {
 			inProjectName: "",
+			mockWsWriter:  func(m *climocks.MockwsPipelineWriter) {},
 			expectedError: errNoProjectInWorkspace,
 		},
+		"invalid pipeline name": {
+			inProjectName:  "badgoose",
+			inPipelineName: "../pipeline",
+			mockWsWriter:   func(m *climocks.MockwsPipelineWriter) {},
+			expectedError:  fmt.Errorf("pipeline name ../pipeline is invalid: %w", errValueBadFormat),
+		},
+		"pipeline name of an environment": {
+			inProjectEnvs:  []string{"test", "prod"},
+			inProjectName:  "badgoose",
+			inPipelineName: "prod",
+			mockWsWriter:   func(m *climocks.MockwsPipelineWriter) {},
+			expectedError:  errors.New("pipeline name prod is already used by an environment of project badgoose"),
+		},
+		"fails to list the pipelines of the workspace": {
+			inProjectEnvs:  []string{"test", "prod"},
+			inProjectName:  "badgoose",
+			inPipelineName: "pipeline-badgoose",
+			mockWsWriter: func(m *climocks.MockwsPipelineWriter) {
+				m.EXPECT().PipelineNames().Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("list pipelines in workspace: some error"),
+		},
+		"pipeline name of another pipeline": {
+			inProjectEnvs:  []string{"test", "prod"},
+			inProjectName:  "badgoose",
+			inPipelineName: "pipeline-badgoose",
+			mockWsWriter: func(m *climocks.MockwsPipelineWriter) {
+				m.EXPECT().PipelineNames().Return([]string{"pipeline-badgoose"}, nil)
+			},
+			expectedError: errors.New("pipeline pipeline-badgoose already exists in your workspace"),
+		},
+		"invalid connection type": {
+			inProjectName:    "badgoose",
+			inConnectionType: "GitLab",
+			mockWsWriter:     func(m *climocks.MockwsPipelineWriter) {},
+			expectedError:    errors.New("invalid connection type GitLab: must be one of Bitbucket, GitHub, GitHubEnterpriseServer"),
+		},
+		"GitHub Enterprise Server connection without a connection ARN": {
+			inProjectName:    "badgoose",
+			inConnectionType: "GitHubEnterpriseServer",
+			mockWsWriter:     func(m *climocks.MockwsPipelineWriter) {},
+			expectedError:    errors.New("a connection is required for a GitHub Enterprise Server repository: please create one in the AWS console and pass its ARN with `--connection-arn`"),
+		},
+		"valid connection type": {
+			inProjectName:    "badgoose",
+			inConnectionARN:  "arn:aws:codestar-connections:us-west-2:1234:connection/abcd",
+			inConnectionType: "GitHubEnterpriseServer",
+			mockWsWriter:     func(m *climocks.MockwsPipelineWriter) {},
+		},
+		"valid pipeline name": {
+			inProjectEnvs:  []string{"test", "prod"},
+			inProjectName:  "badgoose",
+			inPipelineName: "pipeline-badgoose-prod",
+			mockWsWriter: func(m *climocks.MockwsPipelineWriter) {
+				m.EXPECT().PipelineNames().Return([]string{"pipeline-badgoose"}, nil)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -229,11 +425,17 @@ func TestInitPipelineOpts_Validate(t *testing.T) {
 			// GIVEN
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
+			mockWsWriter := climocks.NewMockwsPipelineWriter(ctrl)
+			tc.mockWsWriter(mockWsWriter)
 
 			opts := &initPipelineOpts{
 				initPipelineVars: initPipelineVars{
-					GlobalOpts: &GlobalOpts{projectName: tc.inProjectName},
+					GlobalOpts:     &GlobalOpts{projectName: tc.inProjectName},
+					PipelineName:   tc.inPipelineName,
+					ConnectionARN:  tc.inConnectionARN,
+					ConnectionType: tc.inConnectionType,
 				},
+				workspace:   mockWsWriter,
 				projectEnvs: tc.inProjectEnvs,
 			}
 
@@ -242,7 +444,7 @@ func TestInitPipelineOpts_Validate(t *testing.T) {
 
 			// THEN
 			if tc.expectedError != nil {
-				require.Equal(t, tc.expectedError, err)
+				require.EqualError(t, err, tc.expectedError.Error())
 			} else {
 				require.Nil(t, err)
 			}
@@ -255,8 +457,12 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 		inEnvironments []string
 		inGitHubToken  string
 		inGitHubRepo   string
+		inCodeCommit   string
+		inConnection   string
 		inGitBranch    string
 		inProjectName  string
+		inPipelineName string
+		inApps         []string
 
 		mockSecretsManager func(m *archermocks.MockSecretsManager)
 		mockWsWriter       func(m *climocks.MockwsPipelineWriter)
@@ -279,8 +485,8 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
 			mockWsWriter: func(m *climocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("buildspec.yml", nil)
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "pipeline-badgoose--goose").Return("pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "pipeline-badgoose--goose").Return("buildspec.yml", nil)
 			},
 			mockBox: func(m *packd.MemoryBox) {
 				m.AddString(buildspecTemplatePath, "hello")
@@ -290,6 +496,72 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			expectedBuildspecPath: "buildspec.yml",
 			expectedError:         nil,
 		},
+		"writes the manifest and buildspec of a named pipeline": {
+			inEnvironments: []string{"prod"},
+			inCodeCommit:   "goose",
+			inGitBranch:    "hotfix",
+			inProjectName:  "badgoose",
+			inPipelineName: "hotfix",
+			inApps:         []string{"frontend"},
+
+			mockSecretsManager: func(m *archermocks.MockSecretsManager) {},
+			mockWsWriter: func(m *climocks.MockwsPipelineWriter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "hotfix").Do(func(marshaler encoding.BinaryMarshaler, _ string) {
+					mft, ok := marshaler.(*manifest.PipelineManifest)
+					require.True(t, ok)
+					require.Equal(t, "hotfix", mft.Name)
+					require.Equal(t, []string{"frontend"}, mft.Apps)
+				}).Return("pipelines/hotfix/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "hotfix").Do(func(marshaler encoding.BinaryMarshaler, _ string) {
+					data, err := marshaler.MarshalBinary()
+					require.NoError(t, err)
+					require.Equal(t, "--pipeline hotfix", string(data))
+				}).Return("pipelines/hotfix/buildspec.yml", nil)
+			},
+			mockBox: func(m *packd.MemoryBox) {
+				m.AddString(buildspecTemplatePath, "--pipeline {{.PipelineName}}")
+			},
+			expectManifestPath:    "pipelines/hotfix/pipeline.yml",
+			expectedBuildspecPath: "pipelines/hotfix/buildspec.yml",
+		},
+		"does not create a secret for a repository accessed through a connection": {
+			inEnvironments: []string{"test"},
+			inConnection:   "Bitbucket",
+			inGitBranch:    "dev",
+			inProjectName:  "badgoose",
+
+			mockSecretsManager: func(m *archermocks.MockSecretsManager) {
+				m.EXPECT().CreateSecret(gomock.Any(), gomock.Any()).Times(0)
+			},
+			mockWsWriter: func(m *climocks.MockwsPipelineWriter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "pipeline-badgoose--").Return("pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "pipeline-badgoose--").Return("buildspec.yml", nil)
+			},
+			mockBox: func(m *packd.MemoryBox) {
+				m.AddString(buildspecTemplatePath, "hello")
+			},
+			expectManifestPath:    "pipeline.yml",
+			expectedBuildspecPath: "buildspec.yml",
+		},
+		"does not create a secret for a CodeCommit repository": {
+			inEnvironments: []string{"test"},
+			inCodeCommit:   "goose",
+			inGitBranch:    "dev",
+			inProjectName:  "badgoose",
+
+			mockSecretsManager: func(m *archermocks.MockSecretsManager) {
+				m.EXPECT().CreateSecret(gomock.Any(), gomock.Any()).Times(0)
+			},
+			mockWsWriter: func(m *climocks.MockwsPipelineWriter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "pipeline-badgoose-goose").Return("pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "pipeline-badgoose-goose").Return("buildspec.yml", nil)
+			},
+			mockBox: func(m *packd.MemoryBox) {
+				m.AddString(buildspecTemplatePath, "hello")
+			},
+			expectManifestPath:    "pipeline.yml",
+			expectedBuildspecPath: "buildspec.yml",
+		},
 		"does not return an error if secret already exists": {
 			inEnvironments: []string{"test"},
 			inGitHubToken:  "hunter2",
@@ -302,8 +574,8 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("", existsErr)
 			},
 			mockWsWriter: func(m *climocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("buildspec.yml", nil)
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "pipeline-badgoose--goose").Return("pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "pipeline-badgoose--goose").Return("buildspec.yml", nil)
 			},
 			mockBox: func(m *packd.MemoryBox) {
 				m.AddString(buildspecTemplatePath, "hello")
@@ -324,8 +596,8 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
 			mockWsWriter: func(m *climocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Times(0)
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "pipeline-badgoose--goose").Return("pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "pipeline-badgoose--goose").Times(0)
 			},
 			mockBox: func(m *packd.MemoryBox) {
 			},
@@ -342,8 +614,8 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
 			mockWsWriter: func(m *climocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("", errors.New("some error"))
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "pipeline-badgoose--goose").Return("pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "pipeline-badgoose--goose").Return("", errors.New("some error"))
 			},
 			mockBox: func(m *packd.MemoryBox) {
 				m.AddString(buildspecTemplatePath, "hello")
@@ -369,9 +641,12 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 
 			opts := &initPipelineOpts{
 				initPipelineVars: initPipelineVars{
+					PipelineName:      tc.inPipelineName,
+					Apps:              tc.inApps,
 					Environments:      tc.inEnvironments,
 					GitHubRepo:        tc.inGitHubRepo,
 					GitHubAccessToken: tc.inGitHubToken,
+					CodeCommitRepo:    tc.inCodeCommit,
 					GitBranch:         tc.inGitBranch,
 					GlobalOpts:        &GlobalOpts{projectName: tc.inProjectName},
 				},
@@ -380,6 +655,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				workspace:      mockWriter,
 				box:            mockBox,
 				fsUtils:        memFs,
+				connectionType: tc.inConnection,
 			}
 
 			// WHEN
@@ -434,9 +710,11 @@ func TestInitPipelineOpts_createSecretName(t *testing.T) {
 
 func TestInitPipelineOpts_createPipelineName(t *testing.T) {
 	testCases := map[string]struct {
-		inGitHubRepo   string
-		inProjectName  string
-		inProjectOwner string
+		inGitHubRepo     string
+		inCodeCommitRepo string
+		inConnectionRepo string
+		inProjectName    string
+		inProjectOwner   string
 
 		expected string
 	}{
@@ -445,6 +723,19 @@ func TestInitPipelineOpts_createPipelineName(t *testing.T) {
 			inProjectName:  "badgoose",
 			inProjectOwner: "david",
 
+			expected: "pipeline-badgoose-david-goose",
+		},
+		"matches CodeCommit repo name": {
+			inCodeCommitRepo: "goose",
+			inProjectName:    "badgoose",
+
+			expected: "pipeline-badgoose-goose",
+		},
+		"matches connection repo name": {
+			inConnectionRepo: "goose",
+			inProjectName:    "badgoose",
+			inProjectOwner:   "david",
+
 			expected: "pipeline-badgoose-david-goose",
 		},
 	}
@@ -454,10 +745,13 @@ func TestInitPipelineOpts_createPipelineName(t *testing.T) {
 			// GIVEN
 			opts := &initPipelineOpts{
 				initPipelineVars: initPipelineVars{
-					GitHubRepo:  tc.inGitHubRepo,
-					GlobalOpts:  &GlobalOpts{projectName: tc.inProjectName},
-					GitHubOwner: tc.inProjectOwner,
+					GitHubRepo:     tc.inGitHubRepo,
+					CodeCommitRepo: tc.inCodeCommitRepo,
+					GlobalOpts:     &GlobalOpts{projectName: tc.inProjectName},
+					GitHubOwner:    tc.inProjectOwner,
 				},
+				connectionOwner: tc.inProjectOwner,
+				connectionRepo:  tc.inConnectionRepo,
 			}
 
 			// WHEN
@@ -485,6 +779,19 @@ koke	git://github.com/koke/grit.git (push)`,
 			expectedURLs:  []string{"git@github.com:badgoose/grit", "https://github.com/badgoose/cli", "https://github.com/koke/grit", "git://github.com/koke/grit"},
 			expectedError: nil,
 		},
+		"matched CodeCommit format": {
+			inRemoteResult: `team	https://git-codecommit.us-west-2.amazonaws.com/v1/repos/grit (fetch)
+team	https://git-codecommit.us-west-2.amazonaws.com/v1/repos/grit (push)
+grc	codecommit::us-west-2://grit (fetch)`,
+
+			expectedURLs: []string{"https://git-codecommit.us-west-2.amazonaws.com/v1/repos/grit", "codecommit::us-west-2://grit"},
+		},
+		"matched Bitbucket format": {
+			inRemoteResult: `bb	git@bitbucket.org:koke/grit.git (fetch)
+bb	git@bitbucket.org:koke/grit.git (push)`,
+
+			expectedURLs: []string{"git@bitbucket.org:koke/grit"},
+		},
 		"don't add to URL list if it is not a github URL": {
 			inRemoteResult: `badgoose	verybad@gitlab.com/whatever (fetch)`,
 
@@ -0,0 +1,188 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/session"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/spf13/cobra"
+)
+
+// Prefix of the pipeline names generated by "pipeline init".
+const pipelineNamePrefix = "pipeline-"
+
+type listPipelineVars struct {
+	*GlobalOpts
+	ShouldOutputJSON bool
+}
+
+type listPipelineOpts struct {
+	listPipelineVars
+
+	rgClient resourceGetter
+	w        io.Writer
+}
+
+func newListPipelineOpts(vars listPipelineVars) (*listPipelineOpts, error) {
+	sess, err := session.NewProvider().Default()
+	if err != nil {
+		return nil, err
+	}
+
+	return &listPipelineOpts{
+		listPipelineVars: vars,
+		rgClient:         resourcegroupstaggingapi.New(sess),
+		w:                log.OutputWriter,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *listPipelineOpts) Validate() error {
+	if o.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	return nil
+}
+
+// Execute lists the pipelines deployed in the project.
+func (o *listPipelineOpts) Execute() error {
+	names, err := o.pipelineNames()
+	if err != nil {
+		return err
+	}
+
+	var out string
+	if o.ShouldOutputJSON {
+		data, err := o.jsonOutput(names)
+		if err != nil {
+			return err
+		}
+		out = data
+	} else {
+		out = o.humanOutput(names)
+	}
+	fmt.Fprintf(o.w, out)
+
+	return nil
+}
+
+// pipelineNames returns the names of the pipelines whose stacks are tagged with the project sorted by name.
+func (o *listPipelineOpts) pipelineNames() ([]string, error) {
+	var names []string
+	var token *string
+	for {
+		out, err := o.rgClient.GetResources(&resourcegroupstaggingapi.GetResourcesInput{
+			PaginationToken:     token,
+			ResourceTypeFilters: []*string{aws.String("cloudformation")},
+			TagFilters: []*resourcegroupstaggingapi.TagFilter{
+				{
+					Key:    aws.String(stack.ProjectTagKey),
+					Values: []*string{aws.String(o.ProjectName())},
+				},
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("find pipeline stacks in project %s: %w", o.ProjectName(), err)
+		}
+		for _, mapping := range out.ResourceTagMappingList {
+			if name, ok := o.pipelineName(mapping); ok {
+				names = append(names, name)
+			}
+		}
+		token = out.PaginationToken
+		if aws.StringValue(token) == "" {
+			break
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// pipelineName returns the name of the pipeline deployed by a stack of the project, or false if the stack isn't a pipeline.
+// Pipeline stacks deployed before they were tagged with their pipeline name are recognized by their stack name,
+// which is the project name followed by the name of the pipeline.
+func (o *listPipelineOpts) pipelineName(mapping *resourcegroupstaggingapi.ResourceTagMapping) (string, bool) {
+	tags := make(map[string]string)
+	for _, t := range mapping.Tags {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	if name, ok := tags[stack.PipelineTagKey]; ok {
+		return name, true
+	}
+	if _, ok := tags[stack.EnvTagKey]; ok {
+		return "", false
+	}
+	if _, ok := tags[stack.AppTagKey]; ok {
+		return "", false
+	}
+	stackARN, err := arn.Parse(aws.StringValue(mapping.ResourceARN))
+	if err != nil {
+		return "", false
+	}
+	// The resource of a stack ARN is "stack/<stack name>/<stack ID>".
+	parts := strings.Split(stackARN.Resource, "/")
+	if len(parts) < 2 {
+		return "", false
+	}
+	projectPrefix := o.ProjectName() + "-"
+	if !strings.HasPrefix(parts[1], projectPrefix+pipelineNamePrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(parts[1], projectPrefix), true
+}
+
+func (o *listPipelineOpts) humanOutput(names []string) string {
+	b := &strings.Builder{}
+	for _, name := range names {
+		fmt.Fprintln(b, name)
+	}
+	return b.String()
+}
+
+func (o *listPipelineOpts) jsonOutput(names []string) (string, error) {
+	type serializedPipelines struct {
+		Pipelines []string `json:"pipelines"`
+	}
+	b, err := json.Marshal(serializedPipelines{Pipelines: names})
+	if err != nil {
+		return "", fmt.Errorf("marshal pipelines: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// BuildPipelineListCmd builds the command for listing the pipelines of a project.
+func BuildPipelineListCmd() *cobra.Command {
+	vars := listPipelineVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists all the deployed pipelines in a project.",
+		Example: `
+  Lists all the pipelines for the project in your workspace
+  /code $ ecs-preview pipeline ls`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newListPipelineOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().BoolVar(&vars.ShouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
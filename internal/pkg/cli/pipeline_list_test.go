@@ -0,0 +1,161 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineList_Execute(t *testing.T) {
+	mockPipelineStacks := func(m *climocks.MockresourceGetter) {
+		gomock.InOrder(
+			m.EXPECT().GetResources(&resourcegroupstaggingapi.GetResourcesInput{
+				ResourceTypeFilters: []*string{aws.String("cloudformation")},
+				TagFilters: []*resourcegroupstaggingapi.TagFilter{
+					{
+						Key:    aws.String(stack.ProjectTagKey),
+						Values: []*string{aws.String("phonetool")},
+					},
+				},
+			}).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+				PaginationToken: aws.String("next"),
+				ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
+					{
+						Tags: []*resourcegroupstaggingapi.Tag{
+							{
+								Key:   aws.String(stack.ProjectTagKey),
+								Value: aws.String("phonetool"),
+							},
+							{
+								Key:   aws.String(stack.PipelineTagKey),
+								Value: aws.String("pipeline-phonetool-frontend"),
+							},
+						},
+					},
+				},
+			}, nil),
+			m.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+				ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
+					{
+						Tags: []*resourcegroupstaggingapi.Tag{
+							{
+								Key:   aws.String(stack.PipelineTagKey),
+								Value: aws.String("pipeline-phonetool-backend"),
+							},
+						},
+					},
+					{
+						// Pipeline stack deployed before pipeline stacks were tagged with their name.
+						ResourceARN: aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-pipeline-phonetool-api/8a0e9f50-0000-11ea-0000-0a7f4bd7d5a8"),
+						Tags: []*resourcegroupstaggingapi.Tag{
+							{
+								Key:   aws.String(stack.ProjectTagKey),
+								Value: aws.String("phonetool"),
+							},
+						},
+					},
+					{
+						ResourceARN: aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test/8a0e9f50-0000-11ea-0000-0a7f4bd7d5a8"),
+						Tags: []*resourcegroupstaggingapi.Tag{
+							{
+								Key:   aws.String(stack.ProjectTagKey),
+								Value: aws.String("phonetool"),
+							},
+							{
+								Key:   aws.String(stack.EnvTagKey),
+								Value: aws.String("test"),
+							},
+						},
+					},
+					{
+						ResourceARN: aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-pipeline-web/8a0e9f50-0000-11ea-0000-0a7f4bd7d5a8"),
+						Tags: []*resourcegroupstaggingapi.Tag{
+							{
+								Key:   aws.String(stack.ProjectTagKey),
+								Value: aws.String("phonetool"),
+							},
+							{
+								Key:   aws.String(stack.EnvTagKey),
+								Value: aws.String("test"),
+							},
+							{
+								Key:   aws.String(stack.AppTagKey),
+								Value: aws.String("pipeline-web"),
+							},
+						},
+					},
+				},
+			}, nil),
+		)
+	}
+
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		mockRG           func(m *climocks.MockresourceGetter)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"returns wrapped error if fail to find the pipeline stacks": {
+			mockRG: func(m *climocks.MockresourceGetter) {
+				m.EXPECT().GetResources(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("find pipeline stacks in project phonetool: some error"),
+		},
+		"lists the pipelines sorted by name": {
+			mockRG: mockPipelineStacks,
+
+			wantedContent: "pipeline-phonetool-api\npipeline-phonetool-backend\npipeline-phonetool-frontend\n",
+		},
+		"lists the pipelines in JSON": {
+			shouldOutputJSON: true,
+			mockRG:           mockPipelineStacks,
+
+			wantedContent: "{\"pipelines\":[\"pipeline-phonetool-api\",\"pipeline-phonetool-backend\",\"pipeline-phonetool-frontend\"]}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			b := &bytes.Buffer{}
+			mockRG := climocks.NewMockresourceGetter(ctrl)
+			tc.mockRG(mockRG)
+
+			opts := &listPipelineOpts{
+				listPipelineVars: listPipelineVars{
+					GlobalOpts: &GlobalOpts{
+						projectName: "phonetool",
+					},
+					ShouldOutputJSON: tc.shouldOutputJSON,
+				},
+				rgClient: mockRG,
+				w:        b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+type showPipelineVars struct {
+	*GlobalOpts
+	pipelineName     string
+	shouldOutputJSON bool
+}
+
+type showPipelineOpts struct {
+	showPipelineVars
+
+	w             io.Writer
+	ws            wsPipelineManifestReader
+	describer     pipelineDescriber
+	initDescriber func(*showPipelineOpts) error // Overriden in tests.
+}
+
+func newShowPipelineOpts(vars showPipelineVars) (*showPipelineOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+
+	return &showPipelineOpts{
+		showPipelineVars: vars,
+		w:                log.OutputWriter,
+		ws:               ws,
+		initDescriber: func(o *showPipelineOpts) error {
+			d, err := describe.NewPipelineDescriber(deployedPipelineName(o.ProjectName(), o.pipelineName))
+			if err != nil {
+				return fmt.Errorf("creating describer for pipeline %s in project %s: %w", o.pipelineName, o.ProjectName(), err)
+			}
+			o.describer = d
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *showPipelineOpts) Validate() error {
+	if o.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	return nil
+}
+
+// Ask reads the name of the pipeline from the workspace if it's not passed in.
+func (o *showPipelineOpts) Ask() error {
+	if o.pipelineName != "" {
+		return nil
+	}
+	pipeline, err := readPipelineManifest(o.ws, "")
+	if err != nil {
+		return err
+	}
+	o.pipelineName = pipeline.Name
+	return nil
+}
+
+// Execute shows the source, stages, environments, artifact buckets and notification targets of the pipeline.
+func (o *showPipelineOpts) Execute() error {
+	if err := o.initDescriber(o); err != nil {
+		return err
+	}
+	pipeline, err := o.describer.Describe()
+	if err != nil {
+		return fmt.Errorf("describe pipeline %s: %w", o.pipelineName, err)
+	}
+
+	if o.shouldOutputJSON {
+		data, err := pipeline.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(o.w, data)
+	} else {
+		fmt.Fprintf(o.w, pipeline.HumanString())
+	}
+	return nil
+}
+
+// BuildPipelineShowCmd builds the command for showing the configuration of a deployed pipeline.
+func BuildPipelineShowCmd() *cobra.Command {
+	vars := showPipelineVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Shows info about a deployed pipeline.",
+		Long:  "Shows info about a deployed pipeline, including its source, stages, environments, artifact buckets and notification targets.",
+
+		Example: `
+  Shows info about the pipeline in your workspace
+  /code $ ecs-preview pipeline show
+
+  Shows info about the pipeline "pipeline-phonetool" in JSON
+  /code $ ecs-preview pipeline show -n pipeline-phonetool --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newShowPipelineOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.pipelineName, nameFlag, nameFlagShort, "", pipelineNameFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,97 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineShow_Execute(t *testing.T) {
+	mockPipeline := &describe.Pipeline{
+		Name:   "phonetool-pipeline-phonetool",
+		Region: "us-west-2",
+		Source: &describe.PipelineSource{
+			Provider:   "GitHub",
+			Repository: "badgoose/phonetool",
+			Branch:     "master",
+		},
+		Environments: []string{"test"},
+	}
+	mockJSON, _ := mockPipeline.JSONString()
+
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		mockDescriber    func(m *climocks.MockpipelineDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"returns wrapped error if fail to describe the pipeline": {
+			mockDescriber: func(m *climocks.MockpipelineDescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe pipeline pipeline-phonetool: some error"),
+		},
+		"shows the pipeline": {
+			mockDescriber: func(m *climocks.MockpipelineDescriber) {
+				m.EXPECT().Describe().Return(mockPipeline, nil)
+			},
+
+			wantedContent: mockPipeline.HumanString(),
+		},
+		"shows the pipeline in JSON": {
+			shouldOutputJSON: true,
+			mockDescriber: func(m *climocks.MockpipelineDescriber) {
+				m.EXPECT().Describe().Return(mockPipeline, nil)
+			},
+
+			wantedContent: mockJSON,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			b := &bytes.Buffer{}
+			mockDescriber := climocks.NewMockpipelineDescriber(ctrl)
+			tc.mockDescriber(mockDescriber)
+
+			opts := &showPipelineOpts{
+				showPipelineVars: showPipelineVars{
+					GlobalOpts: &GlobalOpts{
+						projectName: "phonetool",
+					},
+					pipelineName:     "pipeline-phonetool",
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				w: b,
+				initDescriber: func(o *showPipelineOpts) error {
+					o.describer = mockDescriber
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const pipelineStatusRefreshInterval = 5 * time.Second
+
+type statusPipelineVars struct {
+	*GlobalOpts
+	pipelineName     string
+	shouldOutputJSON bool
+	follow           bool
+}
+
+type statusPipelineOpts struct {
+	statusPipelineVars
+
+	w               io.Writer
+	ws              wsPipelineManifestReader
+	describer       pipelineStatusDescriber
+	initDescriber   func(*statusPipelineOpts) error // Overriden in tests.
+	refreshInterval time.Duration
+}
+
+func newStatusPipelineOpts(vars statusPipelineVars) (*statusPipelineOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+
+	return &statusPipelineOpts{
+		statusPipelineVars: vars,
+		w:                  log.OutputWriter,
+		ws:                 ws,
+		initDescriber: func(o *statusPipelineOpts) error {
+			d, err := describe.NewPipelineStatusDescriber(deployedPipelineName(o.ProjectName(), o.pipelineName))
+			if err != nil {
+				return fmt.Errorf("creating status describer for pipeline %s in project %s: %w", o.pipelineName, o.ProjectName(), err)
+			}
+			o.describer = d
+			return nil
+		},
+		refreshInterval: pipelineStatusRefreshInterval,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *statusPipelineOpts) Validate() error {
+	if o.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	return nil
+}
+
+// Ask reads the name of the pipeline from the workspace if it's not passed in.
+func (o *statusPipelineOpts) Ask() error {
+	if o.pipelineName != "" {
+		return nil
+	}
+	pipeline, err := readPipelineManifest(o.ws, "")
+	if err != nil {
+		return err
+	}
+	o.pipelineName = pipeline.Name
+	return nil
+}
+
+// Execute shows the status of each stage and action of the pipeline.
+// If following, the status is shown again until the latest execution of the pipeline finishes.
+func (o *statusPipelineOpts) Execute() error {
+	if err := o.initDescriber(o); err != nil {
+		return err
+	}
+	var prevOutput string
+	for {
+		status, err := o.describer.Describe()
+		if err != nil {
+			return fmt.Errorf("describe status of pipeline %s: %w", o.pipelineName, err)
+		}
+		output, err := o.formatStatus(status)
+		if err != nil {
+			return err
+		}
+		// Only show the status again when it changed since the last refresh.
+		if output != prevOutput {
+			fmt.Fprint(o.w, output)
+			prevOutput = output
+		}
+		if !o.follow || !status.InProgress() {
+			return nil
+		}
+		time.Sleep(o.refreshInterval)
+	}
+}
+
+func (o *statusPipelineOpts) formatStatus(status *describe.PipelineStatus) (string, error) {
+	if o.shouldOutputJSON {
+		return status.JSONString()
+	}
+	return status.HumanString(), nil
+}
+
+// BuildPipelineStatusCmd builds the command for showing the status of a deployed pipeline.
+func BuildPipelineStatusCmd() *cobra.Command {
+	vars := statusPipelineVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Shows the status of a deployed pipeline.",
+		Long:  "Shows the status of each stage and action of a deployed pipeline, along with the revision and commit message that each stage is on.",
+
+		Example: `
+  Shows the status of the pipeline in your workspace
+  /code $ ecs-preview pipeline status
+
+  Refreshes the status of the pipeline "pipeline-phonetool" until its execution finishes
+  /code $ ecs-preview pipeline status -n pipeline-phonetool --follow`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newStatusPipelineOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.pipelineName, nameFlag, nameFlagShort, "", pipelineNameFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.follow, followFlag, false, pipelineFollowFlagDescription)
+	return cmd
+}
@@ -0,0 +1,204 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineStatus_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inPipelineName string
+		mockWorkspace  func(m *climocks.MockwsPipelineManifestReader)
+
+		wantedPipelineName string
+		wantedError        error
+	}{
+		"skips reading the manifest if the name is passed in": {
+			inPipelineName: "pipeline-phonetool",
+			mockWorkspace:  func(m *climocks.MockwsPipelineManifestReader) {},
+
+			wantedPipelineName: "pipeline-phonetool",
+		},
+		"returns error if fail to read the pipeline manifest": {
+			mockWorkspace: func(m *climocks.MockwsPipelineManifestReader) {
+				m.EXPECT().PipelineNames().Return(nil, nil)
+				m.EXPECT().ReadPipelineManifest("").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("read pipeline manifest: some error"),
+		},
+		"reads the name from the pipeline manifest": {
+			mockWorkspace: func(m *climocks.MockwsPipelineManifestReader) {
+				m.EXPECT().PipelineNames().Return(nil, nil)
+				m.EXPECT().ReadPipelineManifest("").Return([]byte(`name: pipeline-phonetool
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: badgoose/phonetool
+    branch: master
+
+stages:
+  - name: test
+`), nil)
+			},
+
+			wantedPipelineName: "pipeline-phonetool",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWorkspace := climocks.NewMockwsPipelineManifestReader(ctrl)
+			tc.mockWorkspace(mockWorkspace)
+
+			opts := &statusPipelineOpts{
+				statusPipelineVars: statusPipelineVars{
+					GlobalOpts: &GlobalOpts{
+						projectName: "phonetool",
+					},
+					pipelineName: tc.inPipelineName,
+				},
+				ws: mockWorkspace,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedPipelineName, opts.pipelineName)
+			}
+		})
+	}
+}
+
+func TestPipelineStatus_Execute(t *testing.T) {
+	inProgressStatus := &describe.PipelineStatus{
+		Name: "phonetool-pipeline-phonetool",
+		LatestExecution: &describe.PipelineExecution{
+			ID:     "exec-1",
+			Status: "InProgress",
+		},
+	}
+	succeededStatus := &describe.PipelineStatus{
+		Name: "phonetool-pipeline-phonetool",
+		LatestExecution: &describe.PipelineExecution{
+			ID:     "exec-1",
+			Status: "Succeeded",
+		},
+	}
+	inProgressJSON, _ := inProgressStatus.JSONString()
+	succeededJSON, _ := succeededStatus.JSONString()
+
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		follow           bool
+		mockDescriber    func(m *climocks.MockpipelineStatusDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"returns wrapped error if fail to describe the pipeline": {
+			mockDescriber: func(m *climocks.MockpipelineStatusDescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe status of pipeline pipeline-phonetool: some error"),
+		},
+		"shows the status once if not following": {
+			mockDescriber: func(m *climocks.MockpipelineStatusDescriber) {
+				m.EXPECT().Describe().Return(inProgressStatus, nil)
+			},
+
+			wantedContent: inProgressStatus.HumanString(),
+		},
+		"shows the status in JSON": {
+			shouldOutputJSON: true,
+			mockDescriber: func(m *climocks.MockpipelineStatusDescriber) {
+				m.EXPECT().Describe().Return(succeededStatus, nil)
+			},
+
+			wantedContent: succeededJSON,
+		},
+		"refreshes the status until the execution finishes if following": {
+			shouldOutputJSON: true,
+			follow:           true,
+			mockDescriber: func(m *climocks.MockpipelineStatusDescriber) {
+				gomock.InOrder(
+					m.EXPECT().Describe().Return(inProgressStatus, nil),
+					m.EXPECT().Describe().Return(succeededStatus, nil),
+				)
+			},
+
+			wantedContent: inProgressJSON + succeededJSON,
+		},
+		"only shows the status again when it changes if following": {
+			shouldOutputJSON: true,
+			follow:           true,
+			mockDescriber: func(m *climocks.MockpipelineStatusDescriber) {
+				gomock.InOrder(
+					m.EXPECT().Describe().Return(inProgressStatus, nil),
+					m.EXPECT().Describe().Return(inProgressStatus, nil),
+					m.EXPECT().Describe().Return(succeededStatus, nil),
+				)
+			},
+
+			wantedContent: inProgressJSON + succeededJSON,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			b := &bytes.Buffer{}
+			mockDescriber := climocks.NewMockpipelineStatusDescriber(ctrl)
+			tc.mockDescriber(mockDescriber)
+
+			opts := &statusPipelineOpts{
+				statusPipelineVars: statusPipelineVars{
+					GlobalOpts: &GlobalOpts{
+						projectName: "phonetool",
+					},
+					pipelineName:     "pipeline-phonetool",
+					shouldOutputJSON: tc.shouldOutputJSON,
+					follow:           tc.follow,
+				},
+				w: b,
+				initDescriber: func(o *statusPipelineOpts) error {
+					o.describer = mockDescriber
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -94,19 +94,18 @@ func (o *updatePipelineOpts) Validate() error {
 	return nil
 }
 
-func (o *updatePipelineOpts) convertStages(manifestStages []manifest.PipelineStage) ([]deploy.PipelineStage, error) {
+func (o *updatePipelineOpts) convertStages(manifestStages []manifest.PipelineStage, appNames []string) ([]deploy.PipelineStage, error) {
 	var stages []deploy.PipelineStage
-	appNames, err := o.ws.AppNames()
-	if err != nil {
-		return nil, err
-	}
-
 	for _, stage := range manifestStages {
 		env, err := o.envStore.GetEnvironment(o.ProjectName(), stage.Name)
 		if err != nil {
 			return nil, err
 		}
 
+		requiresApproval := env.Prod
+		if stage.RequiresApproval != nil {
+			requiresApproval = *stage.RequiresApproval
+		}
 		pipelineStage := deploy.PipelineStage{
 			LocalApplications: appNames,
 			AssociatedEnvironment: &deploy.AssociatedEnvironment{
@@ -115,6 +114,9 @@ func (o *updatePipelineOpts) convertStages(manifestStages []manifest.PipelineSta
 				AccountID: env.AccountID,
 				Prod:      env.Prod,
 			},
+			RequiresApproval: requiresApproval,
+			TestCommands:     stage.TestCommands,
+			DeployOrder:      stage.DeployOrder,
 		}
 		stages = append(stages, pipelineStage)
 	}
@@ -122,6 +124,57 @@ func (o *updatePipelineOpts) convertStages(manifestStages []manifest.PipelineSta
 	return stages, nil
 }
 
+// convertBuild returns the settings of the build project of the pipeline. The paths cached by the buildspec are stored
+// in the artifact bucket of the project in the region of the pipeline.
+func (o *updatePipelineOpts) convertBuild(build *manifest.PipelineBuild, artifactBuckets []deploy.ArtifactBucket) (*deploy.PipelineBuild, error) {
+	settings := deploy.DefaultPipelineBuild()
+	if build == nil {
+		return settings, nil
+	}
+	if build.Image != "" {
+		settings.Image = build.Image
+	}
+	if build.ComputeType != "" {
+		settings.ComputeType = build.ComputeType
+	}
+	if build.Privileged != nil {
+		settings.PrivilegedMode = aws.BoolValue(build.Privileged)
+	}
+	if build.Cache == nil {
+		return settings, nil
+	}
+	if build.Cache.DockerLayers != nil {
+		settings.DockerLayerCache = aws.BoolValue(build.Cache.DockerLayers)
+	}
+	if !aws.BoolValue(build.Cache.S3) {
+		return settings, nil
+	}
+	// CodeBuild projects have a single cache, the paths are cached in S3 instead of the Docker layers.
+	settings.DockerLayerCache = false
+	for _, bucket := range artifactBuckets {
+		region, err := bucket.Region()
+		if err != nil {
+			return nil, err
+		}
+		if region == o.region {
+			settings.CacheBucket = bucket.BucketName
+			return settings, nil
+		}
+	}
+	return nil, fmt.Errorf("find the artifact bucket of project %s in region %s to cache the build", o.ProjectName(), o.region)
+}
+
+func convertNotifications(notifications *manifest.PipelineNotifications) *deploy.PipelineNotifications {
+	if notifications == nil {
+		return nil
+	}
+	return &deploy.PipelineNotifications{
+		TopicARN: notifications.TopicARN,
+		Emails:   notifications.Emails,
+		Events:   notifications.Events,
+	}
+}
+
 func (o *updatePipelineOpts) getArtifactBuckets() ([]deploy.ArtifactBucket, error) {
 	regionalResources, err := o.pipelineDeployer.GetRegionalProjectResources(o.project)
 	if err != nil {
@@ -189,32 +242,50 @@ func (o *updatePipelineOpts) deployPipeline(in *deploy.CreatePipelineInput) erro
 
 // Execute create a new pipeline or update the current pipeline if it already exists.
 func (o *updatePipelineOpts) Execute() error {
+	// read and validate the pipeline manifest before deploying anything
+	pipeline, err := readPipelineManifest(o.ws, o.PipelineName)
+	if err != nil {
+		return err
+	}
+	o.PipelineName = pipeline.Name
+	wsAppNames, err := o.ws.AppNames()
+	if err != nil {
+		return fmt.Errorf("list applications in workspace: %w", err)
+	}
+	if err := pipeline.Validate(&pipelineValidationStore{
+		projectName: o.ProjectName(),
+		envLister:   o.envStore,
+		appNames:    wsAppNames,
+	}); err != nil {
+		return fmt.Errorf("validate pipeline manifest: %w", err)
+	}
+
 	// bootstrap pipeline resources
 	o.prog.Start(fmt.Sprintf(fmtAddPipelineResourcesStart, color.HighlightUserInput(o.ProjectName())))
-	err := o.pipelineDeployer.AddPipelineResourcesToProject(o.project, o.region)
+	err = o.pipelineDeployer.AddPipelineResourcesToProject(o.project, o.region)
 	if err != nil {
 		o.prog.Stop(log.Serrorf(fmtAddPipelineResourcesFailed, color.HighlightUserInput(o.ProjectName())))
 		return fmt.Errorf("add pipeline resources to project %s in %s: %w", o.ProjectName(), o.region, err)
 	}
 	o.prog.Stop(log.Ssuccessf(fmtAddPipelineResourcesComplete, color.HighlightUserInput(o.ProjectName())))
 
-	// read pipeline manifest
-	data, err := o.ws.ReadPipelineManifest()
-	if err != nil {
-		return fmt.Errorf("read pipeline manifest: %w", err)
-	}
-	pipeline, err := manifest.UnmarshalPipeline(data)
-	if err != nil {
-		return fmt.Errorf("unmarshal pipeline manifest: %w", err)
-	}
-	o.PipelineName = pipeline.Name
 	source := &deploy.Source{
 		ProviderName: pipeline.Source.ProviderName,
 		Properties:   pipeline.Source.Properties,
 	}
+	buildspecPath, err := o.ws.PipelineBuildspecPath(pipeline.Name)
+	if err != nil {
+		return fmt.Errorf("get buildspec path of pipeline %s: %w", pipeline.Name, err)
+	}
+
+	// filter the applications of the workspace deployed by the pipeline
+	appNames, err := pipelineAppNames(pipeline, wsAppNames)
+	if err != nil {
+		return err
+	}
 
 	// convert environments to deployment stages
-	stages, err := o.convertStages(pipeline.Stages)
+	stages, err := o.convertStages(pipeline.Stages, appNames)
 	if err != nil {
 		return fmt.Errorf("convert environments to deployment stage: %w", err)
 	}
@@ -225,12 +296,20 @@ func (o *updatePipelineOpts) Execute() error {
 		return fmt.Errorf("get cross-regional resources: %w", err)
 	}
 
+	build, err := o.convertBuild(pipeline.Build, artifactBuckets)
+	if err != nil {
+		return fmt.Errorf("convert build settings: %w", err)
+	}
+
 	deployPipelineInput := &deploy.CreatePipelineInput{
 		ProjectName:     o.ProjectName(),
 		Name:            pipeline.Name,
 		Source:          source,
 		Stages:          stages,
 		ArtifactBuckets: artifactBuckets,
+		BuildspecPath:   buildspecPath,
+		Build:           build,
+		Notifications:   convertNotifications(pipeline.Notifications),
 	}
 
 	if err := o.deployPipeline(deployPipelineInput); err != nil {
@@ -251,7 +330,10 @@ func BuildPipelineUpdateCmd() *cobra.Command {
 		Long:  `Deploys a pipeline for the applications in your workspace, using the environments associated with the applications.`,
 		Example: `
   Deploy an updated pipeline for the applications in your workspace:
-  /code $ ecs-preview pipeline update`,
+  /code $ ecs-preview pipeline update
+
+  Deploy the pipeline "hotfix" when your workspace has multiple pipelines:
+  /code $ ecs-preview pipeline update --name hotfix`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newUpdatePipelineOpts(vars)
 			if err != nil {
@@ -263,6 +345,7 @@ func BuildPipelineUpdateCmd() *cobra.Command {
 			return opts.Execute()
 		}),
 	}
+	cmd.Flags().StringVarP(&vars.PipelineName, nameFlag, nameFlagShort, "", pipelineNameFlagDescription)
 	cmd.Flags().BoolVar(&vars.SkipConfirmation, yesFlag, false, yesFlagDescription)
 
 	return cmd
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
 	archermocks "github.com/aws/amazon-ecs-cli-v2/mocks"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -23,8 +24,7 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 		stages        []manifest.PipelineStage
 		inProjectName string
 
-		mockWorkspace func(m *climocks.MockwsPipelineReader)
-		mockEnvStore  func(m *archermocks.MockEnvironmentStore)
+		mockEnvStore func(m *archermocks.MockEnvironmentStore)
 
 		expectedStages []deploy.PipelineStage
 		expectedError  error
@@ -36,9 +36,6 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 				},
 			},
 			inProjectName: "badgoose",
-			mockWorkspace: func(m *climocks.MockwsPipelineReader) {
-				m.EXPECT().AppNames().Return([]string{"frontend", "backend"}, nil).Times(1)
-			},
 			mockEnvStore: func(m *archermocks.MockEnvironmentStore) {
 				mockEnv := &archer.Environment{
 					Name:      "test",
@@ -64,6 +61,55 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		"requires approval for production environments by default": {
+			stages: []manifest.PipelineStage{
+				{
+					Name:         "prod",
+					TestCommands: []string{"make test"},
+					DeployOrder:  []string{"backend"},
+				},
+				{
+					Name:             "prod-no-approval",
+					RequiresApproval: aws.Bool(false),
+				},
+			},
+			inProjectName: "badgoose",
+			mockEnvStore: func(m *archermocks.MockEnvironmentStore) {
+				for _, env := range []string{"prod", "prod-no-approval"} {
+					m.EXPECT().GetEnvironment("badgoose", env).Return(&archer.Environment{
+						Name:      env,
+						Project:   "badgoose",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+						Prod:      true,
+					}, nil).Times(1)
+				}
+			},
+
+			expectedStages: []deploy.PipelineStage{
+				{
+					AssociatedEnvironment: &deploy.AssociatedEnvironment{
+						Name:      "prod",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+						Prod:      true,
+					},
+					LocalApplications: []string{"frontend", "backend"},
+					RequiresApproval:  true,
+					TestCommands:      []string{"make test"},
+					DeployOrder:       []string{"backend"},
+				},
+				{
+					AssociatedEnvironment: &deploy.AssociatedEnvironment{
+						Name:      "prod-no-approval",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+						Prod:      true,
+					},
+					LocalApplications: []string{"frontend", "backend"},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -73,21 +119,18 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockEnvStore := archermocks.NewMockEnvironmentStore(ctrl)
-			mockWorkspace := climocks.NewMockwsPipelineReader(ctrl)
 
 			tc.mockEnvStore(mockEnvStore)
-			tc.mockWorkspace(mockWorkspace)
 
 			opts := &updatePipelineOpts{
 				updatePipelineVars: updatePipelineVars{
 					GlobalOpts: &GlobalOpts{projectName: tc.inProjectName},
 				},
 				envStore: mockEnvStore,
-				ws:       mockWorkspace,
 			}
 
 			// WHEN
-			actualStages, err := opts.convertStages(tc.stages)
+			actualStages, err := opts.convertStages(tc.stages, []string{"frontend", "backend"})
 
 			// THEN
 			if tc.expectedError != nil {
@@ -154,6 +197,93 @@ func TestUpdatePipelineOpts_getArtifactBuckets(t *testing.T) {
 	}
 }
 
+func TestUpdatePipelineOpts_convertBuild(t *testing.T) {
+	buckets := []deploy.ArtifactBucket{
+		{
+			BucketName: "bucket-east",
+			KeyArn:     "arn:aws:kms:us-east-1:123456789012:key/1234",
+		},
+		{
+			BucketName: "bucket-west",
+			KeyArn:     "arn:aws:kms:us-west-2:123456789012:key/5678",
+		},
+	}
+	testCases := map[string]struct {
+		inBuild  *manifest.PipelineBuild
+		inRegion string
+
+		expectedBuild *deploy.PipelineBuild
+		expectedError error
+	}{
+		"uses the default settings": {
+			inRegion:      "us-west-2",
+			expectedBuild: deploy.DefaultPipelineBuild(),
+		},
+		"overrides the image, compute type and privileged mode": {
+			inBuild: &manifest.PipelineBuild{
+				Image:       "aws/codebuild/amazonlinux2-x86_64-standard:3.0",
+				ComputeType: "BUILD_GENERAL1_LARGE",
+				Privileged:  aws.Bool(false),
+				Cache: &manifest.PipelineBuildCache{
+					DockerLayers: aws.Bool(false),
+				},
+			},
+			inRegion: "us-west-2",
+			expectedBuild: &deploy.PipelineBuild{
+				Image:       "aws/codebuild/amazonlinux2-x86_64-standard:3.0",
+				ComputeType: "BUILD_GENERAL1_LARGE",
+			},
+		},
+		"caches the buildspec paths in the artifact bucket of the region": {
+			inBuild: &manifest.PipelineBuild{
+				Cache: &manifest.PipelineBuildCache{
+					S3: aws.Bool(true),
+				},
+			},
+			inRegion: "us-west-2",
+			expectedBuild: &deploy.PipelineBuild{
+				Image:          "aws/codebuild/amazonlinux2-x86_64-standard:1.0",
+				ComputeType:    "BUILD_GENERAL1_SMALL",
+				PrivilegedMode: true,
+				CacheBucket:    "bucket-west",
+			},
+		},
+		"returns an error if there is no artifact bucket in the region": {
+			inBuild: &manifest.PipelineBuild{
+				Cache: &manifest.PipelineBuildCache{
+					S3: aws.Bool(true),
+				},
+			},
+			inRegion:      "eu-west-1",
+			expectedError: errors.New("find the artifact bucket of project badgoose in region eu-west-1 to cache the build"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &updatePipelineOpts{
+				updatePipelineVars: updatePipelineVars{
+					GlobalOpts: &GlobalOpts{
+						projectName: "badgoose",
+					},
+				},
+				region: tc.inRegion,
+			}
+
+			// WHEN
+			build, err := opts.convertBuild(tc.inBuild, buckets)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedBuild, build)
+			}
+		})
+	}
+}
+
 func TestUpdatePipelineOpts_Execute(t *testing.T) {
 	const (
 		projectName  = "badgoose"
@@ -199,6 +329,10 @@ stages:
 		AccountID: accountID,
 		Prod:      false,
 	}
+	mockEnvs := []*archer.Environment{
+		{Name: "chicken"},
+		{Name: "wings"},
+	}
 
 	testCases := map[string]struct {
 		inProject      *archer.Project
@@ -218,10 +352,13 @@ stages:
 			inProjectName: projectName,
 			inRegion:      region,
 			mockWorkspace: func(m *climocks.MockwsPipelineReader) {
-				m.EXPECT().ReadPipelineManifest().Return([]byte(content), nil)
+				m.EXPECT().PipelineNames().Return(nil, nil)
+				m.EXPECT().ReadPipelineManifest("").Return([]byte(content), nil)
+				m.EXPECT().PipelineBuildspecPath(pipelineName).Return("ecs-project/buildspec.yml", nil)
 				m.EXPECT().AppNames().Return([]string{"frontend", "backend"}, nil).Times(1)
 			},
 			mockEnvStore: func(m *archermocks.MockEnvironmentStore) {
+				m.EXPECT().ListEnvironments(projectName).Return(mockEnvs, nil).Times(1)
 				m.EXPECT().GetEnvironment(projectName, "chicken").Return(mockEnv, nil).Times(1)
 				m.EXPECT().GetEnvironment(projectName, "wings").Return(mockEnv, nil).Times(1)
 			},
@@ -250,10 +387,13 @@ stages:
 			inProjectName: projectName,
 			inRegion:      region,
 			mockWorkspace: func(m *climocks.MockwsPipelineReader) {
-				m.EXPECT().ReadPipelineManifest().Return([]byte(content), nil)
+				m.EXPECT().PipelineNames().Return(nil, nil)
+				m.EXPECT().ReadPipelineManifest("").Return([]byte(content), nil)
+				m.EXPECT().PipelineBuildspecPath(pipelineName).Return("ecs-project/buildspec.yml", nil)
 				m.EXPECT().AppNames().Return([]string{"frontend", "backend"}, nil).Times(1)
 			},
 			mockEnvStore: func(m *archermocks.MockEnvironmentStore) {
+				m.EXPECT().ListEnvironments(projectName).Return(mockEnvs, nil).Times(1)
 				m.EXPECT().GetEnvironment(projectName, "chicken").Return(mockEnv, nil).Times(1)
 				m.EXPECT().GetEnvironment(projectName, "wings").Return(mockEnv, nil).Times(1)
 			},
@@ -279,15 +419,106 @@ stages:
 			},
 			expectedError: nil,
 		},
+		"create and deploy a named pipeline with its applications": {
+			inProject:      &project,
+			inProjectName:  projectName,
+			inPipelineName: pipelineName,
+			inRegion:       region,
+			mockWorkspace: func(m *climocks.MockwsPipelineReader) {
+				m.EXPECT().ReadPipelineManifest(pipelineName).Return([]byte(content+"apps: [frontend]\n"), nil)
+				m.EXPECT().PipelineBuildspecPath(pipelineName).Return("ecs-project/pipelines/pipepiper/buildspec.yml", nil)
+				m.EXPECT().AppNames().Return([]string{"frontend", "backend"}, nil).Times(1)
+			},
+			mockEnvStore: func(m *archermocks.MockEnvironmentStore) {
+				m.EXPECT().ListEnvironments(projectName).Return(mockEnvs, nil).Times(1)
+				m.EXPECT().GetEnvironment(projectName, "chicken").Return(mockEnv, nil).Times(1)
+				m.EXPECT().GetEnvironment(projectName, "wings").Return(mockEnv, nil).Times(1)
+			},
+			mockProgress: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any()).Times(2)
+				m.EXPECT().Stop(gomock.Any()).Times(2)
+			},
+			mockDeployer: func(m *climocks.MockpipelineDeployer) {
+				m.EXPECT().AddPipelineResourcesToProject(&project, region).Return(nil)
+				m.EXPECT().GetRegionalProjectResources(gomock.Any()).Return(mockResources, nil)
+				m.EXPECT().PipelineExists(gomock.Any()).Return(false, nil)
+				m.EXPECT().CreatePipeline(gomock.Any()).Do(func(in *deploy.CreatePipelineInput) {
+					require.Equal(t, "ecs-project/pipelines/pipepiper/buildspec.yml", in.BuildspecPath)
+					for _, stage := range in.Stages {
+						require.Equal(t, []string{"frontend"}, stage.LocalApplications)
+					}
+				}).Return(nil)
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {},
+		},
+		"create and deploy a pipeline with notifications": {
+			inProject:     &project,
+			inProjectName: projectName,
+			inRegion:      region,
+			mockWorkspace: func(m *climocks.MockwsPipelineReader) {
+				m.EXPECT().PipelineNames().Return(nil, nil)
+				m.EXPECT().ReadPipelineManifest("").Return([]byte(content+`
+notifications:
+  emails: [team@example.com]
+  events: [stage_failed]
+`), nil)
+				m.EXPECT().PipelineBuildspecPath(pipelineName).Return("ecs-project/buildspec.yml", nil)
+				m.EXPECT().AppNames().Return([]string{"frontend", "backend"}, nil).Times(1)
+			},
+			mockEnvStore: func(m *archermocks.MockEnvironmentStore) {
+				m.EXPECT().ListEnvironments(projectName).Return(mockEnvs, nil).Times(1)
+				m.EXPECT().GetEnvironment(projectName, "chicken").Return(mockEnv, nil).Times(1)
+				m.EXPECT().GetEnvironment(projectName, "wings").Return(mockEnv, nil).Times(1)
+			},
+			mockProgress: func(m *climocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any()).Times(2)
+				m.EXPECT().Stop(gomock.Any()).Times(2)
+			},
+			mockDeployer: func(m *climocks.MockpipelineDeployer) {
+				m.EXPECT().AddPipelineResourcesToProject(&project, region).Return(nil)
+				m.EXPECT().GetRegionalProjectResources(gomock.Any()).Return(mockResources, nil)
+				m.EXPECT().PipelineExists(gomock.Any()).Return(false, nil)
+				m.EXPECT().CreatePipeline(gomock.Any()).Do(func(in *deploy.CreatePipelineInput) {
+					require.Equal(t, &deploy.PipelineNotifications{
+						Emails: []string{"team@example.com"},
+						Events: []string{"stage_failed"},
+					}, in.Notifications)
+				}).Return(nil)
+			},
+			mockPrompt: func(m *climocks.Mockprompter) {},
+		},
+		"returns an error if the pipeline manifest is invalid": {
+			inProject:     &project,
+			inProjectName: projectName,
+			inRegion:      region,
+			mockWorkspace: func(m *climocks.MockwsPipelineReader) {
+				m.EXPECT().PipelineNames().Return(nil, nil)
+				m.EXPECT().ReadPipelineManifest("").Return([]byte(content+`
+notifications:
+  events: [stage_failed]
+`), nil)
+				m.EXPECT().AppNames().Return([]string{"frontend", "backend"}, nil).Times(1)
+			},
+			mockEnvStore: func(m *archermocks.MockEnvironmentStore) {
+				m.EXPECT().ListEnvironments(projectName).Return(mockEnvs, nil).Times(1)
+			},
+			mockProgress:  func(m *climocks.Mockprogress) {},
+			mockDeployer:  func(m *climocks.MockpipelineDeployer) {},
+			mockPrompt:    func(m *climocks.Mockprompter) {},
+			expectedError: errors.New("validate pipeline manifest: pipeline.yml has 1 problem(s):\n  line 19, column 3: missing notification targets, must have a topic_arn or emails"),
+		},
 		"do not deploy pipeline if decline to update an existing pipeline": {
 			inProject:     &project,
 			inProjectName: projectName,
 			inRegion:      region,
 			mockWorkspace: func(m *climocks.MockwsPipelineReader) {
-				m.EXPECT().ReadPipelineManifest().Return([]byte(content), nil)
+				m.EXPECT().PipelineNames().Return(nil, nil)
+				m.EXPECT().ReadPipelineManifest("").Return([]byte(content), nil)
+				m.EXPECT().PipelineBuildspecPath(pipelineName).Return("ecs-project/buildspec.yml", nil)
 				m.EXPECT().AppNames().Return([]string{"frontend", "backend"}, nil).Times(1)
 			},
 			mockEnvStore: func(m *archermocks.MockEnvironmentStore) {
+				m.EXPECT().ListEnvironments(projectName).Return(mockEnvs, nil).Times(1)
 				m.EXPECT().GetEnvironment(projectName, "chicken").Return(mockEnv, nil).Times(1)
 				m.EXPECT().GetEnvironment(projectName, "wings").Return(mockEnv, nil).Times(1)
 			},
@@ -318,10 +549,13 @@ stages:
 			inProjectName: projectName,
 			inRegion:      region,
 			mockWorkspace: func(m *climocks.MockwsPipelineReader) {
-				m.EXPECT().ReadPipelineManifest().Return([]byte(content), nil)
+				m.EXPECT().PipelineNames().Return(nil, nil)
+				m.EXPECT().ReadPipelineManifest("").Return([]byte(content), nil)
+				m.EXPECT().PipelineBuildspecPath(pipelineName).Return("ecs-project/buildspec.yml", nil)
 				m.EXPECT().AppNames().Return([]string{"frontend", "backend"}, nil).Times(1)
 			},
 			mockEnvStore: func(m *archermocks.MockEnvironmentStore) {
+				m.EXPECT().ListEnvironments(projectName).Return(mockEnvs, nil).Times(1)
 				m.EXPECT().GetEnvironment(projectName, "chicken").Return(mockEnv, nil).Times(1)
 				m.EXPECT().GetEnvironment(projectName, "wings").Return(mockEnv, nil).Times(1)
 			},
@@ -344,8 +578,14 @@ stages:
 			inProject:     &project,
 			inRegion:      region,
 			inProjectName: projectName,
-			mockWorkspace: func(m *climocks.MockwsPipelineReader) {},
-			mockEnvStore:  func(m *archermocks.MockEnvironmentStore) {},
+			mockWorkspace: func(m *climocks.MockwsPipelineReader) {
+				m.EXPECT().PipelineNames().Return(nil, nil)
+				m.EXPECT().ReadPipelineManifest("").Return([]byte(content), nil)
+				m.EXPECT().AppNames().Return([]string{"frontend", "backend"}, nil).Times(1)
+			},
+			mockEnvStore: func(m *archermocks.MockEnvironmentStore) {
+				m.EXPECT().ListEnvironments(projectName).Return(mockEnvs, nil).Times(1)
+			},
 			mockProgress: func(m *climocks.Mockprogress) {
 				m.EXPECT().Start(fmt.Sprintf(fmtAddPipelineResourcesStart, projectName)).Times(1)
 				m.EXPECT().Stop(log.Serrorf(fmtAddPipelineResourcesFailed, projectName)).Times(1)
@@ -362,17 +602,12 @@ stages:
 			inRegion:      region,
 			inProjectName: projectName,
 			mockWorkspace: func(m *climocks.MockwsPipelineReader) {
-				m.EXPECT().ReadPipelineManifest().Return([]byte(content), errors.New("some error"))
-			},
-			mockEnvStore: func(m *archermocks.MockEnvironmentStore) {},
-			mockProgress: func(m *climocks.Mockprogress) {
-				m.EXPECT().Start(fmt.Sprintf(fmtAddPipelineResourcesStart, projectName)).Times(1)
-				m.EXPECT().Stop(log.Serrorf(fmtAddPipelineResourcesFailed, projectName)).Times(0)
-				m.EXPECT().Stop(log.Ssuccessf(fmtAddPipelineResourcesComplete, projectName)).Times(1)
-			},
-			mockDeployer: func(m *climocks.MockpipelineDeployer) {
-				m.EXPECT().AddPipelineResourcesToProject(&project, region).Return(nil)
+				m.EXPECT().PipelineNames().Return(nil, nil)
+				m.EXPECT().ReadPipelineManifest("").Return([]byte(content), errors.New("some error"))
 			},
+			mockEnvStore:  func(m *archermocks.MockEnvironmentStore) {},
+			mockProgress:  func(m *climocks.Mockprogress) {},
+			mockDeployer:  func(m *climocks.MockpipelineDeployer) {},
 			mockPrompt:    func(m *climocks.Mockprompter) {},
 			expectedError: fmt.Errorf("read pipeline manifest: some error"),
 		},
@@ -382,49 +617,42 @@ stages:
 			inProjectName: projectName,
 			mockWorkspace: func(m *climocks.MockwsPipelineReader) {
 				content := ""
-				m.EXPECT().ReadPipelineManifest().Return([]byte(content), nil)
-			},
-			mockEnvStore: func(m *archermocks.MockEnvironmentStore) {},
-			mockProgress: func(m *climocks.Mockprogress) {
-				m.EXPECT().Start(fmt.Sprintf(fmtAddPipelineResourcesStart, projectName)).Times(1)
-				m.EXPECT().Stop(log.Serrorf(fmtAddPipelineResourcesFailed, projectName)).Times(0)
-				m.EXPECT().Stop(log.Ssuccessf(fmtAddPipelineResourcesComplete, projectName)).Times(1)
-			},
-			mockDeployer: func(m *climocks.MockpipelineDeployer) {
-				m.EXPECT().AddPipelineResourcesToProject(&project, region).Return(nil)
+				m.EXPECT().PipelineNames().Return(nil, nil)
+				m.EXPECT().ReadPipelineManifest("").Return([]byte(content), nil)
 			},
+			mockEnvStore:  func(m *archermocks.MockEnvironmentStore) {},
+			mockProgress:  func(m *climocks.Mockprogress) {},
+			mockDeployer:  func(m *climocks.MockpipelineDeployer) {},
 			mockPrompt:    func(m *climocks.Mockprompter) {},
 			expectedError: fmt.Errorf("unmarshal pipeline manifest: pipeline.yml contains invalid schema version: 0"),
 		},
-		"returns an error if unable to convert environments to deployment stage": {
+		"returns an error if fail to list the applications in the workspace": {
 			inProject:     &project,
 			inRegion:      region,
 			inProjectName: projectName,
 			mockWorkspace: func(m *climocks.MockwsPipelineReader) {
-				m.EXPECT().ReadPipelineManifest().Return([]byte(content), nil)
+				m.EXPECT().PipelineNames().Return(nil, nil)
+				m.EXPECT().ReadPipelineManifest("").Return([]byte(content), nil)
 				m.EXPECT().AppNames().Return(nil, errors.New("some error")).Times(1)
 			},
-			mockEnvStore: func(m *archermocks.MockEnvironmentStore) {},
-			mockProgress: func(m *climocks.Mockprogress) {
-				m.EXPECT().Start(fmt.Sprintf(fmtAddPipelineResourcesStart, projectName)).Times(1)
-				m.EXPECT().Stop(log.Serrorf(fmtAddPipelineResourcesFailed, projectName)).Times(0)
-				m.EXPECT().Stop(log.Ssuccessf(fmtAddPipelineResourcesComplete, projectName)).Times(1)
-			},
-			mockDeployer: func(m *climocks.MockpipelineDeployer) {
-				m.EXPECT().AddPipelineResourcesToProject(&project, region).Return(nil)
-			},
+			mockEnvStore:  func(m *archermocks.MockEnvironmentStore) {},
+			mockProgress:  func(m *climocks.Mockprogress) {},
+			mockDeployer:  func(m *climocks.MockpipelineDeployer) {},
 			mockPrompt:    func(m *climocks.Mockprompter) {},
-			expectedError: fmt.Errorf("convert environments to deployment stage: some error"),
+			expectedError: fmt.Errorf("list applications in workspace: some error"),
 		},
 		"returns an error if fails to get cross-regional resources": {
 			inProject:     &project,
 			inRegion:      region,
 			inProjectName: projectName,
 			mockWorkspace: func(m *climocks.MockwsPipelineReader) {
-				m.EXPECT().ReadPipelineManifest().Return([]byte(content), nil)
+				m.EXPECT().PipelineNames().Return(nil, nil)
+				m.EXPECT().ReadPipelineManifest("").Return([]byte(content), nil)
+				m.EXPECT().PipelineBuildspecPath(pipelineName).Return("ecs-project/buildspec.yml", nil)
 				m.EXPECT().AppNames().Return([]string{"frontend", "backend"}, nil).Times(1)
 			},
 			mockEnvStore: func(m *archermocks.MockEnvironmentStore) {
+				m.EXPECT().ListEnvironments(projectName).Return(mockEnvs, nil).Times(1)
 				m.EXPECT().GetEnvironment(projectName, "chicken").Return(mockEnv, nil).Times(1)
 				m.EXPECT().GetEnvironment(projectName, "wings").Return(mockEnv, nil).Times(1)
 			},
@@ -445,10 +673,13 @@ stages:
 			inRegion:      region,
 			inProjectName: projectName,
 			mockWorkspace: func(m *climocks.MockwsPipelineReader) {
-				m.EXPECT().ReadPipelineManifest().Return([]byte(content), nil)
+				m.EXPECT().PipelineNames().Return(nil, nil)
+				m.EXPECT().ReadPipelineManifest("").Return([]byte(content), nil)
+				m.EXPECT().PipelineBuildspecPath(pipelineName).Return("ecs-project/buildspec.yml", nil)
 				m.EXPECT().AppNames().Return([]string{"frontend", "backend"}, nil).Times(1)
 			},
 			mockEnvStore: func(m *archermocks.MockEnvironmentStore) {
+				m.EXPECT().ListEnvironments(projectName).Return(mockEnvs, nil).Times(1)
 				m.EXPECT().GetEnvironment(projectName, "chicken").Return(mockEnv, nil).Times(1)
 				m.EXPECT().GetEnvironment(projectName, "wings").Return(mockEnv, nil).Times(1)
 			},
@@ -470,10 +701,13 @@ stages:
 			inRegion:      region,
 			inProjectName: projectName,
 			mockWorkspace: func(m *climocks.MockwsPipelineReader) {
-				m.EXPECT().ReadPipelineManifest().Return([]byte(content), nil)
+				m.EXPECT().PipelineNames().Return(nil, nil)
+				m.EXPECT().ReadPipelineManifest("").Return([]byte(content), nil)
+				m.EXPECT().PipelineBuildspecPath(pipelineName).Return("ecs-project/buildspec.yml", nil)
 				m.EXPECT().AppNames().Return([]string{"frontend", "backend"}, nil).Times(1)
 			},
 			mockEnvStore: func(m *archermocks.MockEnvironmentStore) {
+				m.EXPECT().ListEnvironments(projectName).Return(mockEnvs, nil).Times(1)
 				m.EXPECT().GetEnvironment(projectName, "chicken").Return(mockEnv, nil).Times(1)
 				m.EXPECT().GetEnvironment(projectName, "wings").Return(mockEnv, nil).Times(1)
 			},
@@ -502,10 +736,13 @@ stages:
 			inRegion:      region,
 			inProjectName: projectName,
 			mockWorkspace: func(m *climocks.MockwsPipelineReader) {
-				m.EXPECT().ReadPipelineManifest().Return([]byte(content), nil)
+				m.EXPECT().PipelineNames().Return(nil, nil)
+				m.EXPECT().ReadPipelineManifest("").Return([]byte(content), nil)
+				m.EXPECT().PipelineBuildspecPath(pipelineName).Return("ecs-project/buildspec.yml", nil)
 				m.EXPECT().AppNames().Return([]string{"frontend", "backend"}, nil).Times(1)
 			},
 			mockEnvStore: func(m *archermocks.MockEnvironmentStore) {
+				m.EXPECT().ListEnvironments(projectName).Return(mockEnvs, nil).Times(1)
 				m.EXPECT().GetEnvironment(projectName, "chicken").Return(mockEnv, nil).Times(1)
 				m.EXPECT().GetEnvironment(projectName, "wings").Return(mockEnv, nil).Times(1)
 			},
@@ -0,0 +1,109 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const fmtPipelineValid = "Pipeline manifest of %s is valid.\n"
+
+type validatePipelineVars struct {
+	*GlobalOpts
+	PipelineName string
+}
+
+type validatePipelineOpts struct {
+	validatePipelineVars
+
+	envLister archer.EnvironmentLister
+	ws        wsPipelineReader
+}
+
+func newValidatePipelineOpts(vars validatePipelineVars) (*validatePipelineOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	store, err := store.New()
+	if err != nil {
+		return nil, fmt.Errorf("couldn't connect to project datastore: %w", err)
+	}
+
+	return &validatePipelineOpts{
+		validatePipelineVars: vars,
+		envLister:            store,
+		ws:                   ws,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *validatePipelineOpts) Validate() error {
+	if o.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	return nil
+}
+
+// Execute checks the pipeline manifest against the environments of the project and the applications of the workspace,
+// and reports all the problems found.
+func (o *validatePipelineOpts) Execute() error {
+	// The schema version is checked by Validate so that it's reported with its position.
+	pipeline, err := readPipelineManifestWith(o.ws, o.PipelineName, manifest.ParsePipeline)
+	if err != nil {
+		return err
+	}
+	appNames, err := o.ws.AppNames()
+	if err != nil {
+		return fmt.Errorf("list applications in workspace: %w", err)
+	}
+	if err := pipeline.Validate(&pipelineValidationStore{
+		projectName: o.ProjectName(),
+		envLister:   o.envLister,
+		appNames:    appNames,
+	}); err != nil {
+		return fmt.Errorf("validate pipeline %s: %w", pipeline.Name, err)
+	}
+	log.Successf(fmtPipelineValid, color.HighlightUserInput(pipeline.Name))
+	return nil
+}
+
+// BuildPipelineValidateCmd builds the command for validating the pipeline manifest in the workspace.
+func BuildPipelineValidateCmd() *cobra.Command {
+	vars := validatePipelineVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validates the pipeline manifest in your workspace.",
+		Long: `Validates the pipeline manifest in your workspace against your project,
+reporting unknown environments and applications, and invalid settings along with their position in the file.`,
+		Example: `
+  Validate the pipeline manifest in your workspace
+  /code $ ecs-preview pipeline validate
+
+  Validate the pipeline "hotfix" when your workspace has multiple pipelines
+  /code $ ecs-preview pipeline validate --name hotfix`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newValidatePipelineOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.PipelineName, nameFlag, nameFlagShort, "", pipelineNameFlagDescription)
+	return cmd
+}
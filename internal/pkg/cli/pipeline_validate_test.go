@@ -0,0 +1,141 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineValidate_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inProjectName string
+
+		wantedError error
+	}{
+		"returns an error if not in a project": {
+			wantedError: errNoProjectInWorkspace,
+		},
+		"succeeds in a project": {
+			inProjectName: "phonetool",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &validatePipelineOpts{
+				validatePipelineVars: validatePipelineVars{
+					GlobalOpts: &GlobalOpts{
+						projectName: tc.inProjectName,
+					},
+				},
+			}
+
+			err := opts.Validate()
+
+			require.Equal(t, tc.wantedError, err)
+		})
+	}
+}
+
+func TestPipelineValidate_Execute(t *testing.T) {
+	const projectName = "phonetool"
+	testCases := map[string]struct {
+		inPipelineName string
+
+		mockWorkspace func(m *climocks.MockwsPipelineReader)
+		mockEnvLister func(m *mocks.MockEnvironmentLister)
+
+		wantedError error
+	}{
+		"returns an error if fail to read the pipeline manifest": {
+			inPipelineName: "pipeline-phonetool",
+			mockWorkspace: func(m *climocks.MockwsPipelineReader) {
+				m.EXPECT().ReadPipelineManifest("pipeline-phonetool").Return(nil, errors.New("some error"))
+			},
+			mockEnvLister: func(m *mocks.MockEnvironmentLister) {},
+			wantedError:   errors.New("read pipeline manifest: some error"),
+		},
+		"returns an error if fail to list the applications in the workspace": {
+			inPipelineName: "pipeline-phonetool",
+			mockWorkspace: func(m *climocks.MockwsPipelineReader) {
+				m.EXPECT().ReadPipelineManifest("pipeline-phonetool").Return([]byte(mockGitHubPipelineManifest), nil)
+				m.EXPECT().AppNames().Return(nil, errors.New("some error"))
+			},
+			mockEnvLister: func(m *mocks.MockEnvironmentLister) {},
+			wantedError:   errors.New("list applications in workspace: some error"),
+		},
+		"reports the problems of the pipeline manifest": {
+			mockWorkspace: func(m *climocks.MockwsPipelineReader) {
+				m.EXPECT().PipelineNames().Return([]string{"pipeline-phonetool"}, nil)
+				m.EXPECT().ReadPipelineManifest("pipeline-phonetool").Return([]byte(mockGitHubPipelineManifest), nil)
+				m.EXPECT().AppNames().Return([]string{"frontend"}, nil)
+			},
+			mockEnvLister: func(m *mocks.MockEnvironmentLister) {
+				m.EXPECT().ListEnvironments(projectName).Return([]*archer.Environment{{Name: "prod"}}, nil)
+			},
+			wantedError: errors.New("validate pipeline pipeline-phonetool: pipeline.yml has 1 problem(s):\n  line 12, column 11: environment test does not exist in the project"),
+		},
+		"reports an unsupported version along with the other problems": {
+			inPipelineName: "pipeline-phonetool",
+			mockWorkspace: func(m *climocks.MockwsPipelineReader) {
+				m.EXPECT().ReadPipelineManifest("pipeline-phonetool").Return([]byte(strings.Replace(mockGitHubPipelineManifest, "version: 1", "version: 2", 1)), nil)
+				m.EXPECT().AppNames().Return([]string{"frontend"}, nil)
+			},
+			mockEnvLister: func(m *mocks.MockEnvironmentLister) {
+				m.EXPECT().ListEnvironments(projectName).Return([]*archer.Environment{{Name: "prod"}}, nil)
+			},
+			wantedError: errors.New("validate pipeline pipeline-phonetool: pipeline.yml has 2 problem(s):\n  line 2, column 10: unsupported schema version 2, must be 1\n  line 12, column 11: environment test does not exist in the project"),
+		},
+		"succeeds if the pipeline manifest is valid": {
+			inPipelineName: "pipeline-phonetool",
+			mockWorkspace: func(m *climocks.MockwsPipelineReader) {
+				m.EXPECT().ReadPipelineManifest("pipeline-phonetool").Return([]byte(mockGitHubPipelineManifest), nil)
+				m.EXPECT().AppNames().Return([]string{"frontend"}, nil)
+			},
+			mockEnvLister: func(m *mocks.MockEnvironmentLister) {
+				m.EXPECT().ListEnvironments(projectName).Return([]*archer.Environment{{Name: "test"}}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWorkspace := climocks.NewMockwsPipelineReader(ctrl)
+			mockEnvLister := mocks.NewMockEnvironmentLister(ctrl)
+			tc.mockWorkspace(mockWorkspace)
+			tc.mockEnvLister(mockEnvLister)
+			opts := &validatePipelineOpts{
+				validatePipelineVars: validatePipelineVars{
+					GlobalOpts: &GlobalOpts{
+						projectName: projectName,
+					},
+					PipelineName: tc.inPipelineName,
+				},
+				envLister: mockEnvLister,
+				ws:        mockWorkspace,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
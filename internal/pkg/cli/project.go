@@ -22,6 +22,7 @@ A Project represents all of your deployment environments.`,
 	cmd.AddCommand(BuildProjectInitCommand())
 	cmd.AddCommand(BuildProjectListCommand())
 	cmd.AddCommand(BuildProjectShowCmd())
+	cmd.AddCommand(BuildProjectUpdateCommand())
 	cmd.AddCommand(BuildProjectDeleteCommand())
 
 	cmd.SetUsageTemplate(template.Usage)
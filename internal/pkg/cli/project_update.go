@@ -0,0 +1,263 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/identity"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/session"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	termprogress "github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/progress"
+	"github.com/spf13/cobra"
+)
+
+const (
+	fmtUpdateProjectDomainStart    = "Updating the DNS delegation resources of project %s."
+	fmtUpdateProjectDomainFailed   = "Failed to update the DNS delegation resources of project %s."
+	fmtUpdateProjectDomainComplete = "Updated the DNS delegation resources of project %s."
+
+	fmtUpdateEnvDomainStart    = "Updating the domain of environment %s."
+	fmtUpdateEnvDomainFailed   = "Failed to update the domain of environment %s."
+	fmtUpdateEnvDomainComplete = "Updated the domain of environment %s."
+)
+
+var (
+	errNoDomainUpdate = fmt.Errorf("must specify one of --%s or --%s", domainNameFlag, removeDomainFlag)
+)
+
+type updateProjectVars struct {
+	*GlobalOpts
+	DomainName   string
+	RemoveDomain bool
+}
+
+type updateProjectOpts struct {
+	updateProjectVars
+
+	store    projectDomainStore
+	identity identityService
+	deployer projectDeployer
+	prog     progress
+
+	// initEnvDeployer is overriden in tests.
+	initEnvDeployer func(env *archer.Environment) (environmentDeployer, error)
+}
+
+func newUpdateProjectOpts(vars updateProjectVars) (*updateProjectOpts, error) {
+	store, err := store.New()
+	if err != nil {
+		return nil, fmt.Errorf("connect to project datastore: %w", err)
+	}
+	sessProvider := session.NewProvider()
+	sess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+
+	return &updateProjectOpts{
+		updateProjectVars: vars,
+		store:             store,
+		identity:          identity.New(sess),
+		deployer:          cloudformation.New(sess),
+		prog:              termprogress.NewSpinner(),
+		initEnvDeployer: func(env *archer.Environment) (environmentDeployer, error) {
+			envSess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session for environment %s: %w", env.Name, err)
+			}
+			return cloudformation.New(envSess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values passed by the user are invalid.
+func (o *updateProjectOpts) Validate() error {
+	if o.ProjectName() == "" {
+		return errNoProjectInWorkspace
+	}
+	if o.DomainName != "" && o.RemoveDomain {
+		return fmt.Errorf("only one of --%s or --%s may be used", domainNameFlag, removeDomainFlag)
+	}
+	if o.DomainName == "" && !o.RemoveDomain {
+		return errNoDomainUpdate
+	}
+	if _, err := o.store.GetProject(o.ProjectName()); err != nil {
+		return err
+	}
+	if o.RemoveDomain {
+		return nil
+	}
+	// Check the hosted zone of the domain before any stack is updated to use it.
+	return o.store.ValidateDomain(o.DomainName)
+}
+
+// Ask is a no-op for this command.
+func (o *updateProjectOpts) Ask() error {
+	return nil
+}
+
+// Execute updates the project's DNS delegation role and the stacks of all its environments so that
+// their HTTPS resources match the new domain of the project, then stores the domain.
+func (o *updateProjectOpts) Execute() error {
+	project, err := o.store.GetProject(o.ProjectName())
+	if err != nil {
+		return err
+	}
+	if project.Domain == o.DomainName {
+		log.Infof("Project %s is already using the domain %s, no changes made.\n", color.HighlightUserInput(project.Name), color.HighlightUserInput(project.Domain))
+		return nil
+	}
+	envs, err := o.store.ListEnvironments(project.Name)
+	if err != nil {
+		return fmt.Errorf("list environments for project %s: %w", project.Name, err)
+	}
+	caller, err := o.identity.Get()
+	if err != nil {
+		return fmt.Errorf("get identity: %w", err)
+	}
+
+	if o.RemoveDomain {
+		if err := o.checkHTTPSListenerImporters(project, envs); err != nil {
+			return err
+		}
+	}
+
+	project.Domain = o.DomainName
+	if err := o.updateStacks(project, envs, caller.RootUserARN); err != nil {
+		return err
+	}
+	// The domain is only stored once all the stacks use it, so that running the command again after a failure
+	// updates the remaining stacks.
+	if err := o.store.UpdateProject(project); err != nil {
+		return fmt.Errorf("update project %s: %w", project.Name, err)
+	}
+	return nil
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *updateProjectOpts) RecommendedActions() []string {
+	return []string{
+		fmt.Sprintf("Run %s to update the routing of your applications with the project's domain.", color.HighlightCode("ecs-preview app deploy")),
+	}
+}
+
+// checkHTTPSListenerImporters returns an error if the HTTPS listener of an environment is still used by applications,
+// since the environment's stack can't remove an export that other stacks import.
+func (o *updateProjectOpts) checkHTTPSListenerImporters(project *archer.Project, envs []*archer.Environment) error {
+	for _, env := range envs {
+		deployer, err := o.initEnvDeployer(env)
+		if err != nil {
+			return err
+		}
+		importers, err := deployer.HTTPSListenerImporters(project.Name, env.Name)
+		if err != nil {
+			return fmt.Errorf("check the applications using the HTTPS listener of environment %s: %w", env.Name, err)
+		}
+		if len(importers) != 0 {
+			return fmt.Errorf("cannot remove the domain while the HTTPS listener of environment %s is used by the stacks %s: run %s for their applications first",
+				env.Name, strings.Join(importers, ", "), color.HighlightCode("ecs-preview app delete"))
+		}
+	}
+	return nil
+}
+
+func (o *updateProjectOpts) updateStacks(project *archer.Project, envs []*archer.Environment, toolsAccountPrincipalARN string) error {
+	if o.RemoveDomain {
+		// The environments delegate their subdomains from the project's hosted zone,
+		// so they have to release them before the project's DNS resources are removed.
+		if err := o.updateEnvs(project, envs, toolsAccountPrincipalARN); err != nil {
+			return err
+		}
+		return o.updateProject(project, envs)
+	}
+	if err := o.updateProject(project, envs); err != nil {
+		return err
+	}
+	return o.updateEnvs(project, envs, toolsAccountPrincipalARN)
+}
+
+func (o *updateProjectOpts) updateProject(project *archer.Project, envs []*archer.Environment) error {
+	var accountIDs []string
+	for _, env := range envs {
+		accountIDs = append(accountIDs, env.AccountID)
+	}
+	o.prog.Start(fmt.Sprintf(fmtUpdateProjectDomainStart, color.HighlightUserInput(project.Name)))
+	if err := o.deployer.UpdateProjectDomain(project, accountIDs); err != nil {
+		o.prog.Stop(log.Serrorf(fmtUpdateProjectDomainFailed, color.HighlightUserInput(project.Name)))
+		return err
+	}
+	o.prog.Stop(log.Ssuccessf(fmtUpdateProjectDomainComplete, color.HighlightUserInput(project.Name)))
+	return nil
+}
+
+func (o *updateProjectOpts) updateEnvs(project *archer.Project, envs []*archer.Environment, toolsAccountPrincipalARN string) error {
+	for _, env := range envs {
+		deployer, err := o.initEnvDeployer(env)
+		if err != nil {
+			return err
+		}
+		o.prog.Start(fmt.Sprintf(fmtUpdateEnvDomainStart, color.HighlightUserInput(env.Name)))
+		err = deployer.UpdateEnvironmentDomain(&deploy.CreateEnvironmentInput{
+			Project:                  project.Name,
+			Name:                     env.Name,
+			ToolsAccountPrincipalARN: toolsAccountPrincipalARN,
+			ProjectDNSName:           project.Domain,
+		})
+		if err != nil {
+			o.prog.Stop(log.Serrorf(fmtUpdateEnvDomainFailed, color.HighlightUserInput(env.Name)))
+			return err
+		}
+		o.prog.Stop(log.Ssuccessf(fmtUpdateEnvDomainComplete, color.HighlightUserInput(env.Name)))
+	}
+	return nil
+}
+
+// BuildProjectUpdateCommand builds the command for updating the domain of an existing project.
+func BuildProjectUpdateCommand() *cobra.Command {
+	vars := updateProjectVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Updates the domain of the project.",
+		Long: `Updates the domain of the project.
+The environments of the project are updated to provision or remove their HTTPS listener and certificate.`,
+		Example: `
+  Use the domain "example.com" for the project.
+  /code $ ecs-preview project update --domain example.com
+  Stop using a domain for the project.
+  /code $ ecs-preview project update --remove-domain`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newUpdateProjectOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			if opts.RemoveDomain {
+				return nil
+			}
+			log.Infoln()
+			log.Infoln("Recommended follow-up actions:")
+			for _, followUp := range opts.RecommendedActions() {
+				log.Infof("- %s\n", followUp)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVar(&vars.DomainName, domainNameFlag, "", domainNameFlagDescription)
+	cmd.Flags().BoolVar(&vars.RemoveDomain, removeDomainFlag, false, removeDomainFlagDescription)
+	return cmd
+}
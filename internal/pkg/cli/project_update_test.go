@@ -0,0 +1,358 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/identity"
+	climocks "github.com/aws/amazon-ecs-cli-v2/internal/pkg/cli/mocks"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateProjectOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inProjectName  string
+		inDomainName   string
+		inRemoveDomain bool
+
+		mockStore func(m *climocks.MockprojectDomainStore)
+
+		wantedError error
+	}{
+		"no project in workspace": {
+			inDomainName: "example.com",
+			mockStore:    func(m *climocks.MockprojectDomainStore) {},
+
+			wantedError: errNoProjectInWorkspace,
+		},
+		"both domain flags": {
+			inProjectName:  "phonetool",
+			inDomainName:   "example.com",
+			inRemoveDomain: true,
+			mockStore:      func(m *climocks.MockprojectDomainStore) {},
+
+			wantedError: errors.New("only one of --domain or --remove-domain may be used"),
+		},
+		"no domain flag": {
+			inProjectName: "phonetool",
+			mockStore:     func(m *climocks.MockprojectDomainStore) {},
+
+			wantedError: errNoDomainUpdate,
+		},
+		"project does not exist": {
+			inProjectName: "phonetool",
+			inDomainName:  "example.com",
+			mockStore: func(m *climocks.MockprojectDomainStore) {
+				m.EXPECT().GetProject("phonetool").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"no hosted zone for the domain": {
+			inProjectName: "phonetool",
+			inDomainName:  "example.com",
+			mockStore: func(m *climocks.MockprojectDomainStore) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool"}, nil)
+				m.EXPECT().ValidateDomain("example.com").Return(errors.New("no hosted zone found for example.com"))
+			},
+
+			wantedError: errors.New("no hosted zone found for example.com"),
+		},
+		"valid domain": {
+			inProjectName: "phonetool",
+			inDomainName:  "example.com",
+			mockStore: func(m *climocks.MockprojectDomainStore) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool"}, nil)
+				m.EXPECT().ValidateDomain("example.com").Return(nil)
+			},
+		},
+		"valid flags": {
+			inProjectName:  "phonetool",
+			inRemoveDomain: true,
+			mockStore: func(m *climocks.MockprojectDomainStore) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := climocks.NewMockprojectDomainStore(ctrl)
+			tc.mockStore(mockStore)
+			opts := &updateProjectOpts{
+				updateProjectVars: updateProjectVars{
+					GlobalOpts:   &GlobalOpts{projectName: tc.inProjectName},
+					DomainName:   tc.inDomainName,
+					RemoveDomain: tc.inRemoveDomain,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpdateProjectOpts_Execute(t *testing.T) {
+	testEnvs := []*archer.Environment{
+		{Project: "phonetool", Name: "test", AccountID: "1234"},
+		{Project: "phonetool", Name: "prod", AccountID: "5678"},
+	}
+	mockIdentity := func(m *climocks.MockidentityService) {
+		m.EXPECT().Get().Return(identity.Caller{RootUserARN: "arn:aws:iam::1234:root"}, nil)
+	}
+	testCases := map[string]struct {
+		inDomainName   string
+		inRemoveDomain bool
+
+		mockStore        func(m *climocks.MockprojectDomainStore)
+		mockIdentity     func(m *climocks.MockidentityService)
+		mockProjDeployer func(m *climocks.MockprojectDeployer)
+		mockEnvDeployer  func(m *climocks.MockenvironmentDeployer)
+
+		wantedError error
+	}{
+		"no-op if the project already uses the domain": {
+			inDomainName: "example.com",
+			mockStore: func(m *climocks.MockprojectDomainStore) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool", Domain: "example.com"}, nil)
+			},
+			mockIdentity:     func(m *climocks.MockidentityService) {},
+			mockProjDeployer: func(m *climocks.MockprojectDeployer) {},
+			mockEnvDeployer:  func(m *climocks.MockenvironmentDeployer) {},
+		},
+		"returns error if fail to update the project record": {
+			inDomainName: "example.com",
+			mockStore: func(m *climocks.MockprojectDomainStore) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool"}, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return(testEnvs, nil)
+				m.EXPECT().UpdateProject(&archer.Project{Name: "phonetool", Domain: "example.com"}).Return(errors.New("some error"))
+			},
+			mockIdentity: mockIdentity,
+			mockProjDeployer: func(m *climocks.MockprojectDeployer) {
+				m.EXPECT().UpdateProjectDomain(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			mockEnvDeployer: func(m *climocks.MockenvironmentDeployer) {
+				m.EXPECT().UpdateEnvironmentDomain(gomock.Any()).Return(nil).Times(2)
+			},
+
+			wantedError: errors.New("update project phonetool: some error"),
+		},
+		"adds the domain to the project then to its environments": {
+			inDomainName: "example.com",
+			mockStore: func(m *climocks.MockprojectDomainStore) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool"}, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return(testEnvs, nil)
+				m.EXPECT().UpdateProject(&archer.Project{Name: "phonetool", Domain: "example.com"}).Return(nil)
+			},
+			mockIdentity: mockIdentity,
+			mockProjDeployer: func(m *climocks.MockprojectDeployer) {
+				m.EXPECT().UpdateProjectDomain(&archer.Project{Name: "phonetool", Domain: "example.com"}, []string{"1234", "5678"}).Return(nil)
+			},
+			mockEnvDeployer: func(m *climocks.MockenvironmentDeployer) {
+				for _, env := range []string{"test", "prod"} {
+					m.EXPECT().UpdateEnvironmentDomain(&deploy.CreateEnvironmentInput{
+						Project:                  "phonetool",
+						Name:                     env,
+						ToolsAccountPrincipalARN: "arn:aws:iam::1234:root",
+						ProjectDNSName:           "example.com",
+					}).Return(nil)
+				}
+			},
+		},
+		"returns error if fail to update the project stack": {
+			inDomainName: "example.com",
+			mockStore: func(m *climocks.MockprojectDomainStore) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool"}, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return(testEnvs, nil)
+				// The domain isn't stored if the stacks don't use it.
+				m.EXPECT().UpdateProject(gomock.Any()).Times(0)
+			},
+			mockIdentity: mockIdentity,
+			mockProjDeployer: func(m *climocks.MockprojectDeployer) {
+				m.EXPECT().UpdateProjectDomain(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			mockEnvDeployer: func(m *climocks.MockenvironmentDeployer) {},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns error if fail to update an environment stack": {
+			inDomainName: "example.com",
+			mockStore: func(m *climocks.MockprojectDomainStore) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool"}, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return(testEnvs, nil)
+				m.EXPECT().UpdateProject(gomock.Any()).Times(0)
+			},
+			mockIdentity: mockIdentity,
+			mockProjDeployer: func(m *climocks.MockprojectDeployer) {
+				m.EXPECT().UpdateProjectDomain(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			mockEnvDeployer: func(m *climocks.MockenvironmentDeployer) {
+				gomock.InOrder(
+					m.EXPECT().UpdateEnvironmentDomain(gomock.Any()).Return(nil),
+					m.EXPECT().UpdateEnvironmentDomain(gomock.Any()).Return(errors.New("some error")),
+				)
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns error if applications use the HTTPS listener of an environment": {
+			inRemoveDomain: true,
+			mockStore: func(m *climocks.MockprojectDomainStore) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool", Domain: "example.com"}, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return(testEnvs, nil)
+				m.EXPECT().UpdateProject(gomock.Any()).Times(0)
+			},
+			mockIdentity: mockIdentity,
+			mockProjDeployer: func(m *climocks.MockprojectDeployer) {
+				m.EXPECT().UpdateProjectDomain(gomock.Any(), gomock.Any()).Times(0)
+			},
+			mockEnvDeployer: func(m *climocks.MockenvironmentDeployer) {
+				m.EXPECT().HTTPSListenerImporters("phonetool", "test").Return(nil, nil)
+				m.EXPECT().HTTPSListenerImporters("phonetool", "prod").Return([]string{"phonetool-prod-frontend", "phonetool-prod-api"}, nil)
+				m.EXPECT().UpdateEnvironmentDomain(gomock.Any()).Times(0)
+			},
+
+			wantedError: errors.New("cannot remove the domain while the HTTPS listener of environment prod is used by the stacks phonetool-prod-frontend, phonetool-prod-api: run `ecs-preview app delete` for their applications first"),
+		},
+		"returns error if fail to check the applications using the HTTPS listener": {
+			inRemoveDomain: true,
+			mockStore: func(m *climocks.MockprojectDomainStore) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool", Domain: "example.com"}, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return(testEnvs[:1], nil)
+			},
+			mockIdentity:     mockIdentity,
+			mockProjDeployer: func(m *climocks.MockprojectDeployer) {},
+			mockEnvDeployer: func(m *climocks.MockenvironmentDeployer) {
+				m.EXPECT().HTTPSListenerImporters("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("check the applications using the HTTPS listener of environment test: some error"),
+		},
+		"removes the domain from the environments before the project": {
+			inRemoveDomain: true,
+			mockStore: func(m *climocks.MockprojectDomainStore) {
+				m.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool", Domain: "example.com"}, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return(testEnvs[:1], nil)
+				m.EXPECT().UpdateProject(gomock.Any()).Times(0)
+			},
+			mockIdentity: mockIdentity,
+			mockProjDeployer: func(m *climocks.MockprojectDeployer) {
+				m.EXPECT().UpdateProjectDomain(gomock.Any(), gomock.Any()).Times(0)
+			},
+			mockEnvDeployer: func(m *climocks.MockenvironmentDeployer) {
+				m.EXPECT().HTTPSListenerImporters("phonetool", "test").Return(nil, nil)
+				m.EXPECT().UpdateEnvironmentDomain(&deploy.CreateEnvironmentInput{
+					Project:                  "phonetool",
+					Name:                     "test",
+					ToolsAccountPrincipalARN: "arn:aws:iam::1234:root",
+				}).Return(errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := climocks.NewMockprojectDomainStore(ctrl)
+			mockIdentity := climocks.NewMockidentityService(ctrl)
+			mockProjDeployer := climocks.NewMockprojectDeployer(ctrl)
+			mockEnvDeployer := climocks.NewMockenvironmentDeployer(ctrl)
+			mockProgress := climocks.NewMockprogress(ctrl)
+			tc.mockStore(mockStore)
+			tc.mockIdentity(mockIdentity)
+			tc.mockProjDeployer(mockProjDeployer)
+			tc.mockEnvDeployer(mockEnvDeployer)
+			mockProgress.EXPECT().Start(gomock.Any()).AnyTimes()
+			mockProgress.EXPECT().Stop(gomock.Any()).AnyTimes()
+
+			opts := &updateProjectOpts{
+				updateProjectVars: updateProjectVars{
+					GlobalOpts:   &GlobalOpts{projectName: "phonetool"},
+					DomainName:   tc.inDomainName,
+					RemoveDomain: tc.inRemoveDomain,
+				},
+				store:    mockStore,
+				identity: mockIdentity,
+				deployer: mockProjDeployer,
+				prog:     mockProgress,
+				initEnvDeployer: func(env *archer.Environment) (environmentDeployer, error) {
+					return mockEnvDeployer, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpdateProjectOpts_Execute_Progress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := climocks.NewMockprojectDomainStore(ctrl)
+	mockIdentity := climocks.NewMockidentityService(ctrl)
+	mockProjDeployer := climocks.NewMockprojectDeployer(ctrl)
+	mockEnvDeployer := climocks.NewMockenvironmentDeployer(ctrl)
+	mockProgress := climocks.NewMockprogress(ctrl)
+	mockStore.EXPECT().GetProject("phonetool").Return(&archer.Project{Name: "phonetool"}, nil)
+	mockStore.EXPECT().ListEnvironments("phonetool").Return([]*archer.Environment{{Name: "test", AccountID: "1234"}}, nil)
+	mockStore.EXPECT().UpdateProject(gomock.Any()).Return(nil)
+	mockIdentity.EXPECT().Get().Return(identity.Caller{RootUserARN: "arn:aws:iam::1234:root"}, nil)
+	mockProjDeployer.EXPECT().UpdateProjectDomain(gomock.Any(), gomock.Any()).Return(nil)
+	mockEnvDeployer.EXPECT().UpdateEnvironmentDomain(gomock.Any()).Return(nil)
+	gomock.InOrder(
+		mockProgress.EXPECT().Start(fmt.Sprintf(fmtUpdateProjectDomainStart, "phonetool")),
+		mockProgress.EXPECT().Stop(log.Ssuccessf(fmtUpdateProjectDomainComplete, "phonetool")),
+		mockProgress.EXPECT().Start(fmt.Sprintf(fmtUpdateEnvDomainStart, "test")),
+		mockProgress.EXPECT().Stop(log.Ssuccessf(fmtUpdateEnvDomainComplete, "test")),
+	)
+	opts := &updateProjectOpts{
+		updateProjectVars: updateProjectVars{
+			GlobalOpts: &GlobalOpts{projectName: "phonetool"},
+			DomainName: "example.com",
+		},
+		store:    mockStore,
+		identity: mockIdentity,
+		deployer: mockProjDeployer,
+		prog:     mockProgress,
+		initEnvDeployer: func(env *archer.Environment) (environmentDeployer, error) {
+			return mockEnvDeployer, nil
+		},
+	}
+
+	require.NoError(t, opts.Execute())
+}
@@ -53,6 +53,13 @@ func validateApplicationType(val interface{}) error {
 	return fmt.Errorf("invalid app type %s: must be one of %s", appType, strings.Join(prettyTypes, ", "))
 }
 
+func validatePipelineName(val interface{}) error {
+	if err := basicNameValidation(val); err != nil {
+		return fmt.Errorf("pipeline name %v is invalid: %w", val, err)
+	}
+	return nil
+}
+
 func validateEnvironmentName(val interface{}) error {
 	if err := basicNameValidation(val); err != nil {
 		return fmt.Errorf("environment name %v is invalid: %w", val, err)
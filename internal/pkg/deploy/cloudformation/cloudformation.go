@@ -335,6 +335,20 @@ func stackDoesNotExist(err error) bool {
 	return false
 }
 
+// exportNotImported returns true if the underlying error is an export that doesn't exist or isn't imported by any stack.
+func exportNotImported(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		switch aerr.Code() {
+		case "ValidationError":
+			// A ValidationError occurs if we list the imports of an export which isn't imported.
+			if strings.Contains(aerr.Message(), "is not imported by any stack") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // stackSetExists returns true if the underlying error is a stack already exists error.
 func stackSetExists(err error) bool {
 	if aerr, ok := err.(awserr.Error); ok {
@@ -53,6 +53,11 @@ type mockCloudFormation struct {
 	mockWaitUntilStackUpdateCompleteWithContext     func(t *testing.T, in *cloudformation.DescribeStacksInput) error
 	mockWaitUntilStackDeleteComplete                func(t *testing.T, in *cloudformation.DescribeStacksInput) error
 	mockWaitUntilStackDeleteCompleteWithContext     func(t *testing.T, in *cloudformation.DescribeStacksInput) error
+	mockListImports                                 func(t *testing.T, in *cloudformation.ListImportsInput) (*cloudformation.ListImportsOutput, error)
+}
+
+func (cf mockCloudFormation) ListImports(in *cloudformation.ListImportsInput) (*cloudformation.ListImportsOutput, error) {
+	return cf.mockListImports(cf.t, in)
 }
 
 func (cf mockCloudFormation) CreateChangeSet(in *cloudformation.CreateChangeSetInput) (*cloudformation.CreateChangeSetOutput, error) {
@@ -5,12 +5,18 @@
 package cloudformation
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 )
 
+// fmtHTTPSListenerExportName is the name of the export of the HTTPS listener of an environment's stack.
+const fmtHTTPSListenerExportName = "%s-HTTPSListenerArn"
+
 // DeployEnvironment creates the CloudFormation stack for an environment by creating and executing a change set.
 //
 // If the deployment succeeds, returns nil.
@@ -21,6 +27,65 @@ func (cf CloudFormation) DeployEnvironment(env *deploy.CreateEnvironmentInput) e
 	return cf.create(stack.NewEnvStackConfig(env, cf.box))
 }
 
+// UpdateEnvironment updates the CloudFormation stack of an existing environment and waits for the update to complete.
+// If there are no changes to the stack, returns nil.
+func (cf CloudFormation) UpdateEnvironment(env *deploy.CreateEnvironmentInput) error {
+	conf := stack.NewEnvStackConfig(env, cf.box)
+	if err := cf.update(conf); err != nil {
+		if err == errChangeSetEmpty {
+			return nil
+		}
+		return fmt.Errorf("update environment %s: %w", env.Name, err)
+	}
+	return cf.client.WaitUntilStackUpdateCompleteWithContext(context.Background(),
+		&cloudformation.DescribeStacksInput{
+			StackName: aws.String(conf.StackName()),
+		}, cf.waiters...)
+}
+
+// UpdateEnvironmentDomain updates the project domain of an existing environment's CloudFormation stack,
+// keeping the previous values of its other parameters, and waits for the update to complete.
+// If there are no changes to the stack, returns nil.
+func (cf CloudFormation) UpdateEnvironmentDomain(env *deploy.CreateEnvironmentInput) error {
+	describeStack := &cloudformation.DescribeStacksInput{
+		StackName: aws.String(stack.NameForEnv(env.Project, env.Name)),
+	}
+	envStack, err := cf.describeStack(describeStack)
+	if err != nil {
+		return fmt.Errorf("get existing stack of environment %s: %w", env.Name, err)
+	}
+	if err := cf.update(stack.NewEnvDomainStackConfig(env, envStack.Parameters, cf.box)); err != nil {
+		if err == errChangeSetEmpty {
+			return nil
+		}
+		return fmt.Errorf("update domain of environment %s: %w", env.Name, err)
+	}
+	return cf.client.WaitUntilStackUpdateCompleteWithContext(context.Background(), describeStack, cf.waiters...)
+}
+
+// HTTPSListenerImporters returns the names of the stacks, like the stacks of the applications deployed with HTTPS,
+// that import the HTTPS listener exported by an environment's stack.
+func (cf CloudFormation) HTTPSListenerImporters(projectName, envName string) ([]string, error) {
+	in := &cloudformation.ListImportsInput{
+		ExportName: aws.String(fmt.Sprintf(fmtHTTPSListenerExportName, stack.NameForEnv(projectName, envName))),
+	}
+	var names []string
+	for {
+		out, err := cf.client.ListImports(in)
+		if err != nil {
+			if exportNotImported(err) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("list stacks importing %s: %w", aws.StringValue(in.ExportName), err)
+		}
+		names = append(names, aws.StringValueSlice(out.Imports)...)
+		if out.NextToken == nil {
+			return names, nil
+		}
+		in.NextToken = out.NextToken
+	}
+}
+
 // StreamEnvironmentCreation streams resource update events while a deployment is taking place.
 // Once the CloudFormation stack operation halts, the update channel is closed and a
 // CreateEnvironmentResponse is sent to the second channel.
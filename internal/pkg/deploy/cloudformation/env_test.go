@@ -13,6 +13,7 @@ import (
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 
 	"github.com/gobuffalo/packd"
@@ -174,6 +175,295 @@ func TestStreamEnvironmentCreation(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_UpdateEnvironment(t *testing.T) {
+	existingStack := func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+		require.Equal(t, "phonetool-test", aws.StringValue(in.StackName))
+		return &cloudformation.DescribeStacksOutput{
+			Stacks: []*cloudformation.Stack{
+				{
+					StackStatus: aws.String(cloudformation.StackStatusCreateComplete),
+				},
+			},
+		}, nil
+	}
+	createChangeSet := func(t *testing.T, in *cloudformation.CreateChangeSetInput) (*cloudformation.CreateChangeSetOutput, error) {
+		require.Equal(t, cloudformation.ChangeSetTypeUpdate, aws.StringValue(in.ChangeSetType))
+		return &cloudformation.CreateChangeSetOutput{
+			Id:      aws.String("1234"),
+			StackId: aws.String("phonetool-test"),
+		}, nil
+	}
+	testCases := map[string]struct {
+		mockDescribeStacks                              func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error)
+		mockCreateChangeSet                             func(t *testing.T, in *cloudformation.CreateChangeSetInput) (*cloudformation.CreateChangeSetOutput, error)
+		mockWaitUntilChangeSetCreateCompleteWithContext func(t *testing.T, in *cloudformation.DescribeChangeSetInput) error
+		mockDescribeChangeSet                           func(t *testing.T, in *cloudformation.DescribeChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error)
+		mockDeleteChangeSet                             func(t *testing.T, in *cloudformation.DeleteChangeSetInput) (*cloudformation.DeleteChangeSetOutput, error)
+		mockExecuteChangeSet                            func(t *testing.T, in *cloudformation.ExecuteChangeSetInput) (*cloudformation.ExecuteChangeSetOutput, error)
+		mockWaitUntilStackUpdateCompleteWithContext     func(t *testing.T, in *cloudformation.DescribeStacksInput) error
+
+		wantedError error
+	}{
+		"stack does not exist": {
+			mockDescribeStacks: func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+				return nil, errors.New("some error")
+			},
+
+			wantedError: errors.New("update environment test: some error"),
+		},
+		"succeeds without waiting if there are no changes": {
+			mockDescribeStacks:  existingStack,
+			mockCreateChangeSet: createChangeSet,
+			mockWaitUntilChangeSetCreateCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) error {
+				return errors.New("some changeset error")
+			},
+			mockDescribeChangeSet: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error) {
+				return &cloudformation.DescribeChangeSetOutput{
+					Changes:         []*cloudformation.Change{},
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+					StatusReason:    aws.String(""),
+				}, nil
+			},
+			mockDeleteChangeSet: func(t *testing.T, in *cloudformation.DeleteChangeSetInput) (*cloudformation.DeleteChangeSetOutput, error) {
+				return &cloudformation.DeleteChangeSetOutput{}, nil
+			},
+		},
+		"updates the stack and waits for completion": {
+			mockDescribeStacks:  existingStack,
+			mockCreateChangeSet: createChangeSet,
+			mockWaitUntilChangeSetCreateCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) error {
+				return nil
+			},
+			mockDescribeChangeSet: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error) {
+				return &cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+				}, nil
+			},
+			mockExecuteChangeSet: func(t *testing.T, in *cloudformation.ExecuteChangeSetInput) (*cloudformation.ExecuteChangeSetOutput, error) {
+				return &cloudformation.ExecuteChangeSetOutput{}, nil
+			},
+			mockWaitUntilStackUpdateCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeStacksInput) error {
+				require.Equal(t, "phonetool-test", aws.StringValue(in.StackName))
+				return nil
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			box := boxWithTemplateFile()
+			box.AddString("custom-resources/dns-cert-validator.js", "cert")
+			box.AddString("custom-resources/dns-delegation.js", "delegation")
+			cf := CloudFormation{
+				client: &mockCloudFormation{
+					t:                   t,
+					mockDescribeStacks:  tc.mockDescribeStacks,
+					mockCreateChangeSet: tc.mockCreateChangeSet,
+					mockWaitUntilChangeSetCreateCompleteWithContext: tc.mockWaitUntilChangeSetCreateCompleteWithContext,
+					mockDescribeChangeSet:                           tc.mockDescribeChangeSet,
+					mockDeleteChangeSet:                             tc.mockDeleteChangeSet,
+					mockExecuteChangeSet:                            tc.mockExecuteChangeSet,
+					mockWaitUntilStackUpdateCompleteWithContext:     tc.mockWaitUntilStackUpdateCompleteWithContext,
+				},
+				box: box,
+			}
+
+			// WHEN
+			err := cf.UpdateEnvironment(&deploy.CreateEnvironmentInput{
+				Project: "phonetool",
+				Name:    "test",
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_UpdateEnvironmentDomain(t *testing.T) {
+	existingStack := func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+		require.Equal(t, "phonetool-test", aws.StringValue(in.StackName))
+		return &cloudformation.DescribeStacksOutput{
+			Stacks: []*cloudformation.Stack{
+				{
+					StackStatus: aws.String(cloudformation.StackStatusUpdateComplete),
+					Parameters: []*cloudformation.Parameter{
+						{
+							ParameterKey:   aws.String("ProjectName"),
+							ParameterValue: aws.String("phonetool"),
+						},
+						{
+							ParameterKey:   aws.String("ProjectDNSName"),
+							ParameterValue: aws.String(""),
+						},
+					},
+				},
+			},
+		}, nil
+	}
+	testCases := map[string]struct {
+		mockDescribeStacks                          func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error)
+		mockExecuteChangeSet                        func(t *testing.T, in *cloudformation.ExecuteChangeSetInput) (*cloudformation.ExecuteChangeSetOutput, error)
+		mockWaitUntilStackUpdateCompleteWithContext func(t *testing.T, in *cloudformation.DescribeStacksInput) error
+
+		wantedError error
+	}{
+		"stack does not exist": {
+			mockDescribeStacks: func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+				return nil, errors.New("some error")
+			},
+
+			wantedError: errors.New("get existing stack of environment test: some error"),
+		},
+		"wraps error from executing the change set": {
+			mockDescribeStacks: existingStack,
+			mockExecuteChangeSet: func(t *testing.T, in *cloudformation.ExecuteChangeSetInput) (*cloudformation.ExecuteChangeSetOutput, error) {
+				return nil, errors.New("some error")
+			},
+
+			wantedError: errors.New("update domain of environment test: failed to execute changeSet name=1234, stackID=phonetool-test: some error"),
+		},
+		"updates the domain and waits for completion": {
+			mockDescribeStacks: existingStack,
+			mockExecuteChangeSet: func(t *testing.T, in *cloudformation.ExecuteChangeSetInput) (*cloudformation.ExecuteChangeSetOutput, error) {
+				return &cloudformation.ExecuteChangeSetOutput{}, nil
+			},
+			mockWaitUntilStackUpdateCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeStacksInput) error {
+				require.Equal(t, "phonetool-test", aws.StringValue(in.StackName))
+				return nil
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			box := boxWithTemplateFile()
+			box.AddString("custom-resources/dns-cert-validator.js", "cert")
+			box.AddString("custom-resources/dns-delegation.js", "delegation")
+			cf := CloudFormation{
+				client: &mockCloudFormation{
+					t:                  t,
+					mockDescribeStacks: tc.mockDescribeStacks,
+					mockCreateChangeSet: func(t *testing.T, in *cloudformation.CreateChangeSetInput) (*cloudformation.CreateChangeSetOutput, error) {
+						require.Equal(t, cloudformation.ChangeSetTypeUpdate, aws.StringValue(in.ChangeSetType))
+						require.Equal(t, []*cloudformation.Parameter{
+							{
+								ParameterKey:     aws.String("ProjectName"),
+								UsePreviousValue: aws.Bool(true),
+							},
+							{
+								ParameterKey:   aws.String("ProjectDNSName"),
+								ParameterValue: aws.String("phonetool.com"),
+							},
+							{
+								ParameterKey:   aws.String("ProjectDNSDelegationRole"),
+								ParameterValue: aws.String("arn:aws:iam::1234:role/phonetool-DNSDelegationRole"),
+							},
+						}, in.Parameters)
+						return &cloudformation.CreateChangeSetOutput{
+							Id:      aws.String("1234"),
+							StackId: aws.String("phonetool-test"),
+						}, nil
+					},
+					mockWaitUntilChangeSetCreateCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) error {
+						return nil
+					},
+					mockDescribeChangeSet: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error) {
+						return &cloudformation.DescribeChangeSetOutput{
+							ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+						}, nil
+					},
+					mockExecuteChangeSet:                        tc.mockExecuteChangeSet,
+					mockWaitUntilStackUpdateCompleteWithContext: tc.mockWaitUntilStackUpdateCompleteWithContext,
+				},
+				box: box,
+			}
+
+			// WHEN
+			err := cf.UpdateEnvironmentDomain(&deploy.CreateEnvironmentInput{
+				Project:                  "phonetool",
+				Name:                     "test",
+				ToolsAccountPrincipalARN: "arn:aws:iam::1234:root",
+				ProjectDNSName:           "phonetool.com",
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_HTTPSListenerImporters(t *testing.T) {
+	testCases := map[string]struct {
+		mockListImports func(t *testing.T, in *cloudformation.ListImportsInput) (*cloudformation.ListImportsOutput, error)
+
+		wantedImporters []string
+		wantedError     error
+	}{
+		"returns the importing stacks of every page": {
+			mockListImports: func(t *testing.T, in *cloudformation.ListImportsInput) (*cloudformation.ListImportsOutput, error) {
+				require.Equal(t, "phonetool-test-HTTPSListenerArn", aws.StringValue(in.ExportName))
+				if in.NextToken == nil {
+					return &cloudformation.ListImportsOutput{
+						Imports:   aws.StringSlice([]string{"phonetool-test-frontend"}),
+						NextToken: aws.String("token"),
+					}, nil
+				}
+				return &cloudformation.ListImportsOutput{
+					Imports: aws.StringSlice([]string{"phonetool-test-api"}),
+				}, nil
+			},
+
+			wantedImporters: []string{"phonetool-test-frontend", "phonetool-test-api"},
+		},
+		"returns no stacks if the listener is not imported": {
+			mockListImports: func(t *testing.T, in *cloudformation.ListImportsInput) (*cloudformation.ListImportsOutput, error) {
+				return nil, awserr.New("ValidationError", "Export 'phonetool-test-HTTPSListenerArn' is not imported by any stack.", nil)
+			},
+		},
+		"wraps other errors": {
+			mockListImports: func(t *testing.T, in *cloudformation.ListImportsInput) (*cloudformation.ListImportsOutput, error) {
+				return nil, errors.New("some error")
+			},
+
+			wantedError: errors.New("list stacks importing phonetool-test-HTTPSListenerArn: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			cf := CloudFormation{
+				client: &mockCloudFormation{
+					t:               t,
+					mockListImports: tc.mockListImports,
+				},
+			}
+
+			// WHEN
+			importers, err := cf.HTTPSListenerImporters("phonetool", "test")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedImporters, importers)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_DeleteEnvironment(t *testing.T) {
 	const (
 		testProject = "phonetool"
@@ -32,6 +32,16 @@ func (err *ErrStackNotFound) Error() string {
 	return fmt.Sprintf("failed to find a stack named %s", err.stackName)
 }
 
+// ErrNotPipelineStack occurs when we try to delete a pipeline but the stack with its name isn't a pipeline of the project.
+type ErrNotPipelineStack struct {
+	stackName   string
+	projectName string
+}
+
+func (err *ErrNotPipelineStack) Error() string {
+	return fmt.Sprintf("stack %s is not a pipeline of project %s", err.stackName, err.projectName)
+}
+
 // ErrStackUpdateInProgress occurs when we try to update a stack that's already being updated.
 type ErrStackUpdateInProgress struct {
 	stackName   string
@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
@@ -16,6 +17,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 )
 
+// pipelineNamePrefix is the prefix of the names given to pipelines by "pipeline init".
+const pipelineNamePrefix = "pipeline-"
+
 // PipelineExists checks if the pipeline with the provided config exists.
 func (cf CloudFormation) PipelineExists(in *deploy.CreatePipelineInput) (bool, error) {
 	stackConfig := stack.NewPipelineStackConfig(in)
@@ -63,3 +67,52 @@ func (cf CloudFormation) UpdatePipeline(in *deploy.CreatePipelineInput) error {
 			StackName: aws.String(pipelineConfig.StackName()),
 		}, cf.waiters...)
 }
+
+// DeletePipeline removes the CodePipeline stack of a pipeline in the project.
+// It returns nil if the stack doesn't exist, and an ErrNotPipelineStack if the stack isn't a pipeline of the project.
+func (cf CloudFormation) DeletePipeline(projectName, pipelineName string) error {
+	pipelineConfig := stack.NewPipelineStackConfig(&deploy.CreatePipelineInput{
+		ProjectName: projectName,
+		Name:        pipelineName,
+	})
+	existingStack, err := cf.describeStack(&cloudformation.DescribeStacksInput{
+		StackName: aws.String(pipelineConfig.StackName()),
+	})
+	if err != nil {
+		var stackNotFound *ErrStackNotFound
+		if errors.As(err, &stackNotFound) {
+			return nil
+		}
+		return fmt.Errorf("describe stack %s: %w", pipelineConfig.StackName(), err)
+	}
+	if !isPipelineStack(existingStack, projectName, pipelineName) {
+		return &ErrNotPipelineStack{
+			stackName:   pipelineConfig.StackName(),
+			projectName: projectName,
+		}
+	}
+	return cf.delete(pipelineConfig.StackName())
+}
+
+// isPipelineStack returns true if the stack deploys the pipeline of the project.
+// Pipeline stacks deployed before they were tagged with their pipeline name are recognized by the prefix of their pipeline name,
+// as long as they aren't tagged with an environment or an application.
+func isPipelineStack(s *cloudformation.Stack, projectName, pipelineName string) bool {
+	tags := make(map[string]string)
+	for _, t := range s.Tags {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	if tags[stack.ProjectTagKey] != projectName {
+		return false
+	}
+	if name, ok := tags[stack.PipelineTagKey]; ok {
+		return name == pipelineName
+	}
+	if _, ok := tags[stack.EnvTagKey]; ok {
+		return false
+	}
+	if _, ok := tags[stack.AppTagKey]; ok {
+		return false
+	}
+	return strings.HasPrefix(pipelineName, pipelineNamePrefix)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/stretchr/testify/require"
 )
@@ -36,6 +37,7 @@ func TestCloudFormation_UpdatePipeline(t *testing.T) {
 					Properties: map[string]interface{}{
 						manifest.GithubSecretIdKeyName: "my secret",
 						"repository":                   "github.com/hello/phonetool",
+						"branch":                       "master",
 					},
 				},
 				Stages:          nil,
@@ -113,6 +115,7 @@ func TestCloudFormation_CreatePipeline(t *testing.T) {
 			Properties: map[string]interface{}{
 				manifest.GithubSecretIdKeyName: "my secret",
 				"repository":                   "github.com/hello/phonetool",
+				"branch":                       "master",
 			},
 		},
 		Stages:          nil,
@@ -206,3 +209,115 @@ func TestCloudFormation_CreatePipeline(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudFormation_DeletePipeline(t *testing.T) {
+	pipelineStack := func(tags map[string]string) func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+		return func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+			require.Equal(t, "phonetool-pipeline-phonetool", aws.StringValue(in.StackName))
+			s := &cloudformation.Stack{StackName: in.StackName}
+			for k, v := range tags {
+				s.Tags = append(s.Tags, &cloudformation.Tag{Key: aws.String(k), Value: aws.String(v)})
+			}
+			return &cloudformation.DescribeStacksOutput{Stacks: []*cloudformation.Stack{s}}, nil
+		}
+	}
+	testCases := map[string]struct {
+		mockDescribeStacks                          func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error)
+		mockDeleteStack                             func(t *testing.T, in *cloudformation.DeleteStackInput) (*cloudformation.DeleteStackOutput, error)
+		mockWaitUntilStackDeleteCompleteWithContext func(t *testing.T, in *cloudformation.DescribeStacksInput) error
+
+		wantedError error
+	}{
+		"describe stack fails": {
+			mockDescribeStacks: func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedError: errors.New("describe stack phonetool-pipeline-phonetool: some error"),
+		},
+		"stack doesn't exist": {
+			mockDescribeStacks: func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+				return nil, awserr.New("ValidationError", "Stack with id phonetool-pipeline-phonetool does not exist", nil)
+			},
+		},
+		"refuses to delete an environment stack": {
+			mockDescribeStacks: pipelineStack(map[string]string{
+				stack.ProjectTagKey: "phonetool",
+				stack.EnvTagKey:     "pipeline-phonetool",
+			}),
+			wantedError: errors.New("stack phonetool-pipeline-phonetool is not a pipeline of project phonetool"),
+		},
+		"refuses to delete a stack of another pipeline": {
+			mockDescribeStacks: pipelineStack(map[string]string{
+				stack.ProjectTagKey:  "phonetool",
+				stack.PipelineTagKey: "pipeline-other",
+			}),
+			wantedError: errors.New("stack phonetool-pipeline-phonetool is not a pipeline of project phonetool"),
+		},
+		"refuses to delete a stack of another project": {
+			mockDescribeStacks: pipelineStack(map[string]string{
+				stack.ProjectTagKey:  "other",
+				stack.PipelineTagKey: "pipeline-phonetool",
+			}),
+			wantedError: errors.New("stack phonetool-pipeline-phonetool is not a pipeline of project phonetool"),
+		},
+		"delete stack fails": {
+			mockDescribeStacks: pipelineStack(map[string]string{
+				stack.ProjectTagKey:  "phonetool",
+				stack.PipelineTagKey: "pipeline-phonetool",
+			}),
+			mockDeleteStack: func(t *testing.T, in *cloudformation.DeleteStackInput) (*cloudformation.DeleteStackOutput, error) {
+				return nil, errors.New("some error")
+			},
+			mockWaitUntilStackDeleteCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeStacksInput) error {
+				return nil
+			},
+			wantedError: errors.New("deleting stack phonetool-pipeline-phonetool: some error"),
+		},
+		"deletes stack successfully": {
+			mockDescribeStacks: pipelineStack(map[string]string{
+				stack.ProjectTagKey:  "phonetool",
+				stack.PipelineTagKey: "pipeline-phonetool",
+			}),
+			mockDeleteStack: func(t *testing.T, in *cloudformation.DeleteStackInput) (*cloudformation.DeleteStackOutput, error) {
+				require.Equal(t, "phonetool-pipeline-phonetool", aws.StringValue(in.StackName))
+				return nil, nil
+			},
+			mockWaitUntilStackDeleteCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeStacksInput) error {
+				require.Equal(t, "phonetool-pipeline-phonetool", aws.StringValue(in.StackName))
+				return nil
+			},
+		},
+		"deletes a stack deployed before pipelines were tagged with their name": {
+			mockDescribeStacks: pipelineStack(map[string]string{
+				stack.ProjectTagKey: "phonetool",
+			}),
+			mockDeleteStack: func(t *testing.T, in *cloudformation.DeleteStackInput) (*cloudformation.DeleteStackOutput, error) {
+				return nil, nil
+			},
+			mockWaitUntilStackDeleteCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeStacksInput) error {
+				return nil
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cf := CloudFormation{
+				client: &mockCloudFormation{
+					t:                  t,
+					mockDescribeStacks: tc.mockDescribeStacks,
+					mockDeleteStack:    tc.mockDeleteStack,
+					mockWaitUntilStackDeleteCompleteWithContext: tc.mockWaitUntilStackDeleteCompleteWithContext,
+				},
+			}
+
+			err := cf.DeletePipeline("phonetool", "pipeline-phonetool")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -102,6 +102,35 @@ func (cf CloudFormation) DelegateDNSPermissions(project *archer.Project, account
 	return cf.client.WaitUntilStackUpdateCompleteWithContext(context.Background(), &describeStack, cf.waiters...)
 }
 
+// UpdateProjectDomain updates this project's infrastructure roles stack with the project's domain,
+// and grants the provided account IDs the ability to write to the domain's HostedZone.
+// If there are no changes to the stack, returns nil.
+func (cf CloudFormation) UpdateProjectDomain(project *archer.Project, accountIDs []string) error {
+	deployProject := deploy.CreateProjectInput{
+		Project:    project.Name,
+		AccountID:  project.AccountID,
+		DomainName: project.Domain,
+	}
+	projectConfig := stack.NewProjectStackConfig(&deployProject, cf.box)
+
+	describeStack := cloudformation.DescribeStacksInput{
+		StackName: aws.String(projectConfig.StackName()),
+	}
+	projectStack, err := cf.describeStack(&describeStack)
+	if err != nil {
+		return fmt.Errorf("getting existing project infrastructure stack: %w", err)
+	}
+
+	deployProject.DNSDelegationAccounts = append(stack.DNSDelegatedAccountsForStack(projectStack), accountIDs...)
+	if err := cf.update(stack.NewProjectStackConfig(&deployProject, cf.box)); err != nil {
+		if err == errChangeSetEmpty {
+			return nil
+		}
+		return fmt.Errorf("updating project domain: %w", err)
+	}
+	return cf.client.WaitUntilStackUpdateCompleteWithContext(context.Background(), &describeStack, cf.waiters...)
+}
+
 // GetProjectResourcesByRegion fetches all the regional resources for a particular region.
 func (cf CloudFormation) GetProjectResourcesByRegion(project *archer.Project, region string) (*archer.ProjectRegionalResources, error) {
 	resources, err := cf.getResourcesForStackInstances(project, &region)
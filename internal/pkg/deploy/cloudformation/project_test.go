@@ -1211,6 +1211,129 @@ func TestDelegateDNSPermissions(t *testing.T) {
 	}
 }
 
+func TestUpdateProjectDomain(t *testing.T) {
+	testCases := map[string]struct {
+		project      *archer.Project
+		accountIDs   []string
+		mockCFClient func() *mockCloudFormation
+		want         error
+	}{
+		"updates the stack with the domain and delegated accounts": {
+			project: &archer.Project{
+				AccountID: "1234",
+				Name:      "project",
+				Domain:    "amazon.com",
+			},
+			accountIDs: []string{"4567"},
+			mockCFClient: func() *mockCloudFormation {
+				return &mockCloudFormation{
+					t: t,
+					mockCreateChangeSet: func(t *testing.T, in *cloudformation.CreateChangeSetInput) (*cloudformation.CreateChangeSetOutput, error) {
+						for _, param := range in.Parameters {
+							switch aws.StringValue(param.ParameterKey) {
+							case "ProjectDNSDelegatedAccounts":
+								require.Equal(t, "1234,4567", aws.StringValue(param.ParameterValue))
+							case "ProjectDomainName":
+								require.Equal(t, "amazon.com", aws.StringValue(param.ParameterValue))
+							}
+						}
+						return &cloudformation.CreateChangeSetOutput{
+							StackId: aws.String("stackname"),
+						}, nil
+					},
+					mockExecuteChangeSet: func(t *testing.T, in *cloudformation.ExecuteChangeSetInput) (*cloudformation.ExecuteChangeSetOutput, error) {
+						return nil, nil
+					},
+					mockDescribeStacks: func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+						stack := mockProjectRolesStack("stackname", map[string]string{
+							"ProjectDNSDelegatedAccounts": "1234",
+						})
+						return &cloudformation.DescribeStacksOutput{Stacks: []*cloudformation.Stack{stack}}, nil
+					},
+					mockDescribeChangeSet: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error) {
+						return &cloudformation.DescribeChangeSetOutput{
+							ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+						}, nil
+					},
+					mockWaitUntilChangeSetCreateCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) error {
+						return nil
+					},
+					mockWaitUntilStackUpdateCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeStacksInput) error {
+						return nil
+					},
+				}
+			},
+		},
+		"succeeds without waiting if there are no changes": {
+			project: &archer.Project{
+				AccountID: "1234",
+				Name:      "project",
+			},
+			mockCFClient: func() *mockCloudFormation {
+				return &mockCloudFormation{
+					t: t,
+					mockCreateChangeSet: func(t *testing.T, in *cloudformation.CreateChangeSetInput) (*cloudformation.CreateChangeSetOutput, error) {
+						return &cloudformation.CreateChangeSetOutput{
+							StackId: aws.String("stackname"),
+						}, nil
+					},
+					mockDescribeStacks: func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+						stack := mockProjectRolesStack("stackname", map[string]string{
+							"ProjectDNSDelegatedAccounts": "1234",
+						})
+						return &cloudformation.DescribeStacksOutput{Stacks: []*cloudformation.Stack{stack}}, nil
+					},
+					mockWaitUntilChangeSetCreateCompleteWithContext: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) error {
+						return errors.New("some changeset error")
+					},
+					mockDescribeChangeSet: func(t *testing.T, in *cloudformation.DescribeChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error) {
+						return &cloudformation.DescribeChangeSetOutput{
+							Changes:         []*cloudformation.Change{},
+							ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+							StatusReason:    aws.String(""),
+						}, nil
+					},
+					mockDeleteChangeSet: func(t *testing.T, in *cloudformation.DeleteChangeSetInput) (*cloudformation.DeleteChangeSetOutput, error) {
+						return &cloudformation.DeleteChangeSetOutput{}, nil
+					},
+				}
+			},
+		},
+		"returns error from describe stack": {
+			project: &archer.Project{
+				AccountID: "1234",
+				Name:      "project",
+				Domain:    "amazon.com",
+			},
+			want: fmt.Errorf("getting existing project infrastructure stack: error"),
+			mockCFClient: func() *mockCloudFormation {
+				return &mockCloudFormation{
+					t: t,
+					mockDescribeStacks: func(t *testing.T, in *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+						return nil, fmt.Errorf("error")
+					},
+				}
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cf := CloudFormation{
+				client: tc.mockCFClient(),
+				box:    templates.Box(),
+			}
+			got := cf.UpdateProjectDomain(tc.project, tc.accountIDs)
+
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
 // Useful for mocking a successfully deployed stack
 func getMockSuccessfulDeployCFClient(t *testing.T, stackName string) *mockCloudFormation {
 	times := 0
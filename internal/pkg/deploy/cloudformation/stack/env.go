@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"strconv"
+	"strings"
 	"text/template"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
@@ -37,6 +38,18 @@ const (
 	envParamToolsAccountPrincipalKey    = "ToolsAccountPrincipalARN"
 	envParamProjectDNSKey               = "ProjectDNSName"
 	envParamProjectDNSDelegationRoleKey = "ProjectDNSDelegationRole"
+	envParamImportCertARNKey            = "ImportCertARN"
+	envParamContainerInsightsKey        = "EnableContainerInsights"
+	envParamFargateSpotKey              = "EnableFargateSpot"
+	envParamVPCCIDRKey                  = "VpcCIDR"
+	envParamPublicSubnet1CIDRKey        = "PublicSubnet1CIDR"
+	envParamPublicSubnet2CIDRKey        = "PublicSubnet2CIDR"
+	envParamPrivateSubnet1CIDRKey       = "PrivateSubnet1CIDR"
+	envParamPrivateSubnet2CIDRKey       = "PrivateSubnet2CIDR"
+	envParamVPCEndpointsKey             = "EnableVpcEndpoints"
+	envParamImportVPCIDKey              = "ImportVpcId"
+	envParamImportPublicSubnetIDsKey    = "ImportPublicSubnetIds"
+	envParamImportPrivateSubnetIDsKey   = "ImportPrivateSubnetIds"
 )
 
 // Output keys.
@@ -45,6 +58,13 @@ const (
 	EnvOutputManagerRoleKey            = "EnvironmentManagerRoleARN"
 	EnvOutputPublicLoadBalancerDNSName = "PublicLoadBalancerDNSName"
 	EnvOutputSubdomain                 = "EnvironmentSubdomain"
+	EnvOutputVPCID                     = "VpcId"
+	EnvOutputPublicSubnets             = "PublicSubnets"
+	EnvOutputPrivateSubnets            = "PrivateSubnets"
+	EnvOutputVPCEndpoints              = "VpcEndpoints"
+	EnvOutputClusterID                 = "ClusterId"
+	EnvOutputPublicLoadBalancerARN     = "PublicLoadBalancerArn"
+	EnvOutputHTTPSListenerARN          = "HTTPSListenerArn"
 )
 
 // NewEnvStackConfig sets up a struct which can provide values to CloudFormation for
@@ -96,8 +116,9 @@ func (e *EnvStackConfig) Template() (string, error) {
 }
 
 // Parameters returns the parameters to be passed into a environment CloudFormation template.
+// The VPC parameters are only set if the environment adjusts or imports its VPC, otherwise the template defaults are used.
 func (e *EnvStackConfig) Parameters() []*cloudformation.Parameter {
-	return []*cloudformation.Parameter{
+	params := []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(envParamIncludeLBKey),
 			ParameterValue: aws.String(strconv.FormatBool(e.PublicLoadBalancer)),
@@ -122,7 +143,127 @@ func (e *EnvStackConfig) Parameters() []*cloudformation.Parameter {
 			ParameterKey:   aws.String(envParamProjectDNSDelegationRoleKey),
 			ParameterValue: aws.String(e.dnsDelegationRole()),
 		},
+		{
+			ParameterKey:   aws.String(envParamImportCertARNKey),
+			ParameterValue: aws.String(e.ImportCertARN),
+		},
+		{
+			ParameterKey:   aws.String(envParamContainerInsightsKey),
+			ParameterValue: aws.String(strconv.FormatBool(e.ContainerInsights)),
+		},
+		{
+			ParameterKey:   aws.String(envParamFargateSpotKey),
+			ParameterValue: aws.String(strconv.FormatBool(e.FargateSpot)),
+		},
+	}
+	if e.AdjustVPC != nil {
+		params = append(params, e.adjustVPCParameters()...)
+	}
+	if e.ImportVPC != nil {
+		params = append(params, e.importVPCParameters()...)
+	}
+	return params
+}
+
+func (e *EnvStackConfig) adjustVPCParameters() []*cloudformation.Parameter {
+	params := []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String(envParamVPCCIDRKey),
+			ParameterValue: aws.String(e.AdjustVPC.CIDR),
+		},
+		{
+			ParameterKey:   aws.String(envParamVPCEndpointsKey),
+			ParameterValue: aws.String(strconv.FormatBool(e.AdjustVPC.Endpoints)),
+		},
+	}
+	publicKeys := []string{envParamPublicSubnet1CIDRKey, envParamPublicSubnet2CIDRKey}
+	for i, cidr := range e.AdjustVPC.PublicSubnetCIDRs {
+		if i >= len(publicKeys) {
+			break
+		}
+		params = append(params, &cloudformation.Parameter{
+			ParameterKey:   aws.String(publicKeys[i]),
+			ParameterValue: aws.String(cidr),
+		})
+	}
+	privateKeys := []string{envParamPrivateSubnet1CIDRKey, envParamPrivateSubnet2CIDRKey}
+	for i, cidr := range e.AdjustVPC.PrivateSubnetCIDRs {
+		if i >= len(privateKeys) {
+			break
+		}
+		params = append(params, &cloudformation.Parameter{
+			ParameterKey:   aws.String(privateKeys[i]),
+			ParameterValue: aws.String(cidr),
+		})
+	}
+	return params
+}
+
+func (e *EnvStackConfig) importVPCParameters() []*cloudformation.Parameter {
+	return []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String(envParamImportVPCIDKey),
+			ParameterValue: aws.String(e.ImportVPC.ID),
+		},
+		{
+			ParameterKey:   aws.String(envParamImportPublicSubnetIDsKey),
+			ParameterValue: aws.String(strings.Join(e.ImportVPC.PublicSubnetIDs, ",")),
+		},
+		{
+			ParameterKey:   aws.String(envParamImportPrivateSubnetIDsKey),
+			ParameterValue: aws.String(strings.Join(e.ImportVPC.PrivateSubnetIDs, ",")),
+		},
+	}
+}
+
+// EnvDomainStackConfig is for updating the project domain of an existing environment stack
+// while keeping the previous values of all its other parameters.
+type EnvDomainStackConfig struct {
+	*EnvStackConfig
+	prevParams []*cloudformation.Parameter
+}
+
+// NewEnvDomainStackConfig sets up a struct which can provide values to CloudFormation for
+// updating the project domain of an environment deployed with the parameters prevParams.
+func NewEnvDomainStackConfig(input *deploy.CreateEnvironmentInput, prevParams []*cloudformation.Parameter, box packd.Box) *EnvDomainStackConfig {
+	return &EnvDomainStackConfig{
+		EnvStackConfig: NewEnvStackConfig(input, box),
+		prevParams:     prevParams,
+	}
+}
+
+// Parameters returns the project DNS parameters of the environment with their new values,
+// every other parameter of the existing stack uses its previous value.
+func (e *EnvDomainStackConfig) Parameters() []*cloudformation.Parameter {
+	newValues := map[string]string{
+		envParamProjectDNSKey:               e.ProjectDNSName,
+		envParamProjectDNSDelegationRoleKey: e.dnsDelegationRole(),
+	}
+	var params []*cloudformation.Parameter
+	for _, prev := range e.prevParams {
+		key := aws.StringValue(prev.ParameterKey)
+		if value, ok := newValues[key]; ok {
+			params = append(params, &cloudformation.Parameter{
+				ParameterKey:   aws.String(key),
+				ParameterValue: aws.String(value),
+			})
+			delete(newValues, key)
+			continue
+		}
+		params = append(params, &cloudformation.Parameter{
+			ParameterKey:     aws.String(key),
+			UsePreviousValue: aws.Bool(true),
+		})
+	}
+	for _, key := range []string{envParamProjectDNSKey, envParamProjectDNSDelegationRoleKey} {
+		if value, ok := newValues[key]; ok {
+			params = append(params, &cloudformation.Parameter{
+				ParameterKey:   aws.String(key),
+				ParameterValue: aws.String(value),
+			})
+		}
 	}
+	return params
 }
 
 // Tags returns the tags that should be applied to the environment CloudFormation stack.
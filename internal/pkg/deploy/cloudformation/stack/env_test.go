@@ -6,14 +6,17 @@ package stack
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
+	"github.com/aws/amazon-ecs-cli-v2/templates"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/gobuffalo/packd"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestEnvTemplate(t *testing.T) {
@@ -47,6 +50,121 @@ func TestEnvTemplate(t *testing.T) {
 	}
 }
 
+func TestEnvTemplate_WithoutPublicLoadBalancer(t *testing.T) {
+	// GIVEN
+	input := mockDeployEnvironmentInput()
+	input.PublicLoadBalancer = false
+	envTemplate, err := templates.Box().FindString(EnvTemplatePath)
+	require.NoError(t, err)
+	box := packd.NewMemoryBox()
+	box.AddString(EnvTemplatePath, envTemplate)
+	box.AddString(acmValidationTemplatePath, "customresources")
+	box.AddString(dnsDelegationTemplatePath, "customresources")
+	envStack := NewEnvStackConfig(input, box)
+
+	// WHEN
+	tpl, err := envStack.Template()
+	params := envStack.Parameters()
+
+	// THEN
+	require.NoError(t, err)
+	require.Contains(t, params, &cloudformation.Parameter{
+		ParameterKey:   aws.String(envParamIncludeLBKey),
+		ParameterValue: aws.String("false"),
+	})
+	var parsed struct {
+		Resources map[string]struct {
+			Condition  string    `yaml:"Condition"`
+			Properties yaml.Node `yaml:"Properties"`
+		} `yaml:"Resources"`
+		Outputs map[string]struct {
+			Condition string    `yaml:"Condition"`
+			Value     yaml.Node `yaml:"Value"`
+		} `yaml:"Outputs"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(tpl), &parsed))
+	// Every resource and output that depends on the public load balancer must only be created along with it.
+	lbConditions := map[string]bool{
+		"CreatePublicLoadBalancer":    true,
+		"ExportHTTPSListener":         true,
+		"DelegateDNSWithImportedCert": true,
+	}
+	refersToLB := func(node yaml.Node) bool {
+		if node.Kind == 0 {
+			return false
+		}
+		out, err := yaml.Marshal(&node)
+		require.NoError(t, err)
+		for _, name := range []string{"PublicLoadBalancer", "HTTPListener", "HTTPSListener", "DefaultHTTPTargetGroup"} {
+			if strings.Contains(string(out), "!Ref "+name+"\n") || strings.Contains(string(out), "!GetAtt "+name+".") {
+				return true
+			}
+		}
+		return false
+	}
+	for name, resource := range parsed.Resources {
+		if refersToLB(resource.Properties) {
+			require.True(t, lbConditions[resource.Condition], "resource %s refers to the public load balancer without a condition", name)
+		}
+	}
+	for name, output := range parsed.Outputs {
+		if refersToLB(output.Value) {
+			require.True(t, lbConditions[output.Condition], "output %s refers to the public load balancer without a condition", name)
+		}
+	}
+	require.Equal(t, "CreatePublicLoadBalancer", parsed.Resources["HTTPListener"].Condition)
+	require.Equal(t, "CreatePublicLoadBalancer", parsed.Resources["DefaultHTTPTargetGroup"].Condition)
+}
+
+func TestEnvTemplate_VPCEndpointsWithImportedVPC(t *testing.T) {
+	// GIVEN
+	input := mockDeployEnvironmentInput()
+	input.ImportVPC = &deploy.ImportVPCConfig{
+		ID:               "vpc-1234",
+		PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+		PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+	}
+	envTemplate, err := templates.Box().FindString(EnvTemplatePath)
+	require.NoError(t, err)
+	box := packd.NewMemoryBox()
+	box.AddString(EnvTemplatePath, envTemplate)
+	box.AddString(acmValidationTemplatePath, "customresources")
+	box.AddString(dnsDelegationTemplatePath, "customresources")
+	envStack := NewEnvStackConfig(input, box)
+
+	// WHEN
+	tpl, err := envStack.Template()
+	params := envStack.Parameters()
+
+	// THEN
+	require.NoError(t, err)
+	for _, param := range params {
+		require.NotEqual(t, envParamVPCEndpointsKey, aws.StringValue(param.ParameterKey), "endpoints must not be enabled in an imported VPC")
+	}
+	var parsed struct {
+		Conditions map[string]yaml.Node `yaml:"Conditions"`
+		Resources  map[string]struct {
+			Type      string `yaml:"Type"`
+			Condition string `yaml:"Condition"`
+		} `yaml:"Resources"`
+		Outputs map[string]struct {
+			Condition string `yaml:"Condition"`
+		} `yaml:"Outputs"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(tpl), &parsed))
+	// The endpoints, and the output read by "env show", are only created along with the VPC.
+	endpointsCondition := parsed.Conditions["CreateVPCEndpoints"]
+	condition, err := yaml.Marshal(&endpointsCondition)
+	require.NoError(t, err)
+	require.Contains(t, string(condition), "!Condition CreateVPC\n")
+	for name, resource := range parsed.Resources {
+		if resource.Type == "AWS::EC2::VPCEndpoint" {
+			require.Equal(t, "CreateVPCEndpoints", resource.Condition, "resource %s", name)
+		}
+	}
+	require.Equal(t, "CreateVPCEndpoints", parsed.Outputs[EnvOutputVPCEndpoints].Condition)
+}
+
 func TestEnvParameters(t *testing.T) {
 	deploymentInput := mockDeployEnvironmentInput()
 	deploymentInputWithDNS := mockDeployEnvironmentInput()
@@ -82,6 +200,18 @@ func TestEnvParameters(t *testing.T) {
 					ParameterKey:   aws.String(envParamProjectDNSDelegationRoleKey),
 					ParameterValue: aws.String(""),
 				},
+				{
+					ParameterKey:   aws.String(envParamImportCertARNKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamContainerInsightsKey),
+					ParameterValue: aws.String("false"),
+				},
+				{
+					ParameterKey:   aws.String(envParamFargateSpotKey),
+					ParameterValue: aws.String("false"),
+				},
 			},
 		},
 		"with DNS": {
@@ -111,6 +241,18 @@ func TestEnvParameters(t *testing.T) {
 					ParameterKey:   aws.String(envParamProjectDNSDelegationRoleKey),
 					ParameterValue: aws.String("arn:aws:iam::000000000:role/project-DNSDelegationRole"),
 				},
+				{
+					ParameterKey:   aws.String(envParamImportCertARNKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamContainerInsightsKey),
+					ParameterValue: aws.String("false"),
+				},
+				{
+					ParameterKey:   aws.String(envParamFargateSpotKey),
+					ParameterValue: aws.String("false"),
+				},
 			},
 		},
 	}
@@ -123,6 +265,174 @@ func TestEnvParameters(t *testing.T) {
 	}
 }
 
+func TestEnvParameters_VPC(t *testing.T) {
+	testCases := map[string]struct {
+		adjustVPC *deploy.AdjustVPCConfig
+		importVPC *deploy.ImportVPCConfig
+
+		wantedParams   []*cloudformation.Parameter
+		unwantedParams []string
+	}{
+		"uses the template defaults if the VPC is not configured": {
+			unwantedParams: []string{envParamVPCCIDRKey, envParamVPCEndpointsKey, envParamImportVPCIDKey},
+		},
+		"with adjusted VPC": {
+			adjustVPC: &deploy.AdjustVPCConfig{
+				CIDR:               "172.16.0.0/16",
+				PublicSubnetCIDRs:  []string{"172.16.0.0/24", "172.16.1.0/24"},
+				PrivateSubnetCIDRs: []string{"172.16.2.0/24", "172.16.3.0/24"},
+				Endpoints:          true,
+			},
+			wantedParams: []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(envParamVPCCIDRKey),
+					ParameterValue: aws.String("172.16.0.0/16"),
+				},
+				{
+					ParameterKey:   aws.String(envParamVPCEndpointsKey),
+					ParameterValue: aws.String("true"),
+				},
+				{
+					ParameterKey:   aws.String(envParamPublicSubnet1CIDRKey),
+					ParameterValue: aws.String("172.16.0.0/24"),
+				},
+				{
+					ParameterKey:   aws.String(envParamPublicSubnet2CIDRKey),
+					ParameterValue: aws.String("172.16.1.0/24"),
+				},
+				{
+					ParameterKey:   aws.String(envParamPrivateSubnet1CIDRKey),
+					ParameterValue: aws.String("172.16.2.0/24"),
+				},
+				{
+					ParameterKey:   aws.String(envParamPrivateSubnet2CIDRKey),
+					ParameterValue: aws.String("172.16.3.0/24"),
+				},
+			},
+			unwantedParams: []string{envParamImportVPCIDKey},
+		},
+		"with imported VPC": {
+			importVPC: &deploy.ImportVPCConfig{
+				ID:               "vpc-1234",
+				PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+				PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+			},
+			wantedParams: []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(envParamImportVPCIDKey),
+					ParameterValue: aws.String("vpc-1234"),
+				},
+				{
+					ParameterKey:   aws.String(envParamImportPublicSubnetIDsKey),
+					ParameterValue: aws.String("subnet-1,subnet-2"),
+				},
+				{
+					ParameterKey:   aws.String(envParamImportPrivateSubnetIDsKey),
+					ParameterValue: aws.String("subnet-3,subnet-4"),
+				},
+			},
+			unwantedParams: []string{envParamVPCCIDRKey},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			input := mockDeployEnvironmentInput()
+			input.AdjustVPC = tc.adjustVPC
+			input.ImportVPC = tc.importVPC
+			env := NewEnvStackConfig(input, emptyEnvBox())
+
+			// WHEN
+			params := env.Parameters()
+
+			// THEN
+			require.Subset(t, params, tc.wantedParams)
+			for _, param := range params {
+				require.NotContains(t, tc.unwantedParams, aws.StringValue(param.ParameterKey))
+			}
+		})
+	}
+}
+
+func TestEnvDomainParameters(t *testing.T) {
+	prevParams := []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String(envParamProjectNameKey),
+			ParameterValue: aws.String("project"),
+		},
+		{
+			ParameterKey:   aws.String(envParamProjectDNSKey),
+			ParameterValue: aws.String(""),
+		},
+		{
+			ParameterKey:   aws.String(envParamVPCCIDRKey),
+			ParameterValue: aws.String("10.1.0.0/16"),
+		},
+	}
+	testCases := map[string]struct {
+		domain     string
+		prevParams []*cloudformation.Parameter
+		want       []*cloudformation.Parameter
+	}{
+		"sets the new domain and keeps the other parameters": {
+			domain:     "ecs.aws",
+			prevParams: prevParams,
+			want: []*cloudformation.Parameter{
+				{
+					ParameterKey:     aws.String(envParamProjectNameKey),
+					UsePreviousValue: aws.Bool(true),
+				},
+				{
+					ParameterKey:   aws.String(envParamProjectDNSKey),
+					ParameterValue: aws.String("ecs.aws"),
+				},
+				{
+					ParameterKey:     aws.String(envParamVPCCIDRKey),
+					UsePreviousValue: aws.Bool(true),
+				},
+				{
+					ParameterKey:   aws.String(envParamProjectDNSDelegationRoleKey),
+					ParameterValue: aws.String("arn:aws:iam::000000000:role/project-DNSDelegationRole"),
+				},
+			},
+		},
+		"removes the domain": {
+			domain: "",
+			prevParams: []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(envParamProjectDNSKey),
+					ParameterValue: aws.String("ecs.aws"),
+				},
+				{
+					ParameterKey:   aws.String(envParamProjectDNSDelegationRoleKey),
+					ParameterValue: aws.String("arn:aws:iam::000000000:role/project-DNSDelegationRole"),
+				},
+			},
+			want: []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(envParamProjectDNSKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamProjectDNSDelegationRoleKey),
+					ParameterValue: aws.String(""),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			input := mockDeployEnvironmentInput()
+			input.ProjectDNSName = tc.domain
+			conf := NewEnvDomainStackConfig(input, tc.prevParams, emptyEnvBox())
+
+			require.Equal(t, tc.want, conf.Parameters())
+		})
+	}
+}
+
 func TestEnvDNSDelegationRole(t *testing.T) {
 	testCases := map[string]struct {
 		input *EnvStackConfig
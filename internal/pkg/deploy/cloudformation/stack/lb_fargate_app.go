@@ -37,11 +37,16 @@ const (
 	LBFargateTaskCountKey           = "TaskCount"
 )
 
+// Output keys for a load balanced Fargate service.
+const (
+	LBFargateOutputAliasesKey = "Aliases"
+)
+
 // LBFargateStackConfig represents the configuration needed to create a CloudFormation stack from a
 // load balanced Fargate application.
 type LBFargateStackConfig struct {
 	*deploy.CreateLBFargateAppInput
-	httpsEnabled bool
+	dnsDelegated bool
 	box          packd.Box
 }
 
@@ -49,7 +54,7 @@ type LBFargateStackConfig struct {
 func NewLBFargateStack(in *deploy.CreateLBFargateAppInput) *LBFargateStackConfig {
 	return &LBFargateStackConfig{
 		CreateLBFargateAppInput: in,
-		httpsEnabled:            false,
+		dnsDelegated:            false,
 		box:                     templates.Box(),
 	}
 }
@@ -60,7 +65,7 @@ func NewLBFargateStack(in *deploy.CreateLBFargateAppInput) *LBFargateStackConfig
 func NewHTTPSLBFargateStack(in *deploy.CreateLBFargateAppInput) *LBFargateStackConfig {
 	return &LBFargateStackConfig{
 		CreateLBFargateAppInput: in,
-		httpsEnabled:            true,
+		dnsDelegated:            true,
 		box:                     templates.Box(),
 	}
 }
@@ -83,7 +88,7 @@ func (c *LBFargateStackConfig) Template() (string, error) {
 		return "", &ErrTemplateNotFound{templateLocation: lbFargateAppTemplatePath, parentErr: err}
 	}
 
-	tpl, err := template.New("template").Parse(content)
+	tpl, err := template.New("template").Funcs(templateFunctions).Parse(content)
 	if err != nil {
 		return "", fmt.Errorf("parse CloudFormation template for %s: %w", c.App.Type, err)
 	}
@@ -145,7 +150,7 @@ func (c *LBFargateStackConfig) Parameters() []*cloudformation.Parameter {
 		},
 		{
 			ParameterKey:   aws.String(LBFargateParamHTTPSKey),
-			ParameterValue: aws.String(strconv.FormatBool(c.httpsEnabled)),
+			ParameterValue: aws.String(templateParams.HTTPSEnabled),
 		},
 	}
 }
@@ -191,6 +196,7 @@ type lbFargateTemplateParams struct {
 	*deploy.CreateLBFargateAppInput
 
 	HTTPSEnabled string
+	DNSDelegated bool
 	// Field types to override.
 	Image struct {
 		URL  string
@@ -200,15 +206,19 @@ type lbFargateTemplateParams struct {
 
 func (c *LBFargateStackConfig) toTemplateParams() *lbFargateTemplateParams {
 	url := fmt.Sprintf("%s:%s", c.ImageRepoURL, c.ImageTag)
+	conf := c.CreateLBFargateAppInput.App.EnvConf(c.Env.Name) // Get environment specific app configuration.
+	// Aliases are served by the environment's HTTPS listener even if the project doesn't have a domain.
+	httpsEnabled := c.dnsDelegated || len(conf.Aliases) != 0
 	return &lbFargateTemplateParams{
 		CreateLBFargateAppInput: &deploy.CreateLBFargateAppInput{
 			App: &manifest.LBFargateManifest{
 				AppManifest:     c.App.AppManifest,
-				LBFargateConfig: c.CreateLBFargateAppInput.App.EnvConf(c.Env.Name), // Get environment specific app configuration.
+				LBFargateConfig: conf,
 			},
 			Env: c.Env,
 		},
-		HTTPSEnabled: strconv.FormatBool(c.httpsEnabled),
+		HTTPSEnabled: strconv.FormatBool(httpsEnabled),
+		DNSDelegated: c.dnsDelegated,
 		Image: struct {
 			URL  string
 			Port int
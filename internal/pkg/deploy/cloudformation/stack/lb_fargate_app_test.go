@@ -11,6 +11,7 @@ import (
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy"
 	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/manifest"
+	"github.com/aws/amazon-ecs-cli-v2/templates"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/gobuffalo/packd"
@@ -162,33 +163,401 @@ func TestLBFargateStackConfig_Template(t *testing.T) {
 	}
 }
 
+func TestLBFargateStackConfig_Template_CapacityProviders(t *testing.T) {
+	testCases := map[string]struct {
+		inStrategy *manifest.CapacityProviderStrategy
+
+		wantedContains    []string
+		wantedNotContains []string
+	}{
+		"launch type without a strategy": {
+			wantedContains:    []string{"LaunchType: FARGATE"},
+			wantedNotContains: []string{"CapacityProviderStrategy"},
+		},
+		"launch type with an empty strategy": {
+			inStrategy:        &manifest.CapacityProviderStrategy{},
+			wantedContains:    []string{"LaunchType: FARGATE"},
+			wantedNotContains: []string{"CapacityProviderStrategy"},
+		},
+		"spot and on-demand weights": {
+			inStrategy: &manifest.CapacityProviderStrategy{
+				Spot:     2,
+				OnDemand: 1,
+			},
+			wantedContains: []string{`      CapacityProviderStrategy:
+        - CapacityProvider: FARGATE_SPOT
+          Weight: 2
+        - CapacityProvider: FARGATE
+          Weight: 1
+`},
+			wantedNotContains: []string{"LaunchType"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			app := manifest.NewLoadBalancedFargateManifest(&manifest.LBFargateManifestProps{
+				AppManifestProps: &manifest.AppManifestProps{
+					AppName:    "frontend",
+					Dockerfile: "frontend/Dockerfile",
+				},
+				Path: "frontend",
+			})
+			app.CapacityProviders = tc.inStrategy
+			conf := &LBFargateStackConfig{
+				CreateLBFargateAppInput: &deploy.CreateLBFargateAppInput{
+					App: app,
+					Env: &archer.Environment{
+						Project: "phonetool",
+						Name:    "test",
+					},
+				},
+				box: templates.Box(),
+			}
+
+			// WHEN
+			tpl, err := conf.Template()
+
+			// THEN
+			require.NoError(t, err)
+			for _, wanted := range tc.wantedContains {
+				require.Contains(t, tpl, wanted)
+			}
+			for _, unwanted := range tc.wantedNotContains {
+				require.NotContains(t, tpl, unwanted)
+			}
+		})
+	}
+}
+
+func TestLBFargateStackConfig_Template_Aliases(t *testing.T) {
+	testCases := map[string]struct {
+		inDNSDelegated bool
+		inAliases      []string
+		inHostedZone   string
+
+		wantedContains    []string
+		wantedNotContains []string
+	}{
+		"without aliases": {
+			wantedNotContains: []string{"LoadBalancerDNSAlias", "CustomDomainAlias", "Aliases:"},
+		},
+		"project domain only": {
+			inDNSDelegated:    true,
+			wantedContains:    []string{"LoadBalancerDNSAlias"},
+			wantedNotContains: []string{"CustomDomainAlias", "Aliases:"},
+		},
+		"aliases without a hosted zone": {
+			inAliases: []string{"example.com", "www.example.com"},
+			wantedContains: []string{`            Values:
+              - 'example.com'
+              - 'www.example.com'
+`, `  Aliases:
+    Value: 'example.com,www.example.com'`},
+			wantedNotContains: []string{"LoadBalancerDNSAlias", "CustomDomainAlias"},
+		},
+		"aliases with a hosted zone": {
+			inDNSDelegated: true,
+			inAliases:      []string{"example.com"},
+			inHostedZone:   "Z0123",
+			wantedContains: []string{"LoadBalancerDNSAlias", `  CustomDomainAlias:
+    Type: AWS::Route53::RecordSetGroup
+    Condition: HTTPSLoadBalancer
+    Properties:
+      HostedZoneId: 'Z0123'`, `      - Name: 'example.com'`},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			app := manifest.NewLoadBalancedFargateManifest(&manifest.LBFargateManifestProps{
+				AppManifestProps: &manifest.AppManifestProps{
+					AppName:    "frontend",
+					Dockerfile: "frontend/Dockerfile",
+				},
+				Path: "frontend",
+			})
+			app.Aliases = tc.inAliases
+			app.HostedZone = tc.inHostedZone
+			conf := &LBFargateStackConfig{
+				CreateLBFargateAppInput: &deploy.CreateLBFargateAppInput{
+					App: app,
+					Env: &archer.Environment{
+						Project: "phonetool",
+						Name:    "test",
+					},
+				},
+				dnsDelegated: tc.inDNSDelegated,
+				box:          templates.Box(),
+			}
+
+			// WHEN
+			tpl, err := conf.Template()
+
+			// THEN
+			require.NoError(t, err)
+			for _, wanted := range tc.wantedContains {
+				require.Contains(t, tpl, wanted)
+			}
+			for _, unwanted := range tc.wantedNotContains {
+				require.NotContains(t, tpl, unwanted)
+			}
+		})
+	}
+}
+
+func TestLBFargateStackConfig_Template_URL(t *testing.T) {
+	// GIVEN
+	app := manifest.NewLoadBalancedFargateManifest(&manifest.LBFargateManifestProps{
+		AppManifestProps: &manifest.AppManifestProps{
+			AppName:    "frontend",
+			Dockerfile: "frontend/Dockerfile",
+		},
+		Path: "/",
+	})
+	conf := &LBFargateStackConfig{
+		CreateLBFargateAppInput: &deploy.CreateLBFargateAppInput{
+			App: app,
+			Env: &archer.Environment{
+				Project: "phonetool",
+				Name:    "test",
+			},
+		},
+		box: templates.Box(),
+	}
+
+	// WHEN
+	tpl, err := conf.Template()
+
+	// THEN
+	require.NoError(t, err)
+	require.Contains(t, tpl, `  RootRulePath:
+    !Equals [!Ref RulePath, '/']`)
+	// The root path isn't appended to the load balancer DNS name, which ends the URL with a single "/".
+	require.Contains(t, tpl, `        - - 'http://'
+          - Fn::ImportValue:
+              !Sub "${ProjectName}-${EnvName}-PublicLoadBalancerDNS"
+          - !If [RootRulePath, '/', !Sub "/${RulePath}"]`)
+}
+
+func TestLBFargateStackConfig_Template_Logging(t *testing.T) {
+	testCases := map[string]struct {
+		inLogging *manifest.LoggingConfig
+
+		wantedContains    []string
+		wantedNotContains []string
+	}{
+		"awslogs driver without logging configuration": {
+			wantedContains:    []string{"LogDriver: awslogs", "awslogs-stream-prefix: ecs\n"},
+			wantedNotContains: []string{"RetentionInDays", "SubscriptionFilter", "awsfirelens", "RouteLogs"},
+		},
+		"retention and subscription to a delivery stream": {
+			inLogging: &manifest.LoggingConfig{
+				Retention: 30,
+				Subscription: &manifest.LogSubscription{
+					Destination: "arn:aws:firehose:us-west-2:123456789012:deliverystream/my-stream",
+					Pattern:     "ERROR",
+				},
+			},
+			wantedContains: []string{
+				"      RetentionInDays: 30\n",
+				`  SubscriptionFilter:
+    Type: AWS::Logs::SubscriptionFilter
+    Properties:
+      LogGroupName: !Ref LogGroup
+      FilterPattern: 'ERROR'
+      DestinationArn: 'arn:aws:firehose:us-west-2:123456789012:deliverystream/my-stream'
+      RoleArn: !GetAtt SubscriptionFilterRole.Arn
+`,
+				"  SubscriptionFilterRole:\n",
+			},
+			wantedNotContains: []string{"SubscriptionFilterPermission"},
+		},
+		"subscription to a lambda function": {
+			inLogging: &manifest.LoggingConfig{
+				Subscription: &manifest.LogSubscription{
+					Destination: "arn:aws:lambda:us-west-2:123456789012:function:forward",
+				},
+			},
+			wantedContains: []string{
+				"    DependsOn: SubscriptionFilterPermission\n",
+				"      FunctionName: 'arn:aws:lambda:us-west-2:123456789012:function:forward'\n",
+			},
+			wantedNotContains: []string{"RetentionInDays", "SubscriptionFilterRole"},
+		},
+		"firelens log router": {
+			inLogging: &manifest.LoggingConfig{
+				Router: &manifest.LogRouter{
+					Options: map[string]string{
+						"Name":            "firehose",
+						"delivery_stream": "my-stream",
+					},
+				},
+			},
+			wantedContains: []string{`          LogConfiguration:
+            LogDriver: awsfirelens
+            Options:
+              'Name': 'firehose'
+              'delivery_stream': 'my-stream'
+`,
+				"          Image: 'amazon/aws-for-fluent-bit:latest'\n",
+				"              awslogs-stream-prefix: firelens\n",
+				`        - PolicyName: 'RouteLogs'
+          PolicyDocument:
+            Version: '2012-10-17'
+            Statement:
+              - Effect: 'Allow'
+                Action:
+                  - 'firehose:PutRecordBatch'
+                Resource: !Sub 'arn:${AWS::Partition}:firehose:${AWS::Region}:${AWS::AccountId}:deliverystream/my-stream'
+`,
+			},
+			wantedNotContains: []string{"awslogs-stream-prefix: ecs\n"},
+		},
+		"quotes the values of the logging configuration": {
+			inLogging: &manifest.LoggingConfig{
+				Subscription: &manifest.LogSubscription{
+					Destination: "arn:aws:firehose:us-west-2:123456789012:deliverystream/my-stream",
+					Pattern:     `{ $.level = 'error' }`,
+				},
+				Router: &manifest.LogRouter{
+					Options: map[string]string{
+						"Name":  "http",
+						"Match": "*",
+						"Uri":   "/logs?source='app'",
+					},
+				},
+			},
+			wantedContains: []string{
+				"      FilterPattern: '{ $.level = ''error'' }'\n",
+				"              'Uri': '/logs?source=''app'''\n",
+			},
+		},
+		"firelens log router to cloudwatch logs keeps the log streams of the awslogs driver": {
+			inLogging: &manifest.LoggingConfig{
+				Router: &manifest.LogRouter{
+					Options: map[string]string{
+						"Name": "cloudwatch",
+					},
+				},
+			},
+			wantedContains: []string{`            Options:
+              'Name': 'cloudwatch'
+              region: !Ref AWS::Region
+              log_group_name: !Ref LogGroup
+              # Same log stream names as the awslogs driver so that the logs can be read by task.
+              log_stream_name: !Sub 'ecs/${AppName}/$(ecs_task_id)'
+`,
+				"                  - 'logs:PutLogEvents'\n                Resource: !GetAtt LogGroup.Arn\n",
+			},
+		},
+		"firelens log router to cloudwatch logs with its own log streams": {
+			inLogging: &manifest.LoggingConfig{
+				Router: &manifest.LogRouter{
+					Options: map[string]string{
+						"Name":              "cloudwatch",
+						"region":            "us-east-1",
+						"log_group_name":    "my-group",
+						"log_stream_prefix": "app-",
+					},
+				},
+			},
+			wantedContains: []string{
+				"                Resource: !Sub 'arn:${AWS::Partition}:logs:us-east-1:${AWS::AccountId}:log-group:my-group:*'\n",
+			},
+			wantedNotContains: []string{"\n              region: !Ref AWS::Region", "log_group_name: !Ref LogGroup", "log_stream_name:"},
+		},
+		"firelens log router to a kinesis stream in another region": {
+			inLogging: &manifest.LoggingConfig{
+				Router: &manifest.LogRouter{
+					Options: map[string]string{
+						"Name":   "kinesis",
+						"stream": "my-stream",
+						"region": "us-east-1",
+					},
+				},
+			},
+			wantedContains: []string{
+				"                  - 'kinesis:PutRecords'\n",
+				"                Resource: !Sub 'arn:${AWS::Partition}:kinesis:us-east-1:${AWS::AccountId}:stream/my-stream'\n",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			app := manifest.NewLoadBalancedFargateManifest(&manifest.LBFargateManifestProps{
+				AppManifestProps: &manifest.AppManifestProps{
+					AppName:    "frontend",
+					Dockerfile: "frontend/Dockerfile",
+				},
+				Path: "frontend",
+			})
+			app.Logging = tc.inLogging
+			conf := &LBFargateStackConfig{
+				CreateLBFargateAppInput: &deploy.CreateLBFargateAppInput{
+					App: app,
+					Env: &archer.Environment{
+						Project: "phonetool",
+						Name:    "test",
+					},
+				},
+				box: templates.Box(),
+			}
+
+			// WHEN
+			tpl, err := conf.Template()
+
+			// THEN
+			require.NoError(t, err)
+			for _, wanted := range tc.wantedContains {
+				require.Contains(t, tpl, wanted)
+			}
+			for _, unwanted := range tc.wantedNotContains {
+				require.NotContains(t, tpl, unwanted)
+			}
+		})
+	}
+}
+
 func TestLBFargateStackConfig_Parameters(t *testing.T) {
 	testCases := map[string]struct {
-		httpsEnabled bool
+		dnsDelegated bool
+		inAliases    []string
 		expectedHTTP string
 	}{
 		"HTTPS Enabled": {
-			httpsEnabled: true,
+			dnsDelegated: true,
 			expectedHTTP: "true",
 		},
 		"HTTPS Not Enabled": {
-			httpsEnabled: false,
+			dnsDelegated: false,
 			expectedHTTP: "false",
 		},
+		"HTTPS Enabled by aliases": {
+			dnsDelegated: false,
+			inAliases:    []string{"example.com"},
+			expectedHTTP: "true",
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 
 			// GIVEN
+			app := manifest.NewLoadBalancedFargateManifest(&manifest.LBFargateManifestProps{
+				AppManifestProps: &manifest.AppManifestProps{
+					AppName:    "frontend",
+					Dockerfile: "frontend/Dockerfile",
+				},
+				Path: "frontend",
+			})
+			app.Aliases = tc.inAliases
 			conf := &LBFargateStackConfig{
 				CreateLBFargateAppInput: &deploy.CreateLBFargateAppInput{
-					App: manifest.NewLoadBalancedFargateManifest(&manifest.LBFargateManifestProps{
-						AppManifestProps: &manifest.AppManifestProps{
-							AppName:    "frontend",
-							Dockerfile: "frontend/Dockerfile",
-						},
-						Path: "frontend",
-					}),
+					App: app,
 					Env: &archer.Environment{
 						Project:   "phonetool",
 						Name:      "test",
@@ -199,7 +568,7 @@ func TestLBFargateStackConfig_Parameters(t *testing.T) {
 					ImageRepoURL: "12345.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
 					ImageTag:     "manual-bf3678c",
 				},
-				httpsEnabled: tc.httpsEnabled,
+				dnsDelegated: tc.dnsDelegated,
 			}
 
 			// WHEN
@@ -290,7 +659,7 @@ func TestLBFargateStackConfig_SerializedParameters(t *testing.T) {
 					ImageRepoURL: "12345.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
 					ImageTag:     "manual-bf3678c",
 				},
-				httpsEnabled: false,
+				dnsDelegated: false,
 			},
 
 			mockBox: func(box *packd.MemoryBox) {
@@ -344,7 +713,7 @@ func TestLBFargateStackConfig_SerializedParameters(t *testing.T) {
 					ImageRepoURL: "12345.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
 					ImageTag:     "manual-bf3678c",
 				},
-				httpsEnabled: true,
+				dnsDelegated: true,
 			},
 			mockBox: func(box *packd.MemoryBox) {
 				box.AddString(lbFargateAppParamsPath, `{
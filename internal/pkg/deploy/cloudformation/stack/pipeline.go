@@ -5,7 +5,9 @@ package stack
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"fmt"
+	"strings"
 	"text/template"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -15,7 +17,12 @@ import (
 	"github.com/aws/amazon-ecs-cli-v2/templates"
 )
 
-const pipelineCfnTemplatePath = "cicd/pipeline_cfn.yml"
+const (
+	pipelineCfnTemplatePath = "cicd/pipeline_cfn.yml"
+
+	notificationRuleNameSuffix    = "-Notifications"
+	notificationRuleNameMaxLength = 64
+)
 
 type pipelineStackConfig struct {
 	*deploy.CreatePipelineInput
@@ -52,6 +59,26 @@ func (p *pipelineStackConfig) Template() (string, error) {
 	return buf.String(), nil
 }
 
+// NotificationRuleName returns the name of the notification rule of the pipeline, which can't be longer
+// than 64 characters. Longer names are truncated and keep a hash of the stack name to stay unique.
+func (p *pipelineStackConfig) NotificationRuleName() string {
+	name := p.StackName() + notificationRuleNameSuffix
+	if len(name) <= notificationRuleNameMaxLength {
+		return name
+	}
+	hash := sha256.Sum256([]byte(p.StackName()))
+	suffix := fmt.Sprintf("-%x%s", hash[:4], notificationRuleNameSuffix)
+	return strings.TrimRight(p.StackName()[:notificationRuleNameMaxLength-len(suffix)], "-") + suffix
+}
+
+// BuildSettings returns the settings of the CodeBuild project that builds the applications of the pipeline.
+func (p *pipelineStackConfig) BuildSettings() *deploy.PipelineBuild {
+	if p.Build == nil {
+		return deploy.DefaultPipelineBuild()
+	}
+	return p.Build
+}
+
 func (p *pipelineStackConfig) Parameters() []*cloudformation.Parameter {
 	return nil
 }
@@ -62,5 +89,9 @@ func (p *pipelineStackConfig) Tags() []*cloudformation.Tag {
 			Key:   aws.String(ProjectTagKey),
 			Value: aws.String(p.ProjectName),
 		},
+		{
+			Key:   aws.String(PipelineTagKey),
+			Value: aws.String(p.Name),
+		},
 	}
 }
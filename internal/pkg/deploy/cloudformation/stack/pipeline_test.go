@@ -41,6 +41,10 @@ func TestPipelineTags(t *testing.T) {
 			Key:   aws.String(ProjectTagKey),
 			Value: aws.String(projectName),
 		},
+		{
+			Key:   aws.String(PipelineTagKey),
+			Value: aws.String(pipelineName),
+		},
 	}
 	require.ElementsMatch(t, expectedTags, pipeline.Tags())
 }
@@ -78,6 +82,308 @@ func TestPipelineTemplateRenderingWithNoApplication(t *testing.T) {
 	require.Equal(t, string(expectedTemplate), string(tmpl), "the rendered template differs from the expected")
 }
 
+func TestPipelineTemplateRenderingWithCodeCommit(t *testing.T) {
+	in := mockCreatePipelineInput()
+	in.Source = &deploy.Source{
+		ProviderName: "CodeCommit",
+		Properties: map[string]interface{}{
+			"repository": "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/wings",
+			"branch":     "main",
+		},
+	}
+	pipeline := NewPipelineStackConfig(in)
+
+	tmpl, err := pipeline.Template()
+
+	require.NoError(t, err, "template serialization failed")
+	require.Contains(t, tmpl, `                Category: Source
+                Owner: AWS
+                Version: 1
+                Provider: CodeCommit
+              Configuration:
+                BranchName: main
+                RepositoryName: wings
+`)
+	require.Contains(t, tmpl, "- codecommit:GetBranch")
+	require.Contains(t, tmpl, "Resource: !Sub 'arn:${AWS::Partition}:codecommit:${AWS::Region}:${AWS::AccountId}:wings'")
+	require.NotContains(t, tmpl, "OAuthToken")
+}
+
+func TestPipelineTemplateRenderingWithBuildspecPath(t *testing.T) {
+	in := mockCreatePipelineInput()
+	in.BuildspecPath = "ecs-project/pipelines/hotfix/buildspec.yml"
+	pipeline := NewPipelineStackConfig(in)
+
+	tmpl, err := pipeline.Template()
+
+	require.NoError(t, err, "template serialization failed")
+	require.Contains(t, tmpl, "BuildSpec: ecs-project/pipelines/hotfix/buildspec.yml\n")
+	require.NotContains(t, tmpl, "BuildSpec: ecs-project/buildspec.yml")
+}
+
+func TestPipelineTemplateRenderingWithBuild(t *testing.T) {
+	testCases := map[string]struct {
+		inBuild *deploy.PipelineBuild
+
+		wantedContains string
+	}{
+		"caches the paths of the buildspec in S3": {
+			inBuild: &deploy.PipelineBuild{
+				Image:          "aws/codebuild/amazonlinux2-x86_64-standard:3.0",
+				ComputeType:    "BUILD_GENERAL1_LARGE",
+				PrivilegedMode: true,
+				CacheBucket:    "chicken-us-west-2",
+			},
+			wantedContains: `      Cache:
+        # The paths listed in the cache section of the buildspec are stored in the artifact bucket.
+        Type: S3
+        Location: !Sub 'chicken-us-west-2/${AWS::StackName}/build-cache'
+      Environment:
+        Type: LINUX_CONTAINER
+        ComputeType: BUILD_GENERAL1_LARGE
+        PrivilegedMode: true
+        Image: aws/codebuild/amazonlinux2-x86_64-standard:3.0
+`,
+		},
+		"does not cache": {
+			inBuild: &deploy.PipelineBuild{
+				Image:       "aws/codebuild/amazonlinux2-x86_64-standard:1.0",
+				ComputeType: "BUILD_GENERAL1_SMALL",
+			},
+			wantedContains: `      Cache:
+        Type: NO_CACHE
+      Environment:
+        Type: LINUX_CONTAINER
+        ComputeType: BUILD_GENERAL1_SMALL
+        PrivilegedMode: false
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			in := mockCreatePipelineInput()
+			in.Build = tc.inBuild
+			pipeline := NewPipelineStackConfig(in)
+
+			tmpl, err := pipeline.Template()
+
+			require.NoError(t, err, "template serialization failed")
+			require.Contains(t, tmpl, tc.wantedContains)
+		})
+	}
+}
+
+func TestPipelineTemplateRenderingWithConnection(t *testing.T) {
+	testCases := map[string]struct {
+		inProperties map[string]interface{}
+
+		wantedContains    []string
+		wantedNotContains []string
+	}{
+		"creates the connection if it does not exist": {
+			inProperties: map[string]interface{}{
+				"repository":    "https://bitbucket.org/aws/wings",
+				"branch":        "main",
+				"provider_type": "Bitbucket",
+			},
+			wantedContains: []string{
+				`  SourceConnection:
+    # The connection is created in the PENDING status, and has to be completed in the AWS console
+    # before the pipeline can access the repository.
+    Type: AWS::CodeStarConnections::Connection
+    Properties:
+      ConnectionName: wings
+      ProviderType: Bitbucket
+`,
+				`                Provider: CodeStarSourceConnection
+              Configuration:
+                BranchName: main
+                ConnectionArn: !Ref SourceConnection
+                FullRepositoryId: aws/wings
+`,
+				`              - codestar-connections:UseConnection
+            Resource: !Ref SourceConnection
+`,
+			},
+		},
+		"creates a GitHub Enterprise Server connection on the host": {
+			inProperties: map[string]interface{}{
+				"repository":    "https://ghe.example.com/aws/wings",
+				"branch":        "main",
+				"provider_type": "GitHubEnterpriseServer",
+				"host_arn":      "arn:aws:codestar-connections:us-west-2:1234:host/ghe-1234",
+			},
+			wantedContains: []string{
+				`      ConnectionName: wings
+      HostArn: arn:aws:codestar-connections:us-west-2:1234:host/ghe-1234
+`,
+			},
+			wantedNotContains: []string{"ProviderType:"},
+		},
+		"uses the existing connection": {
+			inProperties: map[string]interface{}{
+				"repository":     "aws/wings",
+				"branch":         "main",
+				"provider_type":  "GitHub",
+				"connection_arn": "arn:aws:codestar-connections:us-west-2:1234:connection/abcd",
+			},
+			wantedContains: []string{
+				"ConnectionArn: arn:aws:codestar-connections:us-west-2:1234:connection/abcd",
+				"Resource: arn:aws:codestar-connections:us-west-2:1234:connection/abcd",
+			},
+			wantedNotContains: []string{"AWS::CodeStarConnections::Connection", "OAuthToken"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			in := mockCreatePipelineInput()
+			in.Source = &deploy.Source{
+				ProviderName: "CodeStarSourceConnection",
+				Properties:   tc.inProperties,
+			}
+			pipeline := NewPipelineStackConfig(in)
+
+			tmpl, err := pipeline.Template()
+
+			require.NoError(t, err, "template serialization failed")
+			for _, wanted := range tc.wantedContains {
+				require.Contains(t, tmpl, wanted)
+			}
+			for _, notWanted := range tc.wantedNotContains {
+				require.NotContains(t, tmpl, notWanted)
+			}
+		})
+	}
+}
+
+func TestPipelineTemplateRenderingWithNotifications(t *testing.T) {
+	testCases := map[string]struct {
+		inNotifications *deploy.PipelineNotifications
+
+		wantedContains    []string
+		wantedNotContains []string
+		wantedErr         string
+	}{
+		"creates a topic for the emails and notifies of the default events": {
+			inNotifications: &deploy.PipelineNotifications{
+				Emails: []string{"team@example.com", "oncall@example.com"},
+			},
+			wantedContains: []string{
+				`  NotificationTopic:
+    Type: AWS::SNS::Topic
+    Properties:
+      Subscription:
+        - Protocol: email
+          Endpoint: team@example.com
+        - Protocol: email
+          Endpoint: oncall@example.com
+`,
+				`      EventTypeIds:
+        - codepipeline-pipeline-stage-execution-failed
+        - codepipeline-pipeline-manual-approval-needed
+      Targets:
+        - TargetType: SNS
+          TargetAddress: !Ref NotificationTopic
+`,
+				"Resource: !Sub 'arn:${AWS::Partition}:codepipeline:${AWS::Region}:${AWS::AccountId}:${Pipeline}'",
+			},
+		},
+		"publishes to the existing topic": {
+			inNotifications: &deploy.PipelineNotifications{
+				TopicARN: "arn:aws:sns:us-west-2:1234:pipeline-alerts",
+				Events:   []string{"pipeline_failed", "pipeline_succeeded"},
+			},
+			wantedContains: []string{
+				`      EventTypeIds:
+        - codepipeline-pipeline-pipeline-execution-failed
+        - codepipeline-pipeline-pipeline-execution-succeeded
+      Targets:
+        - TargetType: SNS
+          TargetAddress: arn:aws:sns:us-west-2:1234:pipeline-alerts
+`,
+			},
+			wantedNotContains: []string{"AWS::SNS::Topic"},
+		},
+		"returns an error on an unknown event": {
+			inNotifications: &deploy.PipelineNotifications{
+				TopicARN: "arn:aws:sns:us-west-2:1234:pipeline-alerts",
+				Events:   []string{"build_started"},
+			},
+			wantedErr: `invalid notification event "build_started"`,
+		},
+		"does not notify if there are no notifications": {
+			wantedNotContains: []string{"AWS::SNS::Topic", "AWS::CodeStarNotifications::NotificationRule"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			in := mockCreatePipelineInput()
+			in.Notifications = tc.inNotifications
+			pipeline := NewPipelineStackConfig(in)
+
+			tmpl, err := pipeline.Template()
+
+			if tc.wantedErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.wantedErr)
+				return
+			}
+			require.NoError(t, err, "template serialization failed")
+			for _, wanted := range tc.wantedContains {
+				require.Contains(t, tmpl, wanted)
+			}
+			for _, notWanted := range tc.wantedNotContains {
+				require.NotContains(t, tmpl, notWanted)
+			}
+		})
+	}
+}
+
+func TestPipelineStackConfig_NotificationRuleName(t *testing.T) {
+	testCases := map[string]struct {
+		inProjectName  string
+		inPipelineName string
+
+		wantedName string
+	}{
+		"uses the stack name": {
+			inProjectName:  "phonetool",
+			inPipelineName: "pipeline-phonetool-api",
+
+			wantedName: "phonetool-pipeline-phonetool-api-Notifications",
+		},
+		"truncates long stack names": {
+			inProjectName:  "phonetool",
+			inPipelineName: "pipeline-phonetool-a-very-long-repository-name-for-the-frontend",
+
+			wantedName: "phonetool-pipeline-phonetool-a-very-long-9e087841-Notifications",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			in := mockCreatePipelineInput()
+			in.ProjectName = tc.inProjectName
+			in.Name = tc.inPipelineName
+			in.Notifications = &deploy.PipelineNotifications{
+				Emails: []string{"team@example.com"},
+			}
+			pipeline := NewPipelineStackConfig(in)
+
+			ruleName := pipeline.NotificationRuleName()
+			tmpl, err := pipeline.Template()
+
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(ruleName), 64)
+			require.Equal(t, tc.wantedName, ruleName)
+			require.Contains(t, tmpl, "      Name: "+tc.wantedName+"\n")
+		})
+	}
+}
+
 func mockAssociatedEnv(envName, region string, isProd bool) *deploy.AssociatedEnvironment {
 	return &deploy.AssociatedEnvironment{
 		Name:      envName,
@@ -103,10 +409,13 @@ func mockCreatePipelineInput() *deploy.CreatePipelineInput {
 			{
 				AssociatedEnvironment: mockAssociatedEnv("test-chicken", "us-west-2", false),
 				LocalApplications:     []string{"frontend", "backend"},
+				TestCommands:          []string{"make test", `echo "$FRONTEND_URL"`},
+				DeployOrder:           []string{"backend"},
 			},
 			{
 				AssociatedEnvironment: mockAssociatedEnv("prod-can-fly", "us-east-1", true),
 				LocalApplications:     []string{"frontend", "backend"},
+				RequiresApproval:      true,
 			},
 		},
 		ArtifactBuckets: []deploy.ArtifactBucket{
@@ -5,7 +5,8 @@ package stack
 
 // Tag keys used while creating stacks.
 const (
-	ProjectTagKey = "ecs-project"
-	EnvTagKey     = "ecs-environment"
-	AppTagKey     = "ecs-application"
+	ProjectTagKey  = "ecs-project"
+	EnvTagKey      = "ecs-environment"
+	AppTagKey      = "ecs-application"
+	PipelineTagKey = "ecs-pipeline"
 )
@@ -11,6 +11,7 @@ const (
 
 var templateFunctions = map[string]interface{}{
 	"logicalIDSafe": logicalIDSafe,
+	"singleQuote":   singleQuote,
 }
 
 // logicalIDSafe takes a CloudFormation logical ID, and
@@ -26,3 +27,9 @@ func logicalIDSafe(logicalID string) string {
 func safeLogicalIDToOriginal(safeLogicalID string) string {
 	return strings.ReplaceAll(safeLogicalID, dashReplacement, "-")
 }
+
+// singleQuote renders a value as a YAML single-quoted scalar,
+// escaping the single quotes in the value by doubling them.
+func singleQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
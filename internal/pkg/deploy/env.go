@@ -11,12 +11,32 @@ import (
 
 // CreateEnvironmentInput holds the fields required to deploy an environment.
 type CreateEnvironmentInput struct {
-	Project                  string // Name of the project this environment belongs to.
-	Name                     string // Name of the environment, must be unique within a project.
-	Prod                     bool   // Whether or not this environment is a production environment.
-	PublicLoadBalancer       bool   // Whether or not this environment should contain a shared public load balancer between applications.
-	ToolsAccountPrincipalARN string // The Principal ARN of the tools account.
-	ProjectDNSName           string // The DNS name of this project, if it exists
+	Project                  string           // Name of the project this environment belongs to.
+	Name                     string           // Name of the environment, must be unique within a project.
+	Prod                     bool             // Whether or not this environment is a production environment.
+	PublicLoadBalancer       bool             // Whether or not this environment should contain a shared public load balancer between applications.
+	ToolsAccountPrincipalARN string           // The Principal ARN of the tools account.
+	ProjectDNSName           string           // The DNS name of this project, if it exists
+	ImportCertARN            string           // Optional ARN of an existing ACM certificate for the HTTPS listener.
+	ContainerInsights        bool             // Whether or not CloudWatch Container Insights is enabled on the cluster.
+	FargateSpot              bool             // Whether or not the FARGATE_SPOT capacity provider is available in the cluster.
+	AdjustVPC                *AdjustVPCConfig // Optional CIDR ranges of the VPC created for the environment.
+	ImportVPC                *ImportVPCConfig // Optional existing VPC to deploy the environment into instead of creating one.
+}
+
+// AdjustVPCConfig holds the CIDR ranges of the VPC and subnets created for an environment.
+type AdjustVPCConfig struct {
+	CIDR               string   // CIDR range of the VPC.
+	PublicSubnetCIDRs  []string // CIDR ranges of the two public subnets.
+	PrivateSubnetCIDRs []string // CIDR ranges of the two private subnets.
+	Endpoints          bool     // Whether or not to create VPC endpoints for ECR, S3, CloudWatch Logs, Secrets Manager and SSM.
+}
+
+// ImportVPCConfig holds the IDs of an existing VPC and its subnets.
+type ImportVPCConfig struct {
+	ID               string   // ID of the VPC.
+	PublicSubnetIDs  []string // IDs of the subnets to place the public load balancer in.
+	PrivateSubnetIDs []string // IDs of the subnets to place the applications' tasks in.
 }
 
 // CreateEnvironmentResponse holds the created environment on successful deployment.
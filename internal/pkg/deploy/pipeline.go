@@ -6,9 +6,11 @@
 package deploy
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
 
@@ -19,10 +21,52 @@ import (
 // NOTE: this is duplicated from validate.go
 var githubRepoExp = regexp.MustCompile(`(https:\/\/github\.com\/|)(?P<owner>.+)\/(?P<repo>.+)`)
 
+// codecommitRepoExp matches the HTTPS, SSH and git-remote-codecommit URLs of a CodeCommit repository.
+// For example: "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/wings" or "codecommit::us-west-2://wings".
+var codecommitRepoExp = regexp.MustCompile(`^(?:(?:https|ssh)://(?:[^@/]+@)?git-codecommit\.[a-z0-9-]+\.amazonaws\.com(?:\.cn)?/v1/repos/|codecommit:(?::[a-z0-9-]+:)?//(?:[^@/]+@)?)(?P<repo>[\w.-]+)$`)
+
+// connectionRepoExp matches the URL or the full repository ID of a repository hosted on Bitbucket, GitHub or GitHub Enterprise Server.
+// For example: "https://bitbucket.org/aws/wings", "git@ghe.example.com:aws/wings.git" or "aws/wings".
+var connectionRepoExp = regexp.MustCompile(`^(?:(?:https?|ssh|git)://(?:[^@/]+@)?[^/]+/|[^@/:]+@[^/:]+:)?(?P<owner>[^/:@]+)/(?P<repo>[^/]+?)(?:\.git)?$`)
+
+const (
+	fmtInvalidGitHubRepo     = "unable to locate the repository from the properties: %+v"
+	fmtInvalidCodeCommitRepo = "unable to locate the CodeCommit repository from the properties: %+v"
+	fmtInvalidConnectionRepo = "unable to locate the repository owner and name from the properties: %+v"
+)
+
+const (
+	// connectionResourceName is the logical ID of the CodeStar connection created by the pipeline stack.
+	connectionResourceName = "SourceConnection"
+	// maxConnectionNameLength is the maximum length of the name of a CodeStar connection.
+	maxConnectionNameLength = 32
+)
+
 const (
-	fmtInvalidGitHubRepo = "unable to locate the repository from the properties: %+v"
+	sourceActionOwnerThirdParty = "ThirdParty"
+	sourceActionOwnerAWS        = "AWS"
 )
 
+const (
+	defaultBuildImage       = "aws/codebuild/amazonlinux2-x86_64-standard:1.0"
+	defaultBuildComputeType = manifest.BuildComputeTypeSmall
+)
+
+// notificationEventTypeIDs maps the events of a pipeline manifest to the IDs of the CodeStar Notifications events.
+// See https://docs.aws.amazon.com/dtconsole/latest/userguide/concepts.html#events-ref-pipeline
+var notificationEventTypeIDs = map[string]string{
+	manifest.NotificationEventStageFailed:       "codepipeline-pipeline-stage-execution-failed",
+	manifest.NotificationEventApprovalNeeded:    "codepipeline-pipeline-manual-approval-needed",
+	manifest.NotificationEventPipelineFailed:    "codepipeline-pipeline-pipeline-execution-failed",
+	manifest.NotificationEventPipelineSucceeded: "codepipeline-pipeline-pipeline-execution-succeeded",
+}
+
+// defaultNotificationEvents are the events sent as notifications if none are configured.
+var defaultNotificationEvents = []string{
+	manifest.NotificationEventStageFailed,
+	manifest.NotificationEventApprovalNeeded,
+}
+
 // CreatePipelineInput represents the fields required to deploy a pipeline.
 type CreatePipelineInput struct {
 	// Name of the project this pipeline belongs to
@@ -41,6 +85,83 @@ type CreatePipelineInput struct {
 	// A list of artifact buckets and corresponding KMS keys that will
 	// be used in this pipeline.
 	ArtifactBuckets []ArtifactBucket
+
+	// The path of the buildspec run by the build stage, relative to the root of the repository.
+	// Defaults to the buildspec under the project directory.
+	BuildspecPath string
+
+	// The settings of the CodeBuild project that builds the applications.
+	// Defaults to the settings of DefaultPipelineBuild if nil.
+	Build *PipelineBuild
+
+	// The targets notified of the events of the pipeline, nil if there are none.
+	Notifications *PipelineNotifications
+}
+
+// PipelineBuild represents the settings of the CodeBuild project that builds the applications of a pipeline.
+type PipelineBuild struct {
+	// The CodeBuild image the build runs in.
+	Image string
+
+	// The compute type of the build host, for example "BUILD_GENERAL1_SMALL".
+	ComputeType string
+
+	// Whether the build runs in privileged mode, which is required to build Docker images.
+	PrivilegedMode bool
+
+	// Whether the Docker layers are cached on the build host.
+	DockerLayerCache bool
+
+	// The S3 bucket that stores the paths cached by the buildspec, empty if no paths are cached.
+	// It takes precedence over the Docker layer cache.
+	CacheBucket string
+}
+
+// DefaultPipelineBuild returns the settings of the build project used when the pipeline manifest doesn't override them.
+func DefaultPipelineBuild() *PipelineBuild {
+	return &PipelineBuild{
+		Image:            defaultBuildImage,
+		ComputeType:      defaultBuildComputeType,
+		PrivilegedMode:   true,
+		DockerLayerCache: true,
+	}
+}
+
+// PipelineNotifications represents the targets notified of the events of a pipeline.
+type PipelineNotifications struct {
+	// The ARN of an existing SNS topic that notifications are published to.
+	TopicARN string
+
+	// Email addresses subscribed to a topic created by the pipeline stack.
+	Emails []string
+
+	// The events that trigger a notification, for example "stage_failed".
+	Events []string
+}
+
+// CreatesTopic returns true if a topic should be created by the pipeline stack for the email subscriptions.
+func (n *PipelineNotifications) CreatesTopic() bool {
+	return len(n.Emails) > 0
+}
+
+// EventTypeIDs returns the IDs of the CodeStar Notifications events that trigger a notification.
+// If no events are configured, failed stages and manual approvals trigger a notification.
+func (n *PipelineNotifications) EventTypeIDs() ([]string, error) {
+	events := n.Events
+	if len(events) == 0 {
+		events = defaultNotificationEvents
+	}
+	var ids []string
+	for _, event := range events {
+		id, ok := notificationEventTypeIDs[event]
+		if !ok {
+			return nil, fmt.Errorf("invalid notification event %q, must be one of %s", event,
+				strings.Join([]string{manifest.NotificationEventStageFailed, manifest.NotificationEventApprovalNeeded,
+					manifest.NotificationEventPipelineFailed, manifest.NotificationEventPipelineSucceeded}, ", "))
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
 }
 
 // ArtifactBucket represents an S3 bucket used by the CodePipeline to store
@@ -76,6 +197,134 @@ type Source struct {
 	Properties map[string]interface{}
 }
 
+// ActionOwner returns the owner of the CodePipeline source action type for the provider.
+// GitHub actions are owned by a third party, whereas CodeCommit and CodeStar connection actions are owned by AWS.
+func (s *Source) ActionOwner() (string, error) {
+	switch s.ProviderName {
+	case manifest.GithubProviderName:
+		return sourceActionOwnerThirdParty, nil
+	case manifest.CodeCommitProviderName, manifest.ConnectionProviderName:
+		return sourceActionOwnerAWS, nil
+	default:
+		return "", fmt.Errorf("invalid provider: %s", s.ProviderName)
+	}
+}
+
+// ActionConfiguration returns the configuration properties of the CodePipeline source action for the provider.
+// See https://docs.aws.amazon.com/codepipeline/latest/userguide/reference-pipeline-structure.html#action-requirements
+func (s *Source) ActionConfiguration() (map[string]string, error) {
+	repo, err := s.Repository()
+	if err != nil {
+		return nil, err
+	}
+	branch, err := s.Branch()
+	if err != nil {
+		return nil, err
+	}
+	switch s.ProviderName {
+	case manifest.GithubProviderName:
+		owner, err := s.Owner()
+		if err != nil {
+			return nil, err
+		}
+		secretID, err := s.GitHubPersonalAccessTokenSecretID()
+		if err != nil {
+			return nil, err
+		}
+		return map[string]string{
+			"Owner":  owner,
+			"Repo":   repo,
+			"Branch": branch,
+			// Use the *entire* SecretString with version AWSCURRENT.
+			// https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/dynamic-references.html#dynamic-references-secretsmanager
+			"OAuthToken": fmt.Sprintf("'{{resolve:secretsmanager:%s}}'", secretID),
+		}, nil
+	case manifest.ConnectionProviderName:
+		owner, err := s.Owner()
+		if err != nil {
+			return nil, err
+		}
+		connectionARN := fmt.Sprintf("!Ref %s", connectionResourceName)
+		if s.ConnectionARN() != "" {
+			connectionARN = s.ConnectionARN()
+		}
+		return map[string]string{
+			"ConnectionArn":    connectionARN,
+			"FullRepositoryId": fmt.Sprintf("%s/%s", owner, repo),
+			"BranchName":       branch,
+		}, nil
+	default:
+		return map[string]string{
+			"RepositoryName": repo,
+			"BranchName":     branch,
+		}, nil
+	}
+}
+
+// Branch returns the branch of the repository that triggers the pipeline.
+func (s *Source) Branch() (string, error) {
+	branch, ok := s.Properties["branch"].(string)
+	if !ok || branch == "" {
+		return "", fmt.Errorf("unable to locate the branch from the properties: %+v", s.Properties)
+	}
+	return branch, nil
+}
+
+// ConnectionARN returns the ARN of the existing CodeStar connection used by the source.
+// It returns an empty string if the connection should be created by the pipeline stack.
+func (s *Source) ConnectionARN() string {
+	arn, _ := s.Properties["connection_arn"].(string)
+	return arn
+}
+
+// IsCodeCommit returns true if the source is a CodeCommit repository, which the pipeline role accesses directly.
+func (s *Source) IsCodeCommit() bool {
+	return s.ProviderName == manifest.CodeCommitProviderName
+}
+
+// UsesConnection returns true if the source is authorized through a CodeStar connection.
+func (s *Source) UsesConnection() bool {
+	return s.ProviderName == manifest.ConnectionProviderName
+}
+
+// CreatesConnection returns true if the source is authorized through a CodeStar connection
+// that does not exist yet, and should be created along with the pipeline.
+func (s *Source) CreatesConnection() bool {
+	return s.UsesConnection() && s.ConnectionARN() == ""
+}
+
+// ConnectionName returns the name of the CodeStar connection created by the pipeline stack.
+func (s *Source) ConnectionName() (string, error) {
+	repo, err := s.Repository()
+	if err != nil {
+		return "", err
+	}
+	if len(repo) > maxConnectionNameLength {
+		return repo[:maxConnectionNameLength], nil
+	}
+	return repo, nil
+}
+
+// ConnectionProviderType returns the type of the third-party provider of the CodeStar connection,
+// for example "Bitbucket".
+func (s *Source) ConnectionProviderType() (string, error) {
+	providerType, _ := s.Properties["provider_type"].(string)
+	switch providerType {
+	case manifest.BitbucketConnectionType, manifest.GitHubConnectionType, manifest.GitHubEnterpriseConnectionType:
+		return providerType, nil
+	default:
+		return "", fmt.Errorf("invalid connection provider type %q, must be one of %s", providerType,
+			strings.Join([]string{manifest.BitbucketConnectionType, manifest.GitHubConnectionType, manifest.GitHubEnterpriseConnectionType}, ", "))
+	}
+}
+
+// ConnectionHostARN returns the ARN of the CodeStar host of a GitHub Enterprise Server,
+// or an empty string if the source is not hosted on a GitHub Enterprise Server.
+func (s *Source) ConnectionHostARN() string {
+	arn, _ := s.Properties["host_arn"].(string)
+	return arn
+}
+
 // GitHubPersonalAccessTokenSecretID returns the ID of the secret in the
 // Secrets manager, which stores the GitHub Personal Access token if the
 // provider is "GitHub". Otherwise, it returns an error.
@@ -134,10 +383,45 @@ func (s *Source) parseOwnerAndRepo() (*ownerAndRepo, error) {
 	}, nil
 }
 
+func (s *Source) parseConnectionOwnerAndRepo() (*ownerAndRepo, error) {
+	repoURL, ok := s.Properties["repository"].(string)
+	if !ok {
+		return nil, fmt.Errorf(fmtInvalidConnectionRepo, s.Properties)
+	}
+	match := connectionRepoExp.FindStringSubmatch(repoURL)
+	if len(match) == 0 {
+		return nil, fmt.Errorf(fmtInvalidConnectionRepo, repoURL)
+	}
+	return &ownerAndRepo{
+		owner: match[1],
+		repo:  match[2],
+	}, nil
+}
+
+func (s *Source) parseCodeCommitRepo() (string, error) {
+	repoURL, ok := s.Properties["repository"].(string)
+	if !ok {
+		return "", fmt.Errorf(fmtInvalidCodeCommitRepo, s.Properties)
+	}
+	match := codecommitRepoExp.FindStringSubmatch(repoURL)
+	if len(match) == 0 {
+		// The repository can also be referred to directly by its name.
+		if strings.ContainsAny(repoURL, ":/") || repoURL == "" {
+			return "", fmt.Errorf(fmtInvalidCodeCommitRepo, repoURL)
+		}
+		return repoURL, nil
+	}
+	return match[1], nil
+}
+
 // Repository returns the repository portion. For example,
-// given "aws/amazon-ecs-cli-v2", this function returns "amazon-ecs-cli-v2"
+// given "aws/amazon-ecs-cli-v2", this function returns "amazon-ecs-cli-v2".
+// For CodeCommit, given "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/wings", it returns "wings".
 func (s *Source) Repository() (string, error) {
-	oAndR, err := s.parseOwnerAndRepo()
+	if s.ProviderName == manifest.CodeCommitProviderName {
+		return s.parseCodeCommitRepo()
+	}
+	oAndR, err := s.ownerAndRepo()
 	if err != nil {
 		return "", err
 	}
@@ -147,19 +431,104 @@ func (s *Source) Repository() (string, error) {
 // Owner returns the repository owner portion. For example,
 // given "aws/amazon-ecs-cli-v2", this function returns "aws"
 func (s *Source) Owner() (string, error) {
-	oAndR, err := s.parseOwnerAndRepo()
+	oAndR, err := s.ownerAndRepo()
 	if err != nil {
 		return "", err
 	}
 	return oAndR.owner, nil
 }
 
+func (s *Source) ownerAndRepo() (*ownerAndRepo, error) {
+	if s.ProviderName == manifest.ConnectionProviderName {
+		return s.parseConnectionOwnerAndRepo()
+	}
+	return s.parseOwnerAndRepo()
+}
+
 // PipelineStage represents configuration for each deployment stage
 // of a workspace. A stage consists of the Archer Environment the pipeline
 // is deloying to and the containerized applications that will be deployed.
 type PipelineStage struct {
 	*AssociatedEnvironment
 	LocalApplications []string
+
+	// Whether a manual approval is required before deploying to the stage.
+	RequiresApproval bool
+	// Commands run against the deployed applications to test the stage.
+	TestCommands []string
+	// Applications that are deployed one after the other, before the rest of the applications.
+	DeployOrder []string
+}
+
+// AppDeployRunOrder returns the order in which the application is deployed within the stage.
+// Applications listed in the deploy order are deployed sequentially, and all other applications are
+// deployed together afterwards.
+func (s *PipelineStage) AppDeployRunOrder(appName string) int {
+	order := 1
+	if s.RequiresApproval {
+		order++
+	}
+	for _, app := range s.orderedApps() {
+		if app == appName {
+			return order
+		}
+		order++
+	}
+	return order
+}
+
+// TestCommandsRunOrder returns the order of the test action, after all the applications are deployed.
+func (s *PipelineStage) TestCommandsRunOrder() int {
+	last := 0
+	for _, app := range s.LocalApplications {
+		if order := s.AppDeployRunOrder(app); order > last {
+			last = order
+		}
+	}
+	return last + 1
+}
+
+// AppVariablesNamespace returns the namespace of the variables, such as the URL of the application,
+// produced by the action that deploys the application.
+func (s *PipelineStage) AppVariablesNamespace(appName string) string {
+	return fmt.Sprintf("%s-%s", s.Name, appName)
+}
+
+// TestCommandsEnvironmentVariables returns the JSON list of environment variables passed to the test commands.
+// The URL of each application is available as "<APP_NAME>_URL", for example "FRONT_END_URL".
+func (s *PipelineStage) TestCommandsEnvironmentVariables() (string, error) {
+	type envVar struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+		Type  string `json:"type"`
+	}
+	vars := make([]envVar, 0, len(s.LocalApplications))
+	for _, app := range s.LocalApplications {
+		vars = append(vars, envVar{
+			Name:  strings.ToUpper(strings.ReplaceAll(app, "-", "_")) + "_URL",
+			Value: fmt.Sprintf("#{%s.URL}", s.AppVariablesNamespace(app)),
+			Type:  "PLAINTEXT",
+		})
+	}
+	out, err := json.Marshal(vars)
+	if err != nil {
+		return "", fmt.Errorf("marshal environment variables of the test commands: %w", err)
+	}
+	return string(out), nil
+}
+
+// orderedApps returns the applications of the stage that are part of the deploy order.
+func (s *PipelineStage) orderedApps() []string {
+	var apps []string
+	for _, app := range s.DeployOrder {
+		for _, local := range s.LocalApplications {
+			if app == local {
+				apps = append(apps, app)
+				break
+			}
+		}
+	}
+	return apps
 }
 
 // AppTemplatePath returns the full path to the application CFN template
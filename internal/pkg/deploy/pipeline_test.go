@@ -78,3 +78,361 @@ func TestParseOwnerAndRepo(t *testing.T) {
 		})
 	}
 }
+
+func TestSourceRepository_CodeCommit(t *testing.T) {
+	testCases := map[string]struct {
+		repository     interface{}
+		expectedRepo   string
+		expectedErrMsg string
+	}{
+		"https URL": {
+			repository:   "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/wings",
+			expectedRepo: "wings",
+		},
+		"ssh URL with user": {
+			repository:   "ssh://APKAEIBAERJR2EXAMPLE@git-codecommit.eu-west-1.amazonaws.com/v1/repos/chicken.wings",
+			expectedRepo: "chicken.wings",
+		},
+		"git-remote-codecommit URL": {
+			repository:   "codecommit::us-east-1://profile@wings",
+			expectedRepo: "wings",
+		},
+		"repository name": {
+			repository:   "wings",
+			expectedRepo: "wings",
+		},
+		"GitHub URL": {
+			repository:     "https://github.com/badgoose/chaOS",
+			expectedErrMsg: "unable to locate the CodeCommit repository from the properties: https://github.com/badgoose/chaOS",
+		},
+		"missing repository": {
+			expectedErrMsg: "unable to locate the CodeCommit repository from the properties",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			src := &Source{
+				ProviderName: "CodeCommit",
+				Properties:   map[string]interface{}{},
+			}
+			if tc.repository != nil {
+				src.Properties["repository"] = tc.repository
+			}
+
+			repo, err := src.Repository()
+
+			if tc.expectedErrMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedRepo, repo)
+			}
+		})
+	}
+}
+
+func TestSourceOwnerAndRepository_Connection(t *testing.T) {
+	testCases := map[string]struct {
+		repository     string
+		expectedOwner  string
+		expectedRepo   string
+		expectedErrMsg string
+	}{
+		"Bitbucket https URL": {
+			repository:    "https://bitbucket.org/badgoose/chaOS.git",
+			expectedOwner: "badgoose",
+			expectedRepo:  "chaOS",
+		},
+		"Bitbucket https URL with user": {
+			repository:    "https://goose@bitbucket.org/badgoose/chaOS",
+			expectedOwner: "badgoose",
+			expectedRepo:  "chaOS",
+		},
+		"GitHub Enterprise Server ssh URL": {
+			repository:    "git@ghe.example.com:badgoose/chaOS.git",
+			expectedOwner: "badgoose",
+			expectedRepo:  "chaOS",
+		},
+		"full repository ID": {
+			repository:    "badgoose/chaOS",
+			expectedOwner: "badgoose",
+			expectedRepo:  "chaOS",
+		},
+		"missing owner": {
+			repository:     "https://bitbucket.org/chaOS",
+			expectedErrMsg: "unable to locate the repository owner and name from the properties: https://bitbucket.org/chaOS",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			src := &Source{
+				ProviderName: "CodeStarSourceConnection",
+				Properties: map[string]interface{}{
+					"repository": tc.repository,
+				},
+			}
+
+			owner, ownerErr := src.Owner()
+			repo, repoErr := src.Repository()
+
+			if tc.expectedErrMsg != "" {
+				require.EqualError(t, ownerErr, tc.expectedErrMsg)
+				require.EqualError(t, repoErr, tc.expectedErrMsg)
+			} else {
+				require.NoError(t, ownerErr)
+				require.NoError(t, repoErr)
+				require.Equal(t, tc.expectedOwner, owner)
+				require.Equal(t, tc.expectedRepo, repo)
+			}
+		})
+	}
+}
+
+func TestSourceConnectionProviderType(t *testing.T) {
+	testCases := map[string]struct {
+		providerType   interface{}
+		expectedType   string
+		expectedErrMsg string
+	}{
+		"Bitbucket": {
+			providerType: "Bitbucket",
+			expectedType: "Bitbucket",
+		},
+		"GitHub Enterprise Server": {
+			providerType: "GitHubEnterpriseServer",
+			expectedType: "GitHubEnterpriseServer",
+		},
+		"unknown type": {
+			providerType:   "GitLab",
+			expectedErrMsg: `invalid connection provider type "GitLab", must be one of Bitbucket, GitHub, GitHubEnterpriseServer`,
+		},
+		"missing type": {
+			expectedErrMsg: `invalid connection provider type "", must be one of Bitbucket, GitHub, GitHubEnterpriseServer`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			src := &Source{
+				ProviderName: "CodeStarSourceConnection",
+				Properties: map[string]interface{}{
+					"provider_type": tc.providerType,
+				},
+			}
+
+			providerType, err := src.ConnectionProviderType()
+
+			if tc.expectedErrMsg != "" {
+				require.EqualError(t, err, tc.expectedErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedType, providerType)
+			}
+		})
+	}
+}
+
+func TestSourceActionConfiguration(t *testing.T) {
+	testCases := map[string]struct {
+		src            *Source
+		expectedOwner  string
+		expectedConfig map[string]string
+		expectedErrMsg string
+	}{
+		"GitHub source": {
+			src: &Source{
+				ProviderName: "GitHub",
+				Properties: map[string]interface{}{
+					"repository":          "https://github.com/badgoose/chaOS",
+					"branch":              "master",
+					"access_token_secret": "github-token-badgoose-chaOS",
+				},
+			},
+			expectedOwner: "ThirdParty",
+			expectedConfig: map[string]string{
+				"Owner":      "badgoose",
+				"Repo":       "chaOS",
+				"Branch":     "master",
+				"OAuthToken": "'{{resolve:secretsmanager:github-token-badgoose-chaOS}}'",
+			},
+		},
+		"CodeCommit source": {
+			src: &Source{
+				ProviderName: "CodeCommit",
+				Properties: map[string]interface{}{
+					"repository": "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/wings",
+					"branch":     "main",
+				},
+			},
+			expectedOwner: "AWS",
+			expectedConfig: map[string]string{
+				"RepositoryName": "wings",
+				"BranchName":     "main",
+			},
+		},
+		"connection source with an existing connection": {
+			src: &Source{
+				ProviderName: "CodeStarSourceConnection",
+				Properties: map[string]interface{}{
+					"repository":     "https://bitbucket.org/badgoose/chaOS",
+					"branch":         "main",
+					"provider_type":  "Bitbucket",
+					"connection_arn": "arn:aws:codestar-connections:us-west-2:1234:connection/abcd",
+				},
+			},
+			expectedOwner: "AWS",
+			expectedConfig: map[string]string{
+				"ConnectionArn":    "arn:aws:codestar-connections:us-west-2:1234:connection/abcd",
+				"FullRepositoryId": "badgoose/chaOS",
+				"BranchName":       "main",
+			},
+		},
+		"connection source without a connection": {
+			src: &Source{
+				ProviderName: "CodeStarSourceConnection",
+				Properties: map[string]interface{}{
+					"repository":    "https://bitbucket.org/badgoose/chaOS",
+					"branch":        "main",
+					"provider_type": "Bitbucket",
+				},
+			},
+			expectedOwner: "AWS",
+			expectedConfig: map[string]string{
+				"ConnectionArn":    "!Ref SourceConnection",
+				"FullRepositoryId": "badgoose/chaOS",
+				"BranchName":       "main",
+			},
+		},
+		"missing branch": {
+			src: &Source{
+				ProviderName: "CodeCommit",
+				Properties: map[string]interface{}{
+					"repository": "wings",
+				},
+			},
+			expectedOwner:  "AWS",
+			expectedErrMsg: "unable to locate the branch from the properties",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			owner, err := tc.src.ActionOwner()
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedOwner, owner)
+
+			config, err := tc.src.ActionConfiguration()
+
+			if tc.expectedErrMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedConfig, config)
+			}
+		})
+	}
+}
+
+func TestSource_ProviderPermissions(t *testing.T) {
+	testCases := map[string]struct {
+		src *Source
+
+		wantedIsCodeCommit      bool
+		wantedUsesConnection    bool
+		wantedCreatesConnection bool
+	}{
+		"GitHub source": {
+			src: &Source{ProviderName: "GitHub"},
+		},
+		"CodeCommit source": {
+			src: &Source{ProviderName: "CodeCommit"},
+
+			wantedIsCodeCommit: true,
+		},
+		"connection source with an existing connection": {
+			src: &Source{
+				ProviderName: "CodeStarSourceConnection",
+				Properties: map[string]interface{}{
+					"connection_arn": "arn:aws:codestar-connections:us-west-2:1234:connection/abcd",
+				},
+			},
+
+			wantedUsesConnection: true,
+		},
+		"connection source without a connection": {
+			src: &Source{ProviderName: "CodeStarSourceConnection"},
+
+			wantedUsesConnection:    true,
+			wantedCreatesConnection: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedIsCodeCommit, tc.src.IsCodeCommit())
+			require.Equal(t, tc.wantedUsesConnection, tc.src.UsesConnection())
+			require.Equal(t, tc.wantedCreatesConnection, tc.src.CreatesConnection())
+		})
+	}
+}
+
+func TestPipelineStage_RunOrders(t *testing.T) {
+	testCases := map[string]struct {
+		stage *PipelineStage
+
+		expectedAppRunOrders map[string]int
+		expectedTestRunOrder int
+	}{
+		"deploys all applications together": {
+			stage: &PipelineStage{
+				LocalApplications: []string{"frontend", "backend"},
+			},
+			expectedAppRunOrders: map[string]int{"frontend": 1, "backend": 1},
+			expectedTestRunOrder: 2,
+		},
+		"deploys after the approval": {
+			stage: &PipelineStage{
+				LocalApplications: []string{"frontend", "backend"},
+				RequiresApproval:  true,
+			},
+			expectedAppRunOrders: map[string]int{"frontend": 2, "backend": 2},
+			expectedTestRunOrder: 3,
+		},
+		"deploys ordered applications first": {
+			stage: &PipelineStage{
+				LocalApplications: []string{"frontend", "backend", "api", "worker"},
+				RequiresApproval:  true,
+				DeployOrder:       []string{"api", "unknown", "backend"},
+			},
+			expectedAppRunOrders: map[string]int{"api": 2, "backend": 3, "frontend": 4, "worker": 4},
+			expectedTestRunOrder: 5,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for app, wanted := range tc.expectedAppRunOrders {
+				require.Equal(t, wanted, tc.stage.AppDeployRunOrder(app), "unexpected run order for %s", app)
+			}
+			require.Equal(t, tc.expectedTestRunOrder, tc.stage.TestCommandsRunOrder())
+		})
+	}
+}
+
+func TestPipelineStage_TestCommandsEnvironmentVariables(t *testing.T) {
+	stage := &PipelineStage{
+		AssociatedEnvironment: &AssociatedEnvironment{
+			Name: "test",
+		},
+		LocalApplications: []string{"front-end"},
+	}
+
+	vars, err := stage.TestCommandsEnvironmentVariables()
+
+	require.NoError(t, err)
+	require.Equal(t, `[{"name":"FRONT_END_URL","value":"#{test-front-end.URL}","type":"PLAINTEXT"}]`, vars)
+}
@@ -0,0 +1,323 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/cloudwatch"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/ecs"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/elbv2"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/session"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+)
+
+const (
+	// Logical IDs of the resources in templates/lb-fargate-service/cf.yml.
+	appStackServiceLogicalID     = "Service"
+	appStackTargetGroupLogicalID = "TargetGroup"
+
+	// Rollout states of a deployment.
+	rolloutStateCompleted  = "COMPLETED"
+	rolloutStateInProgress = "IN_PROGRESS"
+	rolloutStateDraining   = "DRAINING"
+
+	primaryDeploymentStatus = "PRIMARY"
+)
+
+type serviceStatusDescriber interface {
+	ServiceStatus(cluster, service string) (*ecs.ServiceStatus, error)
+	ServiceTasks(cluster, service string) ([]*ecs.Task, error)
+}
+
+type targetsHealthDescriber interface {
+	TargetsHealth(targetGroupARN string) ([]*elbv2.TargetHealth, error)
+}
+
+type alarmsDescriber interface {
+	AlarmsWithDimensions(dimensionSets ...map[string]string) ([]*cloudwatch.Alarm, error)
+}
+
+// AppDeployment contains serialized parameters of a deployment of an application.
+type AppDeployment struct {
+	ID             string    `json:"id"`
+	Status         string    `json:"status"`
+	RolloutState   string    `json:"rolloutState"`
+	TaskDefinition string    `json:"taskDefinition"`
+	DesiredCount   int64     `json:"desiredCount"`
+	RunningCount   int64     `json:"runningCount"`
+	PendingCount   int64     `json:"pendingCount"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+// AppTask contains serialized parameters of a task of an application.
+type AppTask struct {
+	ID             string     `json:"id"`
+	TaskDefinition string     `json:"taskDefinition"`
+	LastStatus     string     `json:"lastStatus"`
+	HealthStatus   string     `json:"healthStatus"`
+	StartedAt      *time.Time `json:"startedAt,omitempty"`
+}
+
+// AppTarget contains serialized parameters of the health of a load balancer target of an application.
+type AppTarget struct {
+	ID     string `json:"id"`
+	Port   int64  `json:"port"`
+	TaskID string `json:"taskID,omitempty"` // Empty if no task of the application has the target's IP address.
+	State  string `json:"state"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// AppAlarm contains serialized parameters of an alarm of an application in ALARM state.
+type AppAlarm struct {
+	Name      string    `json:"name"`
+	Metric    string    `json:"metric"`
+	Reason    string    `json:"reason"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// AppStatus contains serialized parameters of the runtime state of an application in an environment.
+type AppStatus struct {
+	Project      string           `json:"project"`
+	AppName      string           `json:"appName"`
+	Environment  string           `json:"environment"`
+	DesiredCount int64            `json:"desiredCount"`
+	RunningCount int64            `json:"runningCount"`
+	PendingCount int64            `json:"pendingCount"`
+	Deployments  []*AppDeployment `json:"deployments"`
+	Tasks        []*AppTask       `json:"tasks"`
+	Targets      []*AppTarget     `json:"targets"`
+	Alarms       []*AppAlarm      `json:"alarms"`
+}
+
+// AppStatusDescriber retrieves the runtime state of an application deployed in an environment.
+type AppStatusDescriber struct {
+	env     *archer.Environment
+	appName string
+
+	stackDescriber stackDescriber
+	ecsSvc         serviceStatusDescriber
+	elbSvc         targetsHealthDescriber
+	cwSvc          alarmsDescriber
+}
+
+// NewAppStatusDescriber instantiates a describer for the runtime state of an application in an environment.
+func NewAppStatusDescriber(env *archer.Environment, appName string) (*AppStatusDescriber, error) {
+	sess, err := session.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return &AppStatusDescriber{
+		env:            env,
+		appName:        appName,
+		stackDescriber: cloudformation.New(sess),
+		ecsSvc:         ecs.New(sess),
+		elbSvc:         elbv2.New(sess),
+		cwSvc:          cloudwatch.New(sess),
+	}, nil
+}
+
+// Describe returns the task counts, deployments, tasks, load balancer targets health and
+// alarms in ALARM state of the ECS service of the application.
+func (d *AppStatusDescriber) Describe() (*AppStatus, error) {
+	cluster, err := envClusterID(d.stackDescriber, d.env)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := d.appStackResources()
+	if err != nil {
+		return nil, err
+	}
+	serviceARN, ok := resources[appStackServiceLogicalID]
+	if !ok {
+		return nil, fmt.Errorf("service of application %s not found in environment %s", d.appName, d.env.Name)
+	}
+	// Service ARN example: arn:aws:ecs:us-west-2:123456789012:service/phonetool-test-Cluster/phonetool-test-frontend-Service
+	serviceName := serviceARN[strings.LastIndex(serviceARN, "/")+1:]
+
+	serviceStatus, err := d.ecsSvc.ServiceStatus(cluster, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := d.ecsSvc.ServiceTasks(cluster, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	status := &AppStatus{
+		Project:      d.env.Project,
+		AppName:      d.appName,
+		Environment:  d.env.Name,
+		DesiredCount: serviceStatus.DesiredCount,
+		RunningCount: serviceStatus.RunningCount,
+		PendingCount: serviceStatus.PendingCount,
+		Deployments:  appDeployments(serviceStatus.Deployments),
+	}
+	taskIDByIP := make(map[string]string)
+	for _, task := range tasks {
+		status.Tasks = append(status.Tasks, &AppTask{
+			ID:             task.ID,
+			TaskDefinition: task.TaskDefinition,
+			LastStatus:     task.LastStatus,
+			HealthStatus:   task.HealthStatus,
+			StartedAt:      task.StartedAt,
+		})
+		if task.PrivateIP != "" {
+			taskIDByIP[task.PrivateIP] = task.ID
+		}
+	}
+
+	alarmDimensions := []map[string]string{
+		{
+			"ClusterName": cluster,
+			"ServiceName": serviceName,
+		},
+	}
+	if targetGroupARN, ok := resources[appStackTargetGroupLogicalID]; ok {
+		targets, err := d.elbSvc.TargetsHealth(targetGroupARN)
+		if err != nil {
+			return nil, err
+		}
+		for _, target := range targets {
+			status.Targets = append(status.Targets, &AppTarget{
+				ID:     target.ID,
+				Port:   target.Port,
+				TaskID: taskIDByIP[target.ID],
+				State:  target.State,
+				Reason: target.Reason,
+			})
+		}
+		alarmDimensions = append(alarmDimensions, map[string]string{
+			"TargetGroup": targetGroupDimension(targetGroupARN),
+		})
+	}
+	alarms, err := d.cwSvc.AlarmsWithDimensions(alarmDimensions...)
+	if err != nil {
+		return nil, err
+	}
+	for _, alarm := range alarms {
+		status.Alarms = append(status.Alarms, &AppAlarm{
+			Name:      alarm.Name,
+			Metric:    fmt.Sprintf("%s/%s", alarm.Namespace, alarm.MetricName),
+			Reason:    alarm.Reason,
+			UpdatedAt: alarm.UpdatedAt,
+		})
+	}
+	return status, nil
+}
+
+// appStackResources returns the physical IDs of the resources of the application stack by logical ID.
+func (d *AppStatusDescriber) appStackResources() (map[string]string, error) {
+	stackName := stack.NameForApp(d.env.Project, d.env.Name, d.appName)
+	out, err := d.stackDescriber.DescribeStackResources(&cloudformation.DescribeStackResourcesInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe resources for stack %s: %w", stackName, err)
+	}
+	resources := make(map[string]string)
+	for _, resource := range out.StackResources {
+		resources[aws.StringValue(resource.LogicalResourceId)] = aws.StringValue(resource.PhysicalResourceId)
+	}
+	return resources, nil
+}
+
+// targetGroupDimension returns the value of the "TargetGroup" dimension of the load balancer metrics of a target group,
+// the ARN suffix starting at "targetgroup/".
+// For example, "targetgroup/phonet-Targe-1A2B3C/6d0ecf831eec9f09" for "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/phonet-Targe-1A2B3C/6d0ecf831eec9f09".
+func targetGroupDimension(targetGroupARN string) string {
+	i := strings.Index(targetGroupARN, "targetgroup/")
+	if i == -1 {
+		return targetGroupARN
+	}
+	return targetGroupARN[i:]
+}
+
+// appDeployments returns the deployments along with their rollout state. The primary deployment is complete
+// once it's the only one left and all of its tasks are running, the other deployments are being drained.
+func appDeployments(deployments []*ecs.Deployment) []*AppDeployment {
+	var appDeployments []*AppDeployment
+	for _, d := range deployments {
+		rolloutState := rolloutStateDraining
+		if d.Status == primaryDeploymentStatus {
+			rolloutState = rolloutStateInProgress
+			if len(deployments) == 1 && d.RunningCount == d.DesiredCount && d.PendingCount == 0 {
+				rolloutState = rolloutStateCompleted
+			}
+		}
+		appDeployments = append(appDeployments, &AppDeployment{
+			ID:             d.ID,
+			Status:         d.Status,
+			RolloutState:   rolloutState,
+			TaskDefinition: d.TaskDefinition,
+			DesiredCount:   d.DesiredCount,
+			RunningCount:   d.RunningCount,
+			PendingCount:   d.PendingCount,
+			CreatedAt:      d.CreatedAt,
+			UpdatedAt:      d.UpdatedAt,
+		})
+	}
+	return appDeployments
+}
+
+// JSONString returns the stringified AppStatus struct with json format.
+func (s *AppStatus) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal application status: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified AppStatus struct with human readable format.
+func (s *AppStatus) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, color.Bold.Sprint("About\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", "Project", s.Project)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Name", s.AppName)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Environment", s.Environment)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Tasks", fmt.Sprintf("%d desired, %d running, %d pending", s.DesiredCount, s.RunningCount, s.PendingCount))
+	fmt.Fprintf(writer, color.Bold.Sprint("\nDeployments\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\t%s\n", "ID", "Status", "Rollout", "Task Definition", "Tasks", "Updated At")
+	for _, d := range s.Deployments {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\t%s\n", d.ID, d.Status, d.RolloutState, d.TaskDefinition,
+			fmt.Sprintf("%d/%d", d.RunningCount, d.DesiredCount), d.UpdatedAt.Format(time.RFC3339))
+	}
+	fmt.Fprintf(writer, color.Bold.Sprint("\nTasks\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", "ID", "Task Definition", "Last Status", "Health", "Started At")
+	for _, task := range s.Tasks {
+		startedAt := noValue
+		if task.StartedAt != nil {
+			startedAt = task.StartedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", task.ID, task.TaskDefinition, valueOrDash(task.LastStatus), valueOrDash(task.HealthStatus), startedAt)
+	}
+	fmt.Fprintf(writer, color.Bold.Sprint("\nTargets\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", "ID", "Port", "Task", "State", "Reason")
+	for _, target := range s.Targets {
+		fmt.Fprintf(writer, "  %s\t%d\t%s\t%s\t%s\n", target.ID, target.Port, valueOrDash(target.TaskID), target.State, valueOrDash(target.Reason))
+	}
+	fmt.Fprintf(writer, color.Bold.Sprint("\nAlarms\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", "Name", "Metric", "Updated At", "Reason")
+	for _, alarm := range s.Alarms {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", alarm.Name, alarm.Metric, alarm.UpdatedAt.Format(time.RFC3339), alarm.Reason)
+	}
+	writer.Flush()
+	return b.String()
+}
@@ -0,0 +1,312 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/cloudwatch"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/ecs"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/elbv2"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe/mocks"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type appStatusDescriberMocks struct {
+	stackDescriber *mocks.MockstackDescriber
+	ecsSvc         *mocks.MockserviceStatusDescriber
+	elbSvc         *mocks.MocktargetsHealthDescriber
+	cwSvc          *mocks.MockalarmsDescriber
+}
+
+func TestAppStatusDescriber_Describe(t *testing.T) {
+	const (
+		mockCluster        = "phonetool-test-Cluster"
+		mockService        = "phonetool-test-frontend-Service"
+		mockServiceARN     = "arn:aws:ecs:us-west-2:1234:service/phonetool-test-Cluster/phonetool-test-frontend-Service"
+		mockTargetGroupARN = "arn:aws:elasticloadbalancing:us-west-2:1234:targetgroup/phonet-Targe-1A2B3C/6d0ecf831eec9f09"
+	)
+	testErr := errors.New("some error")
+	startedAt := time.Date(2020, 4, 1, 10, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2020, 4, 1, 10, 5, 0, 0, time.UTC)
+	envStack := &cloudformation.DescribeStacksOutput{
+		Stacks: []*cloudformation.Stack{
+			{
+				Outputs: []*cloudformation.Output{
+					{
+						OutputKey:   aws.String(stack.EnvOutputClusterID),
+						OutputValue: aws.String(mockCluster),
+					},
+				},
+			},
+		},
+	}
+	appResources := &cloudformation.DescribeStackResourcesOutput{
+		StackResources: []*cloudformation.StackResource{
+			{
+				LogicalResourceId:  aws.String("Service"),
+				PhysicalResourceId: aws.String(mockServiceARN),
+			},
+			{
+				LogicalResourceId:  aws.String("TargetGroup"),
+				PhysicalResourceId: aws.String(mockTargetGroupARN),
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		setupMocks func(m appStatusDescriberMocks)
+
+		wantedStatus *AppStatus
+		wantedError  error
+	}{
+		"returns error if fail to describe the resources of the application stack": {
+			setupMocks: func(m appStatusDescriberMocks) {
+				m.stackDescriber.EXPECT().DescribeStacks(gomock.Any()).Return(envStack, nil)
+				m.stackDescriber.EXPECT().DescribeStackResources(gomock.Any()).Return(nil, testErr)
+			},
+
+			wantedError: fmt.Errorf("describe resources for stack phonetool-test-frontend: some error"),
+		},
+		"returns error if the application stack has no service": {
+			setupMocks: func(m appStatusDescriberMocks) {
+				m.stackDescriber.EXPECT().DescribeStacks(gomock.Any()).Return(envStack, nil)
+				m.stackDescriber.EXPECT().DescribeStackResources(gomock.Any()).Return(&cloudformation.DescribeStackResourcesOutput{}, nil)
+			},
+
+			wantedError: fmt.Errorf("service of application frontend not found in environment test"),
+		},
+		"returns error if fail to get the status of the service": {
+			setupMocks: func(m appStatusDescriberMocks) {
+				m.stackDescriber.EXPECT().DescribeStacks(gomock.Any()).Return(envStack, nil)
+				m.stackDescriber.EXPECT().DescribeStackResources(gomock.Any()).Return(appResources, nil)
+				m.ecsSvc.EXPECT().ServiceStatus(mockCluster, mockService).Return(nil, testErr)
+			},
+
+			wantedError: testErr,
+		},
+		"returns error if fail to get the health of the targets": {
+			setupMocks: func(m appStatusDescriberMocks) {
+				m.stackDescriber.EXPECT().DescribeStacks(gomock.Any()).Return(envStack, nil)
+				m.stackDescriber.EXPECT().DescribeStackResources(gomock.Any()).Return(appResources, nil)
+				m.ecsSvc.EXPECT().ServiceStatus(mockCluster, mockService).Return(&ecs.ServiceStatus{}, nil)
+				m.ecsSvc.EXPECT().ServiceTasks(mockCluster, mockService).Return(nil, nil)
+				m.elbSvc.EXPECT().TargetsHealth(mockTargetGroupARN).Return(nil, testErr)
+			},
+
+			wantedError: testErr,
+		},
+		"returns the deployments, tasks, targets and alarms of the service": {
+			setupMocks: func(m appStatusDescriberMocks) {
+				m.stackDescriber.EXPECT().DescribeStacks(&cloudformation.DescribeStacksInput{
+					StackName: aws.String("phonetool-test"),
+				}).Return(envStack, nil)
+				m.stackDescriber.EXPECT().DescribeStackResources(&cloudformation.DescribeStackResourcesInput{
+					StackName: aws.String("phonetool-test-frontend"),
+				}).Return(appResources, nil)
+				m.ecsSvc.EXPECT().ServiceStatus(mockCluster, mockService).Return(&ecs.ServiceStatus{
+					DesiredCount: 2,
+					RunningCount: 2,
+					PendingCount: 1,
+					Deployments: []*ecs.Deployment{
+						{ID: "ecs-svc/2", Status: "PRIMARY", TaskDefinition: "phonetool-test-frontend:2", DesiredCount: 2, RunningCount: 1, PendingCount: 1, UpdatedAt: updatedAt},
+						{ID: "ecs-svc/1", Status: "ACTIVE", TaskDefinition: "phonetool-test-frontend:1", DesiredCount: 1, RunningCount: 1, UpdatedAt: updatedAt},
+					},
+				}, nil)
+				m.ecsSvc.EXPECT().ServiceTasks(mockCluster, mockService).Return([]*ecs.Task{
+					{ID: "task1", TaskDefinition: "phonetool-test-frontend:1", LastStatus: "RUNNING", HealthStatus: "HEALTHY", PrivateIP: "10.0.0.1", StartedAt: &startedAt},
+					{ID: "task2", TaskDefinition: "phonetool-test-frontend:2", LastStatus: "PROVISIONING", HealthStatus: "UNKNOWN"},
+				}, nil)
+				m.elbSvc.EXPECT().TargetsHealth(mockTargetGroupARN).Return([]*elbv2.TargetHealth{
+					{ID: "10.0.0.1", Port: 80, State: "healthy"},
+					{ID: "10.0.0.9", Port: 80, State: "draining", Reason: "Target.DeregistrationInProgress"},
+				}, nil)
+				m.cwSvc.EXPECT().AlarmsWithDimensions(
+					map[string]string{
+						"ClusterName": mockCluster,
+						"ServiceName": mockService,
+					},
+					map[string]string{
+						"TargetGroup": "targetgroup/phonet-Targe-1A2B3C/6d0ecf831eec9f09",
+					},
+				).Return([]*cloudwatch.Alarm{
+					{Name: "frontend-cpu", Namespace: "AWS/ECS", MetricName: "CPUUtilization", Reason: "Threshold Crossed", UpdatedAt: updatedAt},
+					{Name: "frontend-5xx", Namespace: "AWS/ApplicationELB", MetricName: "HTTPCode_Target_5XX_Count", Reason: "Threshold Crossed", UpdatedAt: updatedAt},
+				}, nil)
+			},
+
+			wantedStatus: &AppStatus{
+				Project:      "phonetool",
+				AppName:      "frontend",
+				Environment:  "test",
+				DesiredCount: 2,
+				RunningCount: 2,
+				PendingCount: 1,
+				Deployments: []*AppDeployment{
+					{ID: "ecs-svc/2", Status: "PRIMARY", RolloutState: "IN_PROGRESS", TaskDefinition: "phonetool-test-frontend:2", DesiredCount: 2, RunningCount: 1, PendingCount: 1, UpdatedAt: updatedAt},
+					{ID: "ecs-svc/1", Status: "ACTIVE", RolloutState: "DRAINING", TaskDefinition: "phonetool-test-frontend:1", DesiredCount: 1, RunningCount: 1, UpdatedAt: updatedAt},
+				},
+				Tasks: []*AppTask{
+					{ID: "task1", TaskDefinition: "phonetool-test-frontend:1", LastStatus: "RUNNING", HealthStatus: "HEALTHY", StartedAt: &startedAt},
+					{ID: "task2", TaskDefinition: "phonetool-test-frontend:2", LastStatus: "PROVISIONING", HealthStatus: "UNKNOWN"},
+				},
+				Targets: []*AppTarget{
+					{ID: "10.0.0.1", Port: 80, TaskID: "task1", State: "healthy"},
+					{ID: "10.0.0.9", Port: 80, State: "draining", Reason: "Target.DeregistrationInProgress"},
+				},
+				Alarms: []*AppAlarm{
+					{Name: "frontend-cpu", Metric: "AWS/ECS/CPUUtilization", Reason: "Threshold Crossed", UpdatedAt: updatedAt},
+					{Name: "frontend-5xx", Metric: "AWS/ApplicationELB/HTTPCode_Target_5XX_Count", Reason: "Threshold Crossed", UpdatedAt: updatedAt},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := appStatusDescriberMocks{
+				stackDescriber: mocks.NewMockstackDescriber(ctrl),
+				ecsSvc:         mocks.NewMockserviceStatusDescriber(ctrl),
+				elbSvc:         mocks.NewMocktargetsHealthDescriber(ctrl),
+				cwSvc:          mocks.NewMockalarmsDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &AppStatusDescriber{
+				env: &archer.Environment{
+					Project: "phonetool",
+					Name:    "test",
+				},
+				appName:        "frontend",
+				stackDescriber: m.stackDescriber,
+				ecsSvc:         m.ecsSvc,
+				elbSvc:         m.elbSvc,
+				cwSvc:          m.cwSvc,
+			}
+
+			// WHEN
+			status, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStatus, status)
+		})
+	}
+}
+
+func TestAppDeployments(t *testing.T) {
+	testCases := map[string]struct {
+		deployments []*ecs.Deployment
+
+		wantedStates []string
+	}{
+		"primary deployment with all its tasks running is completed": {
+			deployments: []*ecs.Deployment{
+				{Status: "PRIMARY", DesiredCount: 2, RunningCount: 2},
+			},
+			wantedStates: []string{"COMPLETED"},
+		},
+		"primary deployment with pending tasks is in progress": {
+			deployments: []*ecs.Deployment{
+				{Status: "PRIMARY", DesiredCount: 2, RunningCount: 1, PendingCount: 1},
+			},
+			wantedStates: []string{"IN_PROGRESS"},
+		},
+		"primary deployment is in progress until the other deployments are drained": {
+			deployments: []*ecs.Deployment{
+				{Status: "PRIMARY", DesiredCount: 2, RunningCount: 2},
+				{Status: "ACTIVE", DesiredCount: 0, RunningCount: 1},
+			},
+			wantedStates: []string{"IN_PROGRESS", "DRAINING"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var states []string
+			for _, d := range appDeployments(tc.deployments) {
+				states = append(states, d.RolloutState)
+			}
+			require.Equal(t, tc.wantedStates, states)
+		})
+	}
+}
+
+func TestAppStatus_Output(t *testing.T) {
+	startedAt := time.Date(2020, 4, 1, 10, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2020, 4, 1, 10, 5, 0, 0, time.UTC)
+	status := &AppStatus{
+		Project:      "phonetool",
+		AppName:      "frontend",
+		Environment:  "test",
+		DesiredCount: 1,
+		RunningCount: 1,
+		Deployments: []*AppDeployment{
+			{ID: "ecs-svc/1", Status: "PRIMARY", RolloutState: "COMPLETED", TaskDefinition: "phonetool-test-frontend:1", DesiredCount: 1, RunningCount: 1, CreatedAt: startedAt, UpdatedAt: updatedAt},
+		},
+		Tasks: []*AppTask{
+			{ID: "task1", TaskDefinition: "phonetool-test-frontend:1", LastStatus: "RUNNING", HealthStatus: "HEALTHY", StartedAt: &startedAt},
+			{ID: "task2", TaskDefinition: "phonetool-test-frontend:1", LastStatus: "PROVISIONING"},
+		},
+		Targets: []*AppTarget{
+			{ID: "10.0.0.1", Port: 80, TaskID: "task1", State: "healthy"},
+		},
+		Alarms: []*AppAlarm{
+			{Name: "frontend-cpu", Metric: "AWS/ECS/CPUUtilization", Reason: "Threshold Crossed", UpdatedAt: updatedAt},
+		},
+	}
+
+	t.Run("human", func(t *testing.T) {
+		wanted := `About
+
+  Project           phonetool
+  Name              frontend
+  Environment       test
+  Tasks             1 desired, 1 running, 0 pending
+
+Deployments
+
+  ID                Status              Rollout             Task Definition            Tasks               Updated At
+  ecs-svc/1         PRIMARY             COMPLETED           phonetool-test-frontend:1  1/1                 2020-04-01T10:05:00Z
+
+Tasks
+
+  ID                Task Definition            Last Status         Health              Started At
+  task1             phonetool-test-frontend:1  RUNNING             HEALTHY             2020-04-01T10:00:00Z
+  task2             phonetool-test-frontend:1  PROVISIONING        -                   -
+
+Targets
+
+  ID                Port                Task                State               Reason
+  10.0.0.1          80                  task1               healthy             -
+
+Alarms
+
+  Name              Metric                  Updated At            Reason
+  frontend-cpu      AWS/ECS/CPUUtilization  2020-04-01T10:05:00Z  Threshold Crossed
+`
+		require.Equal(t, wanted, status.HumanString())
+	})
+	t.Run("json", func(t *testing.T) {
+		wanted := `{"project":"phonetool","appName":"frontend","environment":"test","desiredCount":1,"runningCount":1,"pendingCount":0,"deployments":[{"id":"ecs-svc/1","status":"PRIMARY","rolloutState":"COMPLETED","taskDefinition":"phonetool-test-frontend:1","desiredCount":1,"runningCount":1,"pendingCount":0,"createdAt":"2020-04-01T10:00:00Z","updatedAt":"2020-04-01T10:05:00Z"}],"tasks":[{"id":"task1","taskDefinition":"phonetool-test-frontend:1","lastStatus":"RUNNING","healthStatus":"HEALTHY","startedAt":"2020-04-01T10:00:00Z"},{"id":"task2","taskDefinition":"phonetool-test-frontend:1","lastStatus":"PROVISIONING","healthStatus":""}],"targets":[{"id":"10.0.0.1","port":80,"taskID":"task1","state":"healthy"}],"alarms":[{"name":"frontend-cpu","metric":"AWS/ECS/CPUUtilization","reason":"Threshold Crossed","updatedAt":"2020-04-01T10:05:00Z"}]}
+`
+		got, err := status.JSONString()
+		require.NoError(t, err)
+		require.Equal(t, wanted, got)
+	})
+}
@@ -0,0 +1,257 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/session"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/store"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+)
+
+// EnvResources contains the identifiers of the resources created by an environment stack.
+type EnvResources struct {
+	VPCID                 string   `json:"vpcID"`
+	PublicSubnets         []string `json:"publicSubnets"`
+	PrivateSubnets        []string `json:"privateSubnets"`
+	VPCEndpoints          []string `json:"vpcEndpoints,omitempty"`
+	ClusterID             string   `json:"clusterID"`
+	PublicLoadBalancerARN string   `json:"publicLoadBalancerARN,omitempty"`
+	PublicLoadBalancerDNS string   `json:"publicLoadBalancerDNS,omitempty"`
+}
+
+// EnvApp contains serialized parameters of an application deployed in an environment.
+type EnvApp struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	Path string `json:"path"`
+}
+
+// Env contains serialized parameters for an environment.
+type Env struct {
+	Name      string        `json:"name"`
+	Project   string        `json:"project"`
+	Region    string        `json:"region"`
+	AccountID string        `json:"accountID"`
+	Prod      bool          `json:"prod"`
+	Resources *EnvResources `json:"resources"`
+	Apps      []*EnvApp     `json:"applications"`
+}
+
+type resourceGetter interface {
+	GetResources(input *resourcegroupstaggingapi.GetResourcesInput) (*resourcegroupstaggingapi.GetResourcesOutput, error)
+}
+
+// EnvDescriber retrieves information about an environment.
+type EnvDescriber struct {
+	env *archer.Environment
+
+	stackDescriber stackDescriber
+	rgClient       resourceGetter
+}
+
+// NewEnvDescriber instantiates an environment describer.
+func NewEnvDescriber(project, env string) (*EnvDescriber, error) {
+	svc, err := store.New()
+	if err != nil {
+		return nil, fmt.Errorf("connect to store: %w", err)
+	}
+	meta, err := svc.GetEnvironment(project, env)
+	if err != nil {
+		return nil, err
+	}
+	sess, err := session.NewProvider().FromRole(meta.ManagerRoleARN, meta.Region)
+	if err != nil {
+		return nil, fmt.Errorf("session for role %s and region %s: %w", meta.ManagerRoleARN, meta.Region, err)
+	}
+	return &EnvDescriber{
+		env:            meta,
+		stackDescriber: cloudformation.New(sess),
+		rgClient:       resourcegroupstaggingapi.New(sess),
+	}, nil
+}
+
+// Describe returns the configuration, resources and deployed applications of the environment.
+func (d *EnvDescriber) Describe() (*Env, error) {
+	envStack, err := d.stack(stack.NameForEnv(d.env.Project, d.env.Name))
+	if err != nil {
+		return nil, err
+	}
+	outputs := stackOutputs(envStack)
+
+	apps, err := d.apps(outputs)
+	if err != nil {
+		return nil, err
+	}
+	return &Env{
+		Name:      d.env.Name,
+		Project:   d.env.Project,
+		Region:    d.env.Region,
+		AccountID: d.env.AccountID,
+		Prod:      d.env.Prod,
+		Resources: &EnvResources{
+			VPCID:                 outputs[stack.EnvOutputVPCID],
+			PublicSubnets:         splitOutput(outputs[stack.EnvOutputPublicSubnets]),
+			PrivateSubnets:        splitOutput(outputs[stack.EnvOutputPrivateSubnets]),
+			VPCEndpoints:          splitOutput(outputs[stack.EnvOutputVPCEndpoints]),
+			ClusterID:             outputs[stack.EnvOutputClusterID],
+			PublicLoadBalancerARN: outputs[stack.EnvOutputPublicLoadBalancerARN],
+			PublicLoadBalancerDNS: outputs[stack.EnvOutputPublicLoadBalancerDNSName],
+		},
+		Apps: apps,
+	}, nil
+}
+
+// HasHTTPSListener returns true if the load balancer of the environment has an HTTPS listener,
+// which requires a project domain or an imported certificate.
+func (d *EnvDescriber) HasHTTPSListener() (bool, error) {
+	envStack, err := d.stack(stack.NameForEnv(d.env.Project, d.env.Name))
+	if err != nil {
+		return false, err
+	}
+	_, ok := stackOutputs(envStack)[stack.EnvOutputHTTPSListenerARN]
+	return ok, nil
+}
+
+// apps returns the applications deployed in the environment sorted by name.
+func (d *EnvDescriber) apps(envOutputs map[string]string) ([]*EnvApp, error) {
+	names, err := d.appNames()
+	if err != nil {
+		return nil, err
+	}
+	var apps []*EnvApp
+	for _, name := range names {
+		appStack, err := d.stack(stack.NameForApp(d.env.Project, d.env.Name, name))
+		if err != nil {
+			return nil, err
+		}
+		uri := newWebAppURI(name, envOutputs, stackParameters(appStack), stackOutputs(appStack))
+		apps = append(apps, &EnvApp{
+			Name: name,
+			URL:  uri.DNSName,
+			Path: uri.Path,
+		})
+	}
+	return apps, nil
+}
+
+// appNames returns the names of the applications whose stacks are tagged with the environment.
+func (d *EnvDescriber) appNames() ([]string, error) {
+	var names []string
+	var token *string
+	for {
+		out, err := d.rgClient.GetResources(&resourcegroupstaggingapi.GetResourcesInput{
+			PaginationToken:     token,
+			ResourceTypeFilters: []*string{aws.String("cloudformation")},
+			TagFilters: []*resourcegroupstaggingapi.TagFilter{
+				{
+					Key:    aws.String(stack.AppTagKey),
+					Values: []*string{}, // Matches any application stack.
+				},
+				{
+					Key:    aws.String(stack.EnvTagKey),
+					Values: []*string{aws.String(d.env.Name)},
+				},
+				{
+					Key:    aws.String(stack.ProjectTagKey),
+					Values: []*string{aws.String(d.env.Project)},
+				},
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("find application stacks in environment %s: %w", d.env.Name, err)
+		}
+		for _, mapping := range out.ResourceTagMappingList {
+			for _, t := range mapping.Tags {
+				if aws.StringValue(t.Key) != stack.AppTagKey {
+					continue
+				}
+				names = append(names, aws.StringValue(t.Value))
+			}
+		}
+		token = out.PaginationToken
+		if aws.StringValue(token) == "" {
+			break
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (d *EnvDescriber) stack(stackName string) (*cloudformation.Stack, error) {
+	out, err := d.stackDescriber.DescribeStacks(&cloudformation.DescribeStacksInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe stack %s: %w", stackName, err)
+	}
+	if len(out.Stacks) == 0 {
+		return nil, fmt.Errorf("stack %s not found", stackName)
+	}
+	return out.Stacks[0], nil
+}
+
+// splitOutput returns the values of a comma separated stack output.
+func splitOutput(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// JSONString returns the stringified Env struct with json format.
+func (e *Env) JSONString() (string, error) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return "", fmt.Errorf("marshal environment: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified Env struct with human readable format.
+func (e *Env) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, color.Bold.Sprint("About\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", "Name", e.Name)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Project", e.Project)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Region", e.Region)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Account ID", e.AccountID)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Production", strconv.FormatBool(e.Prod))
+	if e.Resources != nil {
+		fmt.Fprintf(writer, color.Bold.Sprint("\nResources\n\n"))
+		writer.Flush()
+		fmt.Fprintf(writer, "  %s\t%s\n", "VPC", e.Resources.VPCID)
+		fmt.Fprintf(writer, "  %s\t%s\n", "Public Subnets", strings.Join(e.Resources.PublicSubnets, ", "))
+		fmt.Fprintf(writer, "  %s\t%s\n", "Private Subnets", strings.Join(e.Resources.PrivateSubnets, ", "))
+		if len(e.Resources.VPCEndpoints) != 0 {
+			fmt.Fprintf(writer, "  %s\t%s\n", "VPC Endpoints", strings.Join(e.Resources.VPCEndpoints, ", "))
+		}
+		fmt.Fprintf(writer, "  %s\t%s\n", "Cluster", e.Resources.ClusterID)
+		if e.Resources.PublicLoadBalancerARN != "" {
+			fmt.Fprintf(writer, "  %s\t%s\n", "Load Balancer", e.Resources.PublicLoadBalancerARN)
+		}
+	}
+	fmt.Fprintf(writer, color.Bold.Sprint("\nApplications\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\t%s\n", "Name", "URL", "Path")
+	for _, app := range e.Apps {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", app.Name, app.URL, app.Path)
+	}
+	writer.Flush()
+	return b.String()
+}
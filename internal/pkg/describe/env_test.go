@@ -0,0 +1,297 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe/mocks"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvDescriber_Describe(t *testing.T) {
+	const (
+		testProject = "phonetool"
+		testEnv     = "test"
+	)
+	testEnvStack := &cloudformation.Stack{
+		Outputs: []*cloudformation.Output{
+			{
+				OutputKey:   aws.String(stack.EnvOutputVPCID),
+				OutputValue: aws.String("vpc-1234"),
+			},
+			{
+				OutputKey:   aws.String(stack.EnvOutputPublicSubnets),
+				OutputValue: aws.String("subnet-1,subnet-2"),
+			},
+			{
+				OutputKey:   aws.String(stack.EnvOutputPrivateSubnets),
+				OutputValue: aws.String("subnet-3,subnet-4"),
+			},
+			{
+				OutputKey:   aws.String(stack.EnvOutputVPCEndpoints),
+				OutputValue: aws.String("com.amazonaws.us-west-2.ecr.api,com.amazonaws.us-west-2.s3"),
+			},
+			{
+				OutputKey:   aws.String(stack.EnvOutputClusterID),
+				OutputValue: aws.String("phonetool-test-Cluster"),
+			},
+			{
+				OutputKey:   aws.String(stack.EnvOutputPublicLoadBalancerDNSName),
+				OutputValue: aws.String("abc.us-west-2.elb.amazonaws.com"),
+			},
+		},
+	}
+	testResources := &EnvResources{
+		VPCID:                 "vpc-1234",
+		PublicSubnets:         []string{"subnet-1", "subnet-2"},
+		PrivateSubnets:        []string{"subnet-3", "subnet-4"},
+		VPCEndpoints:          []string{"com.amazonaws.us-west-2.ecr.api", "com.amazonaws.us-west-2.s3"},
+		ClusterID:             "phonetool-test-Cluster",
+		PublicLoadBalancerDNS: "abc.us-west-2.elb.amazonaws.com",
+	}
+	appTags := func(app string) *resourcegroupstaggingapi.ResourceTagMapping {
+		return &resourcegroupstaggingapi.ResourceTagMapping{
+			Tags: []*resourcegroupstaggingapi.Tag{
+				{
+					Key:   aws.String(stack.ProjectTagKey),
+					Value: aws.String(testProject),
+				},
+				{
+					Key:   aws.String(stack.AppTagKey),
+					Value: aws.String(app),
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		mockStackDescriber func(m *mocks.MockstackDescriber)
+		mockRGClient       func(m *mocks.MockresourceGetter)
+
+		wantedEnv   *Env
+		wantedError error
+	}{
+		"environment stack does not exist": {
+			mockStackDescriber: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().DescribeStacks(&cloudformation.DescribeStacksInput{
+					StackName: aws.String("phonetool-test"),
+				}).Return(nil, errors.New("some error"))
+			},
+			mockRGClient: func(m *mocks.MockresourceGetter) {},
+
+			wantedError: fmt.Errorf("describe stack phonetool-test: some error"),
+		},
+		"fail to find application stacks": {
+			mockStackDescriber: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{testEnvStack},
+				}, nil)
+			},
+			mockRGClient: func(m *mocks.MockresourceGetter) {
+				m.EXPECT().GetResources(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("find application stacks in environment test: some error"),
+		},
+		"describes environment with its applications": {
+			mockStackDescriber: func(m *mocks.MockstackDescriber) {
+				gomock.InOrder(
+					m.EXPECT().DescribeStacks(&cloudformation.DescribeStacksInput{
+						StackName: aws.String("phonetool-test"),
+					}).Return(&cloudformation.DescribeStacksOutput{
+						Stacks: []*cloudformation.Stack{testEnvStack},
+					}, nil),
+					m.EXPECT().DescribeStacks(&cloudformation.DescribeStacksInput{
+						StackName: aws.String("phonetool-test-api"),
+					}).Return(&cloudformation.DescribeStacksOutput{
+						Stacks: []*cloudformation.Stack{
+							{
+								Parameters: []*cloudformation.Parameter{
+									{
+										ParameterKey:   aws.String(stack.LBFargateRulePathKey),
+										ParameterValue: aws.String("api"),
+									},
+								},
+							},
+						},
+					}, nil),
+					m.EXPECT().DescribeStacks(&cloudformation.DescribeStacksInput{
+						StackName: aws.String("phonetool-test-frontend"),
+					}).Return(&cloudformation.DescribeStacksOutput{
+						Stacks: []*cloudformation.Stack{
+							{
+								Parameters: []*cloudformation.Parameter{
+									{
+										ParameterKey:   aws.String(stack.LBFargateRulePathKey),
+										ParameterValue: aws.String("*"),
+									},
+								},
+							},
+						},
+					}, nil),
+				)
+			},
+			mockRGClient: func(m *mocks.MockresourceGetter) {
+				gomock.InOrder(
+					m.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+						ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{appTags("frontend")},
+						PaginationToken:        aws.String("next"),
+					}, nil),
+					m.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+						ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{appTags("api")},
+					}, nil),
+				)
+			},
+
+			wantedEnv: &Env{
+				Name:      testEnv,
+				Project:   testProject,
+				Region:    "us-west-2",
+				AccountID: "1111",
+				Resources: testResources,
+				Apps: []*EnvApp{
+					{
+						Name: "api",
+						URL:  "abc.us-west-2.elb.amazonaws.com",
+						Path: "api",
+					},
+					{
+						Name: "frontend",
+						URL:  "abc.us-west-2.elb.amazonaws.com",
+						Path: "*",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStackDescriber := mocks.NewMockstackDescriber(ctrl)
+			mockRGClient := mocks.NewMockresourceGetter(ctrl)
+			tc.mockStackDescriber(mockStackDescriber)
+			tc.mockRGClient(mockRGClient)
+
+			d := &EnvDescriber{
+				env: &archer.Environment{
+					Project:   testProject,
+					Name:      testEnv,
+					Region:    "us-west-2",
+					AccountID: "1111",
+				},
+				stackDescriber: mockStackDescriber,
+				rgClient:       mockRGClient,
+			}
+
+			// WHEN
+			actual, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedEnv, actual)
+			}
+		})
+	}
+}
+
+func TestEnvDescriber_HasHTTPSListener(t *testing.T) {
+	testCases := map[string]struct {
+		mockStackDescriber func(m *mocks.MockstackDescriber)
+
+		wantedHTTPS bool
+		wantedError error
+	}{
+		"environment stack does not exist": {
+			mockStackDescriber: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().DescribeStacks(&cloudformation.DescribeStacksInput{
+					StackName: aws.String("phonetool-test"),
+				}).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("describe stack phonetool-test: some error"),
+		},
+		"environment without HTTPS listener": {
+			mockStackDescriber: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							Outputs: []*cloudformation.Output{
+								{
+									OutputKey:   aws.String(stack.EnvOutputPublicLoadBalancerDNSName),
+									OutputValue: aws.String("abc.us-west-2.elb.amazonaws.com"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+
+			wantedHTTPS: false,
+		},
+		"environment with HTTPS listener": {
+			mockStackDescriber: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							Outputs: []*cloudformation.Output{
+								{
+									OutputKey:   aws.String(stack.EnvOutputHTTPSListenerARN),
+									OutputValue: aws.String("arn:aws:elasticloadbalancing:us-west-2:1111:listener/app/abc/123/456"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+
+			wantedHTTPS: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStackDescriber := mocks.NewMockstackDescriber(ctrl)
+			tc.mockStackDescriber(mockStackDescriber)
+
+			d := &EnvDescriber{
+				env: &archer.Environment{
+					Project: "phonetool",
+					Name:    "test",
+				},
+				stackDescriber: mockStackDescriber,
+			}
+
+			// WHEN
+			actual, err := d.HasHTTPSListener()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedHTTPS, actual)
+			}
+		})
+	}
+}
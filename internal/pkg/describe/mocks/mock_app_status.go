@@ -0,0 +1,146 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/app_status.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	cloudwatch "github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/cloudwatch"
+	ecs "github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/ecs"
+	elbv2 "github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/elbv2"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockserviceStatusDescriber is a mock of serviceStatusDescriber interface
+type MockserviceStatusDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceStatusDescriberMockRecorder
+}
+
+// MockserviceStatusDescriberMockRecorder is the mock recorder for MockserviceStatusDescriber
+type MockserviceStatusDescriberMockRecorder struct {
+	mock *MockserviceStatusDescriber
+}
+
+// NewMockserviceStatusDescriber creates a new mock instance
+func NewMockserviceStatusDescriber(ctrl *gomock.Controller) *MockserviceStatusDescriber {
+	mock := &MockserviceStatusDescriber{ctrl: ctrl}
+	mock.recorder = &MockserviceStatusDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockserviceStatusDescriber) EXPECT() *MockserviceStatusDescriberMockRecorder {
+	return m.recorder
+}
+
+// ServiceStatus mocks base method
+func (m *MockserviceStatusDescriber) ServiceStatus(cluster, service string) (*ecs.ServiceStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceStatus", cluster, service)
+	ret0, _ := ret[0].(*ecs.ServiceStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceStatus indicates an expected call of ServiceStatus
+func (mr *MockserviceStatusDescriberMockRecorder) ServiceStatus(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceStatus", reflect.TypeOf((*MockserviceStatusDescriber)(nil).ServiceStatus), cluster, service)
+}
+
+// ServiceTasks mocks base method
+func (m *MockserviceStatusDescriber) ServiceTasks(cluster, service string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceTasks", cluster, service)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceTasks indicates an expected call of ServiceTasks
+func (mr *MockserviceStatusDescriberMockRecorder) ServiceTasks(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceTasks", reflect.TypeOf((*MockserviceStatusDescriber)(nil).ServiceTasks), cluster, service)
+}
+
+// MocktargetsHealthDescriber is a mock of targetsHealthDescriber interface
+type MocktargetsHealthDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MocktargetsHealthDescriberMockRecorder
+}
+
+// MocktargetsHealthDescriberMockRecorder is the mock recorder for MocktargetsHealthDescriber
+type MocktargetsHealthDescriberMockRecorder struct {
+	mock *MocktargetsHealthDescriber
+}
+
+// NewMocktargetsHealthDescriber creates a new mock instance
+func NewMocktargetsHealthDescriber(ctrl *gomock.Controller) *MocktargetsHealthDescriber {
+	mock := &MocktargetsHealthDescriber{ctrl: ctrl}
+	mock.recorder = &MocktargetsHealthDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocktargetsHealthDescriber) EXPECT() *MocktargetsHealthDescriberMockRecorder {
+	return m.recorder
+}
+
+// TargetsHealth mocks base method
+func (m *MocktargetsHealthDescriber) TargetsHealth(targetGroupARN string) ([]*elbv2.TargetHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TargetsHealth", targetGroupARN)
+	ret0, _ := ret[0].([]*elbv2.TargetHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TargetsHealth indicates an expected call of TargetsHealth
+func (mr *MocktargetsHealthDescriberMockRecorder) TargetsHealth(targetGroupARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TargetsHealth", reflect.TypeOf((*MocktargetsHealthDescriber)(nil).TargetsHealth), targetGroupARN)
+}
+
+// MockalarmsDescriber is a mock of alarmsDescriber interface
+type MockalarmsDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockalarmsDescriberMockRecorder
+}
+
+// MockalarmsDescriberMockRecorder is the mock recorder for MockalarmsDescriber
+type MockalarmsDescriberMockRecorder struct {
+	mock *MockalarmsDescriber
+}
+
+// NewMockalarmsDescriber creates a new mock instance
+func NewMockalarmsDescriber(ctrl *gomock.Controller) *MockalarmsDescriber {
+	mock := &MockalarmsDescriber{ctrl: ctrl}
+	mock.recorder = &MockalarmsDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockalarmsDescriber) EXPECT() *MockalarmsDescriberMockRecorder {
+	return m.recorder
+}
+
+// AlarmsWithDimensions mocks base method
+func (m *MockalarmsDescriber) AlarmsWithDimensions(dimensionSets ...map[string]string) ([]*cloudwatch.Alarm, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range dimensionSets {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AlarmsWithDimensions", varargs...)
+	ret0, _ := ret[0].([]*cloudwatch.Alarm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AlarmsWithDimensions indicates an expected call of AlarmsWithDimensions
+func (mr *MockalarmsDescriberMockRecorder) AlarmsWithDimensions(dimensionSets ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlarmsWithDimensions", reflect.TypeOf((*MockalarmsDescriber)(nil).AlarmsWithDimensions), dimensionSets...)
+}
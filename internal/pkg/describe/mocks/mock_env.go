@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/env.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	resourcegroupstaggingapi "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockresourceGetter is a mock of resourceGetter interface
+type MockresourceGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockresourceGetterMockRecorder
+}
+
+// MockresourceGetterMockRecorder is the mock recorder for MockresourceGetter
+type MockresourceGetterMockRecorder struct {
+	mock *MockresourceGetter
+}
+
+// NewMockresourceGetter creates a new mock instance
+func NewMockresourceGetter(ctrl *gomock.Controller) *MockresourceGetter {
+	mock := &MockresourceGetter{ctrl: ctrl}
+	mock.recorder = &MockresourceGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockresourceGetter) EXPECT() *MockresourceGetterMockRecorder {
+	return m.recorder
+}
+
+// GetResources mocks base method
+func (m *MockresourceGetter) GetResources(input *resourcegroupstaggingapi.GetResourcesInput) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResources", input)
+	ret0, _ := ret[0].(*resourcegroupstaggingapi.GetResourcesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResources indicates an expected call of GetResources
+func (mr *MockresourceGetterMockRecorder) GetResources(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResources", reflect.TypeOf((*MockresourceGetter)(nil).GetResources), input)
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/pipeline.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	codepipeline "github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/codepipeline"
+	codestarnotifications "github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/codestarnotifications"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockpipelineGetter is a mock of pipelineGetter interface
+type MockpipelineGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineGetterMockRecorder
+}
+
+// MockpipelineGetterMockRecorder is the mock recorder for MockpipelineGetter
+type MockpipelineGetterMockRecorder struct {
+	mock *MockpipelineGetter
+}
+
+// NewMockpipelineGetter creates a new mock instance
+func NewMockpipelineGetter(ctrl *gomock.Controller) *MockpipelineGetter {
+	mock := &MockpipelineGetter{ctrl: ctrl}
+	mock.recorder = &MockpipelineGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockpipelineGetter) EXPECT() *MockpipelineGetterMockRecorder {
+	return m.recorder
+}
+
+// GetPipeline mocks base method
+func (m *MockpipelineGetter) GetPipeline(name string) (*codepipeline.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipeline", name)
+	ret0, _ := ret[0].(*codepipeline.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipeline indicates an expected call of GetPipeline
+func (mr *MockpipelineGetterMockRecorder) GetPipeline(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipeline), name)
+}
+
+// MocknotificationRulesGetter is a mock of notificationRulesGetter interface
+type MocknotificationRulesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocknotificationRulesGetterMockRecorder
+}
+
+// MocknotificationRulesGetterMockRecorder is the mock recorder for MocknotificationRulesGetter
+type MocknotificationRulesGetterMockRecorder struct {
+	mock *MocknotificationRulesGetter
+}
+
+// NewMocknotificationRulesGetter creates a new mock instance
+func NewMocknotificationRulesGetter(ctrl *gomock.Controller) *MocknotificationRulesGetter {
+	mock := &MocknotificationRulesGetter{ctrl: ctrl}
+	mock.recorder = &MocknotificationRulesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocknotificationRulesGetter) EXPECT() *MocknotificationRulesGetterMockRecorder {
+	return m.recorder
+}
+
+// NotificationRules mocks base method
+func (m *MocknotificationRulesGetter) NotificationRules(resourceARN string) ([]*codestarnotifications.NotificationRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationRules", resourceARN)
+	ret0, _ := ret[0].([]*codestarnotifications.NotificationRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotificationRules indicates an expected call of NotificationRules
+func (mr *MocknotificationRulesGetterMockRecorder) NotificationRules(resourceARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationRules", reflect.TypeOf((*MocknotificationRulesGetter)(nil).NotificationRules), resourceARN)
+}
@@ -0,0 +1,48 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/tasks.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockrunningTasksLister is a mock of runningTasksLister interface
+type MockrunningTasksLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockrunningTasksListerMockRecorder
+}
+
+// MockrunningTasksListerMockRecorder is the mock recorder for MockrunningTasksLister
+type MockrunningTasksListerMockRecorder struct {
+	mock *MockrunningTasksLister
+}
+
+// NewMockrunningTasksLister creates a new mock instance
+func NewMockrunningTasksLister(ctrl *gomock.Controller) *MockrunningTasksLister {
+	mock := &MockrunningTasksLister{ctrl: ctrl}
+	mock.recorder = &MockrunningTasksListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockrunningTasksLister) EXPECT() *MockrunningTasksListerMockRecorder {
+	return m.recorder
+}
+
+// RunningTaskIDs mocks base method
+func (m *MockrunningTasksLister) RunningTaskIDs(cluster, family string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunningTaskIDs", cluster, family)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunningTaskIDs indicates an expected call of RunningTaskIDs
+func (mr *MockrunningTasksListerMockRecorder) RunningTaskIDs(cluster, family interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTaskIDs", reflect.TypeOf((*MockrunningTasksLister)(nil).RunningTaskIDs), cluster, family)
+}
@@ -0,0 +1,223 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/codepipeline"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/codestarnotifications"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/session"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+const (
+	fmtPipelineConsoleURL = "https://console.aws.amazon.com/codesuite/codepipeline/pipelines/%s/view?region=%s"
+
+	// deployStagePrefix is the prefix of the stages that deploy to an environment.
+	deployStagePrefix = "DeployTo-"
+	sourceActionType  = "Source"
+)
+
+type pipelineGetter interface {
+	GetPipeline(name string) (*codepipeline.Pipeline, error)
+}
+
+type notificationRulesGetter interface {
+	NotificationRules(resourceARN string) ([]*codestarnotifications.NotificationRule, error)
+}
+
+// PipelineSource contains serialized parameters of the source of a pipeline.
+type PipelineSource struct {
+	Provider   string `json:"provider"`
+	Repository string `json:"repository"`
+	Branch     string `json:"branch"`
+}
+
+// PipelineStageSummary contains serialized parameters of a stage of a pipeline.
+type PipelineStageSummary struct {
+	Name    string   `json:"name"`
+	Actions []string `json:"actions"`
+}
+
+// PipelineArtifactBucket contains serialized parameters of the bucket storing the artifacts of a pipeline in a region.
+type PipelineArtifactBucket struct {
+	Region string `json:"region"`
+	Name   string `json:"name"`
+}
+
+// PipelineNotificationTarget contains serialized parameters of a target notified of the events of a pipeline.
+type PipelineNotificationTarget struct {
+	Type    string   `json:"type"`
+	Address string   `json:"address"`
+	Events  []string `json:"events"`
+}
+
+// Pipeline contains serialized parameters of a pipeline.
+type Pipeline struct {
+	Name            string                        `json:"name"`
+	Region          string                        `json:"region"`
+	ConsoleURL      string                        `json:"consoleURL"`
+	Source          *PipelineSource               `json:"source,omitempty"`
+	Stages          []*PipelineStageSummary       `json:"stages"`
+	Environments    []string                      `json:"environments"`
+	ArtifactBuckets []*PipelineArtifactBucket     `json:"artifactBuckets"`
+	Notifications   []*PipelineNotificationTarget `json:"notifications,omitempty"`
+}
+
+// PipelineDescriber retrieves the configuration of a pipeline.
+type PipelineDescriber struct {
+	pipelineName string
+	region       string
+
+	pipelineSvc      pipelineGetter
+	notificationsSvc notificationRulesGetter
+}
+
+// NewPipelineDescriber instantiates a describer for the pipeline deployed with the name.
+func NewPipelineDescriber(pipelineName string) (*PipelineDescriber, error) {
+	sess, err := session.NewProvider().Default()
+	if err != nil {
+		return nil, err
+	}
+	return &PipelineDescriber{
+		pipelineName:     pipelineName,
+		region:           aws.StringValue(sess.Config.Region),
+		pipelineSvc:      codepipeline.New(sess),
+		notificationsSvc: codestarnotifications.New(sess),
+	}, nil
+}
+
+// Describe returns the source, stages, environments, artifact buckets and notification targets of the pipeline.
+func (d *PipelineDescriber) Describe() (*Pipeline, error) {
+	p, err := d.pipelineSvc.GetPipeline(d.pipelineName)
+	if err != nil {
+		return nil, err
+	}
+	pipeline := &Pipeline{
+		Name:       p.Name,
+		Region:     d.region,
+		ConsoleURL: fmt.Sprintf(fmtPipelineConsoleURL, p.Name, d.region),
+	}
+	for _, stage := range p.Stages {
+		summary := &PipelineStageSummary{
+			Name: stage.Name,
+		}
+		for _, action := range stage.Actions {
+			summary.Actions = append(summary.Actions, action.Name)
+			if action.Category == sourceActionType {
+				pipeline.Source = newPipelineSource(action)
+			}
+		}
+		pipeline.Stages = append(pipeline.Stages, summary)
+		if strings.HasPrefix(stage.Name, deployStagePrefix) {
+			pipeline.Environments = append(pipeline.Environments, strings.TrimPrefix(stage.Name, deployStagePrefix))
+		}
+	}
+	for _, bucket := range p.ArtifactBuckets {
+		region := bucket.Region
+		if region == "" {
+			region = d.region
+		}
+		pipeline.ArtifactBuckets = append(pipeline.ArtifactBuckets, &PipelineArtifactBucket{
+			Region: region,
+			Name:   bucket.Name,
+		})
+	}
+	if p.ARN == "" {
+		return pipeline, nil
+	}
+	rules, err := d.notificationsSvc.NotificationRules(p.ARN)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		for _, target := range rule.Targets {
+			pipeline.Notifications = append(pipeline.Notifications, &PipelineNotificationTarget{
+				Type:    target.Type,
+				Address: target.Address,
+				Events:  rule.Events,
+			})
+		}
+	}
+	return pipeline, nil
+}
+
+// newPipelineSource returns the repository and branch of a source action,
+// the configuration keys are different for each provider.
+func newPipelineSource(action *codepipeline.Action) *PipelineSource {
+	source := &PipelineSource{
+		Provider: action.Provider,
+		Branch:   action.Configuration["BranchName"],
+	}
+	switch {
+	case action.Configuration["FullRepositoryId"] != "":
+		source.Repository = action.Configuration["FullRepositoryId"]
+	case action.Configuration["RepositoryName"] != "":
+		source.Repository = action.Configuration["RepositoryName"]
+	default:
+		source.Repository = fmt.Sprintf("%s/%s", action.Configuration["Owner"], action.Configuration["Repo"])
+		source.Branch = action.Configuration["Branch"]
+	}
+	return source
+}
+
+// JSONString returns the stringified Pipeline struct with json format.
+func (p *Pipeline) JSONString() (string, error) {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return "", fmt.Errorf("marshal pipeline: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified Pipeline struct with human readable format.
+func (p *Pipeline) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, color.Bold.Sprint("About\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", "Name", p.Name)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Region", p.Region)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Console URL", p.ConsoleURL)
+	if p.Source != nil {
+		fmt.Fprintf(writer, color.Bold.Sprint("\nSource\n\n"))
+		writer.Flush()
+		fmt.Fprintf(writer, "  %s\t%s\n", "Provider", p.Source.Provider)
+		fmt.Fprintf(writer, "  %s\t%s\n", "Repository", p.Source.Repository)
+		fmt.Fprintf(writer, "  %s\t%s\n", "Branch", p.Source.Branch)
+	}
+	fmt.Fprintf(writer, color.Bold.Sprint("\nStages\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", "Name", "Actions")
+	for _, stage := range p.Stages {
+		fmt.Fprintf(writer, "  %s\t%s\n", stage.Name, valueOrDash(strings.Join(stage.Actions, ", ")))
+	}
+	fmt.Fprintf(writer, color.Bold.Sprint("\nEnvironments\n\n"))
+	writer.Flush()
+	for _, env := range p.Environments {
+		fmt.Fprintf(writer, "  %s\n", env)
+	}
+	fmt.Fprintf(writer, color.Bold.Sprint("\nArtifact Buckets\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", "Region", "Name")
+	for _, bucket := range p.ArtifactBuckets {
+		fmt.Fprintf(writer, "  %s\t%s\n", bucket.Region, bucket.Name)
+	}
+	if len(p.Notifications) > 0 {
+		fmt.Fprintf(writer, color.Bold.Sprint("\nNotifications\n\n"))
+		writer.Flush()
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", "Type", "Target", "Events")
+		for _, target := range p.Notifications {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", target.Type, target.Address, valueOrDash(strings.Join(target.Events, ", ")))
+		}
+	}
+	writer.Flush()
+	return b.String()
+}
@@ -0,0 +1,182 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/codepipeline"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/session"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/term/color"
+)
+
+const noValue = "-"
+
+type pipelineStateGetter interface {
+	GetPipelineState(name string) (*codepipeline.PipelineState, error)
+	ListPipelineExecutions(name string) ([]*codepipeline.PipelineExecution, error)
+}
+
+// PipelineExecution contains serialized parameters of a pipeline execution.
+type PipelineExecution struct {
+	ID        string    `json:"id"`
+	Status    string    `json:"status"`
+	StartTime time.Time `json:"startTime"`
+}
+
+// PipelineAction contains serialized parameters of the state of an action in a stage.
+type PipelineAction struct {
+	Name          string     `json:"name"`
+	Status        string     `json:"status,omitempty"`
+	LastChangedAt *time.Time `json:"lastChangedAt,omitempty"`
+}
+
+// PipelineStage contains serialized parameters of the state of a pipeline stage.
+type PipelineStage struct {
+	Name          string            `json:"name"`
+	Status        string            `json:"status,omitempty"`
+	RevisionID    string            `json:"revisionID,omitempty"`
+	CommitMessage string            `json:"commitMessage,omitempty"`
+	Actions       []*PipelineAction `json:"actions"`
+}
+
+// PipelineStatus contains serialized parameters of the state of a pipeline.
+type PipelineStatus struct {
+	Name            string             `json:"name"`
+	LatestExecution *PipelineExecution `json:"latestExecution,omitempty"`
+	Stages          []*PipelineStage   `json:"stages"`
+}
+
+// PipelineStatusDescriber retrieves the state of a pipeline.
+type PipelineStatusDescriber struct {
+	pipelineName string
+
+	pipelineSvc pipelineStateGetter
+}
+
+// NewPipelineStatusDescriber instantiates a describer for the pipeline deployed with the name.
+func NewPipelineStatusDescriber(pipelineName string) (*PipelineStatusDescriber, error) {
+	sess, err := session.NewProvider().Default()
+	if err != nil {
+		return nil, err
+	}
+	return &PipelineStatusDescriber{
+		pipelineName: pipelineName,
+		pipelineSvc:  codepipeline.New(sess),
+	}, nil
+}
+
+// Describe returns the state of each stage and action of the pipeline, along with the
+// source revision that each stage is on.
+func (d *PipelineStatusDescriber) Describe() (*PipelineStatus, error) {
+	state, err := d.pipelineSvc.GetPipelineState(d.pipelineName)
+	if err != nil {
+		return nil, err
+	}
+	executions, err := d.pipelineSvc.ListPipelineExecutions(d.pipelineName)
+	if err != nil {
+		return nil, err
+	}
+	executionByID := make(map[string]*codepipeline.PipelineExecution)
+	for _, execution := range executions {
+		executionByID[execution.ExecutionID] = execution
+	}
+
+	status := &PipelineStatus{
+		Name: d.pipelineName,
+	}
+	if len(executions) != 0 {
+		status.LatestExecution = &PipelineExecution{
+			ID:        executions[0].ExecutionID,
+			Status:    executions[0].Status,
+			StartTime: executions[0].StartTime,
+		}
+	}
+	for _, stageState := range state.StageStates {
+		stage := &PipelineStage{
+			Name:   stageState.StageName,
+			Status: stageState.Status,
+		}
+		if execution, ok := executionByID[stageState.ExecutionID]; ok && len(execution.SourceRevisions) != 0 {
+			stage.RevisionID = execution.SourceRevisions[0].RevisionID
+			stage.CommitMessage = execution.SourceRevisions[0].Summary
+		}
+		for _, actionState := range stageState.ActionStates {
+			action := &PipelineAction{
+				Name:   actionState.ActionName,
+				Status: actionState.Status,
+			}
+			if !actionState.LastStatusChange.IsZero() {
+				lastChanged := actionState.LastStatusChange
+				action.LastChangedAt = &lastChanged
+			}
+			stage.Actions = append(stage.Actions, action)
+		}
+		status.Stages = append(status.Stages, stage)
+	}
+	return status, nil
+}
+
+// InProgress returns true if the latest execution of the pipeline is not finished yet.
+func (s *PipelineStatus) InProgress() bool {
+	if s.LatestExecution == nil {
+		return false
+	}
+	return s.LatestExecution.Status == codepipeline.ExecutionStatusInProgress ||
+		s.LatestExecution.Status == codepipeline.ExecutionStatusStopping
+}
+
+// JSONString returns the stringified PipelineStatus struct with json format.
+func (s *PipelineStatus) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal pipeline status: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified PipelineStatus struct with human readable format.
+func (s *PipelineStatus) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, color.Bold.Sprint("About\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", "Name", s.Name)
+	if s.LatestExecution != nil {
+		fmt.Fprintf(writer, "  %s\t%s\n", "Latest Execution", s.LatestExecution.ID)
+		fmt.Fprintf(writer, "  %s\t%s\n", "Status", s.LatestExecution.Status)
+		fmt.Fprintf(writer, "  %s\t%s\n", "Started At", s.LatestExecution.StartTime.Format(time.RFC3339))
+	}
+	fmt.Fprintf(writer, color.Bold.Sprint("\nStages\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", "Name", "Status", "Revision", "Commit Message")
+	for _, stage := range s.Stages {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", stage.Name, valueOrDash(stage.Status), valueOrDash(stage.RevisionID), valueOrDash(stage.CommitMessage))
+	}
+	fmt.Fprintf(writer, color.Bold.Sprint("\nActions\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", "Stage", "Name", "Status", "Last Changed")
+	for _, stage := range s.Stages {
+		for _, action := range stage.Actions {
+			lastChanged := noValue
+			if action.LastChangedAt != nil {
+				lastChanged = action.LastChangedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", stage.Name, action.Name, valueOrDash(action.Status), lastChanged)
+		}
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return noValue
+	}
+	return value
+}
@@ -0,0 +1,263 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/codepipeline"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineStatusDescriber_Describe(t *testing.T) {
+	mockTime := time.Date(2020, time.April, 27, 15, 6, 40, 0, time.UTC)
+	mockState := &codepipeline.PipelineState{
+		PipelineName: "phonetool-pipeline",
+		StageStates: []*codepipeline.StageState{
+			{
+				StageName:   "Source",
+				ExecutionID: "exec-2",
+				Status:      "Succeeded",
+				ActionStates: []*codepipeline.ActionState{
+					{
+						ActionName:       "SourceCodeFor-phonetool",
+						Status:           "Succeeded",
+						LastStatusChange: mockTime,
+					},
+				},
+			},
+			{
+				StageName:   "DeployTo-test",
+				ExecutionID: "exec-1",
+				Status:      "Failed",
+				ActionStates: []*codepipeline.ActionState{
+					{
+						ActionName:       "CreateOrUpdate-frontend-test",
+						Status:           "Failed",
+						LastStatusChange: mockTime,
+					},
+					{
+						ActionName: "CreateOrUpdate-backend-test",
+					},
+				},
+			},
+		},
+	}
+	mockExecutions := []*codepipeline.PipelineExecution{
+		{
+			ExecutionID: "exec-2",
+			Status:      "InProgress",
+			StartTime:   mockTime,
+			SourceRevisions: []*codepipeline.SourceRevision{
+				{
+					RevisionID: "def456",
+					Summary:    "Add tests",
+				},
+			},
+		},
+		{
+			ExecutionID: "exec-1",
+			Status:      "Failed",
+			StartTime:   mockTime,
+			SourceRevisions: []*codepipeline.SourceRevision{
+				{
+					RevisionID: "abc123",
+					Summary:    "Fix typo",
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		mockPipelineSvc func(m *mocks.MockpipelineStateGetter)
+
+		wantedStatus *PipelineStatus
+		wantedError  error
+	}{
+		"returns error if fail to get the pipeline state": {
+			mockPipelineSvc: func(m *mocks.MockpipelineStateGetter) {
+				m.EXPECT().GetPipelineState("phonetool-pipeline").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns error if fail to list the pipeline executions": {
+			mockPipelineSvc: func(m *mocks.MockpipelineStateGetter) {
+				m.EXPECT().GetPipelineState("phonetool-pipeline").Return(mockState, nil)
+				m.EXPECT().ListPipelineExecutions("phonetool-pipeline").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"matches each stage with the revision of its execution": {
+			mockPipelineSvc: func(m *mocks.MockpipelineStateGetter) {
+				m.EXPECT().GetPipelineState("phonetool-pipeline").Return(mockState, nil)
+				m.EXPECT().ListPipelineExecutions("phonetool-pipeline").Return(mockExecutions, nil)
+			},
+			wantedStatus: &PipelineStatus{
+				Name: "phonetool-pipeline",
+				LatestExecution: &PipelineExecution{
+					ID:        "exec-2",
+					Status:    "InProgress",
+					StartTime: mockTime,
+				},
+				Stages: []*PipelineStage{
+					{
+						Name:          "Source",
+						Status:        "Succeeded",
+						RevisionID:    "def456",
+						CommitMessage: "Add tests",
+						Actions: []*PipelineAction{
+							{
+								Name:          "SourceCodeFor-phonetool",
+								Status:        "Succeeded",
+								LastChangedAt: &mockTime,
+							},
+						},
+					},
+					{
+						Name:          "DeployTo-test",
+						Status:        "Failed",
+						RevisionID:    "abc123",
+						CommitMessage: "Fix typo",
+						Actions: []*PipelineAction{
+							{
+								Name:          "CreateOrUpdate-frontend-test",
+								Status:        "Failed",
+								LastChangedAt: &mockTime,
+							},
+							{
+								Name: "CreateOrUpdate-backend-test",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPipelineSvc := mocks.NewMockpipelineStateGetter(ctrl)
+			tc.mockPipelineSvc(mockPipelineSvc)
+			d := &PipelineStatusDescriber{
+				pipelineName: "phonetool-pipeline",
+				pipelineSvc:  mockPipelineSvc,
+			}
+
+			// WHEN
+			status, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedStatus, status)
+			}
+		})
+	}
+}
+
+func TestPipelineStatus_InProgress(t *testing.T) {
+	testCases := map[string]struct {
+		inExecution *PipelineExecution
+
+		wanted bool
+	}{
+		"never executed": {
+			wanted: false,
+		},
+		"in progress": {
+			inExecution: &PipelineExecution{Status: "InProgress"},
+			wanted:      true,
+		},
+		"stopping": {
+			inExecution: &PipelineExecution{Status: "Stopping"},
+			wanted:      true,
+		},
+		"succeeded": {
+			inExecution: &PipelineExecution{Status: "Succeeded"},
+			wanted:      false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			status := &PipelineStatus{LatestExecution: tc.inExecution}
+
+			require.Equal(t, tc.wanted, status.InProgress())
+		})
+	}
+}
+
+func TestPipelineStatus_String(t *testing.T) {
+	lastChanged := time.Date(2020, time.April, 27, 15, 6, 40, 0, time.UTC)
+	status := &PipelineStatus{
+		Name: "phonetool-pipeline",
+		LatestExecution: &PipelineExecution{
+			ID:        "exec-1",
+			Status:    "InProgress",
+			StartTime: lastChanged,
+		},
+		Stages: []*PipelineStage{
+			{
+				Name:          "Source",
+				Status:        "Succeeded",
+				RevisionID:    "abc123",
+				CommitMessage: "Fix typo",
+				Actions: []*PipelineAction{
+					{
+						Name:          "SourceCodeFor-phonetool",
+						Status:        "Succeeded",
+						LastChangedAt: &lastChanged,
+					},
+				},
+			},
+			{
+				Name: "DeployTo-test",
+				Actions: []*PipelineAction{
+					{
+						Name: "CreateOrUpdate-frontend-test",
+					},
+					{
+						Name: "CreateOrUpdate-backend-test",
+					},
+				},
+			},
+		},
+	}
+	wantedHumanString := `About
+
+  Name              phonetool-pipeline
+  Latest Execution  exec-1
+  Status            InProgress
+  Started At        2020-04-27T15:06:40Z
+
+Stages
+
+  Name              Status              Revision            Commit Message
+  Source            Succeeded           abc123              Fix typo
+  DeployTo-test     -                   -                   -
+
+Actions
+
+  Stage             Name                          Status              Last Changed
+  Source            SourceCodeFor-phonetool       Succeeded           2020-04-27T15:06:40Z
+  DeployTo-test     CreateOrUpdate-frontend-test  -                   -
+  DeployTo-test     CreateOrUpdate-backend-test   -                   -
+`
+	wantedJSONString := `{"name":"phonetool-pipeline","latestExecution":{"id":"exec-1","status":"InProgress","startTime":"2020-04-27T15:06:40Z"},"stages":[{"name":"Source","status":"Succeeded","revisionID":"abc123","commitMessage":"Fix typo","actions":[{"name":"SourceCodeFor-phonetool","status":"Succeeded","lastChangedAt":"2020-04-27T15:06:40Z"}]},{"name":"DeployTo-test","actions":[{"name":"CreateOrUpdate-frontend-test"},{"name":"CreateOrUpdate-backend-test"}]}]}
+`
+
+	human := status.HumanString()
+	json, _ := status.JSONString()
+
+	require.Equal(t, wantedHumanString, human)
+	require.Equal(t, wantedJSONString, json)
+}
@@ -0,0 +1,310 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/codepipeline"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/codestarnotifications"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineDescriber_Describe(t *testing.T) {
+	testCases := map[string]struct {
+		mockPipelineSvc      func(m *mocks.MockpipelineGetter)
+		mockNotificationsSvc func(m *mocks.MocknotificationRulesGetter)
+
+		wantedPipeline *Pipeline
+		wantedError    error
+	}{
+		"returns error if fail to get the pipeline": {
+			mockPipelineSvc: func(m *mocks.MockpipelineGetter) {
+				m.EXPECT().GetPipeline("phonetool-pipeline").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns error if fail to get the notification rules": {
+			mockPipelineSvc: func(m *mocks.MockpipelineGetter) {
+				m.EXPECT().GetPipeline("phonetool-pipeline").Return(&codepipeline.Pipeline{
+					Name: "phonetool-pipeline",
+					ARN:  "arn:aws:codepipeline:us-west-2:1234:phonetool-pipeline",
+				}, nil)
+			},
+			mockNotificationsSvc: func(m *mocks.MocknotificationRulesGetter) {
+				m.EXPECT().NotificationRules("arn:aws:codepipeline:us-west-2:1234:phonetool-pipeline").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns the source, stages, environments, artifact buckets and notification targets": {
+			mockPipelineSvc: func(m *mocks.MockpipelineGetter) {
+				m.EXPECT().GetPipeline("phonetool-pipeline").Return(&codepipeline.Pipeline{
+					Name: "phonetool-pipeline",
+					ARN:  "arn:aws:codepipeline:us-west-2:1234:phonetool-pipeline",
+					Stages: []*codepipeline.Stage{
+						{
+							Name: "Source",
+							Actions: []*codepipeline.Action{
+								{
+									Name:     "SourceCodeFor-phonetool",
+									Category: "Source",
+									Provider: "GitHub",
+									Configuration: map[string]string{
+										"Owner":  "badgoose",
+										"Repo":   "phonetool",
+										"Branch": "master",
+									},
+								},
+							},
+						},
+						{
+							Name: "Build",
+							Actions: []*codepipeline.Action{
+								{
+									Name:     "Build",
+									Category: "Build",
+									Provider: "CodeBuild",
+								},
+							},
+						},
+						{
+							Name: "DeployTo-test",
+							Actions: []*codepipeline.Action{
+								{
+									Name:     "CreateOrUpdate-frontend-test",
+									Category: "Deploy",
+									Provider: "CloudFormation",
+								},
+								{
+									Name:     "CreateOrUpdate-backend-test",
+									Category: "Deploy",
+									Provider: "CloudFormation",
+								},
+							},
+						},
+					},
+					ArtifactBuckets: []*codepipeline.ArtifactBucket{
+						{
+							Region: "us-west-2",
+							Name:   "bucket-west",
+						},
+					},
+				}, nil)
+			},
+			mockNotificationsSvc: func(m *mocks.MocknotificationRulesGetter) {
+				m.EXPECT().NotificationRules("arn:aws:codepipeline:us-west-2:1234:phonetool-pipeline").Return([]*codestarnotifications.NotificationRule{
+					{
+						Name:   "phonetool-pipeline-Notifications",
+						Events: []string{"Stage execution: Failed", "Manual approval: Needed"},
+						Targets: []*codestarnotifications.Target{
+							{
+								Type:    "SNS",
+								Address: "arn:aws:sns:us-west-2:1234:alerts",
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedPipeline: &Pipeline{
+				Name:       "phonetool-pipeline",
+				Region:     "us-west-2",
+				ConsoleURL: "https://console.aws.amazon.com/codesuite/codepipeline/pipelines/phonetool-pipeline/view?region=us-west-2",
+				Source: &PipelineSource{
+					Provider:   "GitHub",
+					Repository: "badgoose/phonetool",
+					Branch:     "master",
+				},
+				Stages: []*PipelineStageSummary{
+					{
+						Name:    "Source",
+						Actions: []string{"SourceCodeFor-phonetool"},
+					},
+					{
+						Name:    "Build",
+						Actions: []string{"Build"},
+					},
+					{
+						Name:    "DeployTo-test",
+						Actions: []string{"CreateOrUpdate-frontend-test", "CreateOrUpdate-backend-test"},
+					},
+				},
+				Environments: []string{"test"},
+				ArtifactBuckets: []*PipelineArtifactBucket{
+					{
+						Region: "us-west-2",
+						Name:   "bucket-west",
+					},
+				},
+				Notifications: []*PipelineNotificationTarget{
+					{
+						Type:    "SNS",
+						Address: "arn:aws:sns:us-west-2:1234:alerts",
+						Events:  []string{"Stage execution: Failed", "Manual approval: Needed"},
+					},
+				},
+			},
+		},
+		"uses the region of the pipeline for a single artifact bucket": {
+			mockPipelineSvc: func(m *mocks.MockpipelineGetter) {
+				m.EXPECT().GetPipeline("phonetool-pipeline").Return(&codepipeline.Pipeline{
+					Name: "phonetool-pipeline",
+					Stages: []*codepipeline.Stage{
+						{
+							Name: "Source",
+							Actions: []*codepipeline.Action{
+								{
+									Name:     "SourceCodeFor-phonetool",
+									Category: "Source",
+									Provider: "CodeStarSourceConnection",
+									Configuration: map[string]string{
+										"FullRepositoryId": "badgoose/phonetool",
+										"BranchName":       "main",
+									},
+								},
+							},
+						},
+					},
+					ArtifactBuckets: []*codepipeline.ArtifactBucket{
+						{
+							Name: "bucket",
+						},
+					},
+				}, nil)
+			},
+			wantedPipeline: &Pipeline{
+				Name:       "phonetool-pipeline",
+				Region:     "us-west-2",
+				ConsoleURL: "https://console.aws.amazon.com/codesuite/codepipeline/pipelines/phonetool-pipeline/view?region=us-west-2",
+				Source: &PipelineSource{
+					Provider:   "CodeStarSourceConnection",
+					Repository: "badgoose/phonetool",
+					Branch:     "main",
+				},
+				Stages: []*PipelineStageSummary{
+					{
+						Name:    "Source",
+						Actions: []string{"SourceCodeFor-phonetool"},
+					},
+				},
+				ArtifactBuckets: []*PipelineArtifactBucket{
+					{
+						Region: "us-west-2",
+						Name:   "bucket",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPipelineSvc := mocks.NewMockpipelineGetter(ctrl)
+			mockNotificationsSvc := mocks.NewMocknotificationRulesGetter(ctrl)
+			tc.mockPipelineSvc(mockPipelineSvc)
+			if tc.mockNotificationsSvc != nil {
+				tc.mockNotificationsSvc(mockNotificationsSvc)
+			}
+			d := &PipelineDescriber{
+				pipelineName:     "phonetool-pipeline",
+				region:           "us-west-2",
+				pipelineSvc:      mockPipelineSvc,
+				notificationsSvc: mockNotificationsSvc,
+			}
+
+			// WHEN
+			pipeline, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedPipeline, pipeline)
+			}
+		})
+	}
+}
+
+func TestPipeline_String(t *testing.T) {
+	pipeline := &Pipeline{
+		Name:       "phonetool-pipeline",
+		Region:     "us-west-2",
+		ConsoleURL: "https://console.aws.amazon.com/codesuite/codepipeline/pipelines/phonetool-pipeline/view?region=us-west-2",
+		Source: &PipelineSource{
+			Provider:   "GitHub",
+			Repository: "badgoose/phonetool",
+			Branch:     "master",
+		},
+		Stages: []*PipelineStageSummary{
+			{
+				Name:    "Source",
+				Actions: []string{"SourceCodeFor-phonetool"},
+			},
+			{
+				Name:    "DeployTo-test",
+				Actions: []string{"CreateOrUpdate-frontend-test", "CreateOrUpdate-backend-test"},
+			},
+		},
+		Environments: []string{"test"},
+		ArtifactBuckets: []*PipelineArtifactBucket{
+			{
+				Region: "us-west-2",
+				Name:   "bucket-west",
+			},
+		},
+		Notifications: []*PipelineNotificationTarget{
+			{
+				Type:    "SNS",
+				Address: "arn:aws:sns:us-west-2:1234:alerts",
+				Events:  []string{"Stage execution: Failed"},
+			},
+		},
+	}
+	wantedHumanString := `About
+
+  Name              phonetool-pipeline
+  Region            us-west-2
+  Console URL       https://console.aws.amazon.com/codesuite/codepipeline/pipelines/phonetool-pipeline/view?region=us-west-2
+
+Source
+
+  Provider          GitHub
+  Repository        badgoose/phonetool
+  Branch            master
+
+Stages
+
+  Name              Actions
+  Source            SourceCodeFor-phonetool
+  DeployTo-test     CreateOrUpdate-frontend-test, CreateOrUpdate-backend-test
+
+Environments
+
+  test
+
+Artifact Buckets
+
+  Region            Name
+  us-west-2         bucket-west
+
+Notifications
+
+  Type              Target                             Events
+  SNS               arn:aws:sns:us-west-2:1234:alerts  Stage execution: Failed
+`
+	wantedJSONString := `{"name":"phonetool-pipeline","region":"us-west-2","consoleURL":"https://console.aws.amazon.com/codesuite/codepipeline/pipelines/phonetool-pipeline/view?region=us-west-2","source":{"provider":"GitHub","repository":"badgoose/phonetool","branch":"master"},"stages":[{"name":"Source","actions":["SourceCodeFor-phonetool"]},{"name":"DeployTo-test","actions":["CreateOrUpdate-frontend-test","CreateOrUpdate-backend-test"]}],"environments":["test"],"artifactBuckets":[{"region":"us-west-2","name":"bucket-west"}],"notifications":[{"type":"SNS","address":"arn:aws:sns:us-west-2:1234:alerts","events":["Stage execution: Failed"]}]}
+`
+
+	human := pipeline.HumanString()
+	json, _ := pipeline.JSONString()
+
+	require.Equal(t, wantedHumanString, human)
+	require.Equal(t, wantedJSONString, json)
+}
@@ -0,0 +1,76 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/ecs"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/aws/session"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+)
+
+type runningTasksLister interface {
+	RunningTaskIDs(cluster, family string) ([]string, error)
+}
+
+// AppTasksDescriber retrieves the tasks of an application deployed in an environment.
+type AppTasksDescriber struct {
+	env     *archer.Environment
+	appName string
+	cluster string // Cached after the first lookup.
+
+	stackDescriber stackDescriber
+	ecsSvc         runningTasksLister
+}
+
+// NewAppTasksDescriber instantiates a describer for the tasks of an application in an environment.
+func NewAppTasksDescriber(env *archer.Environment, appName string) (*AppTasksDescriber, error) {
+	sess, err := session.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return &AppTasksDescriber{
+		env:            env,
+		appName:        appName,
+		stackDescriber: cloudformation.New(sess),
+		ecsSvc:         ecs.New(sess),
+	}, nil
+}
+
+// RunningTaskIDs returns the IDs of the running tasks of the application.
+func (d *AppTasksDescriber) RunningTaskIDs() ([]string, error) {
+	if d.cluster == "" {
+		cluster, err := envClusterID(d.stackDescriber, d.env)
+		if err != nil {
+			return nil, err
+		}
+		d.cluster = cluster
+	}
+	// The task definition family of an application is "{project}-{env}-{app}", see templates/lb-fargate-service/cf.yml.
+	family := fmt.Sprintf("%s-%s-%s", d.env.Project, d.env.Name, d.appName)
+	return d.ecsSvc.RunningTaskIDs(d.cluster, family)
+}
+
+// envClusterID returns the ID of the ECS cluster of an environment from the outputs of its stack.
+func envClusterID(sd stackDescriber, env *archer.Environment) (string, error) {
+	stackName := stack.NameForEnv(env.Project, env.Name)
+	out, err := sd.DescribeStacks(&cloudformation.DescribeStacksInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("describe stack %s: %w", stackName, err)
+	}
+	if len(out.Stacks) == 0 {
+		return "", fmt.Errorf("stack %s not found", stackName)
+	}
+	cluster, ok := stackOutputs(out.Stacks[0])[stack.EnvOutputClusterID]
+	if !ok {
+		return "", fmt.Errorf("cluster of environment %s not found", env.Name)
+	}
+	return cluster, nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/archer"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/amazon-ecs-cli-v2/internal/pkg/describe/mocks"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppTasksDescriber_RunningTaskIDs(t *testing.T) {
+	testErr := errors.New("some error")
+	testCases := map[string]struct {
+		cachedCluster      string
+		mockStackDescriber func(m *mocks.MockstackDescriber)
+		mockECS            func(m *mocks.MockrunningTasksLister)
+
+		wantedIDs   []string
+		wantedError error
+	}{
+		"returns error if fail to describe the environment stack": {
+			mockStackDescriber: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(nil, testErr)
+			},
+			mockECS: func(m *mocks.MockrunningTasksLister) {},
+
+			wantedError: fmt.Errorf("describe stack phonetool-test: some error"),
+		},
+		"returns error if the environment stack has no cluster": {
+			mockStackDescriber: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{{}},
+				}, nil)
+			},
+			mockECS: func(m *mocks.MockrunningTasksLister) {},
+
+			wantedError: fmt.Errorf("cluster of environment test not found"),
+		},
+		"returns the running tasks in the cluster of the environment": {
+			mockStackDescriber: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().DescribeStacks(&cloudformation.DescribeStacksInput{
+					StackName: aws.String("phonetool-test"),
+				}).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							Outputs: []*cloudformation.Output{
+								{
+									OutputKey:   aws.String(stack.EnvOutputClusterID),
+									OutputValue: aws.String("phonetool-test-Cluster"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			mockECS: func(m *mocks.MockrunningTasksLister) {
+				m.EXPECT().RunningTaskIDs("phonetool-test-Cluster", "phonetool-test-frontend").Return([]string{"task1"}, nil)
+			},
+
+			wantedIDs: []string{"task1"},
+		},
+		"reuses the cluster of a previous lookup": {
+			cachedCluster:      "phonetool-test-Cluster",
+			mockStackDescriber: func(m *mocks.MockstackDescriber) {},
+			mockECS: func(m *mocks.MockrunningTasksLister) {
+				m.EXPECT().RunningTaskIDs("phonetool-test-Cluster", "phonetool-test-frontend").Return(nil, testErr)
+			},
+
+			wantedError: testErr,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStackDescriber := mocks.NewMockstackDescriber(ctrl)
+			mockECS := mocks.NewMockrunningTasksLister(ctrl)
+			tc.mockStackDescriber(mockStackDescriber)
+			tc.mockECS(mockECS)
+
+			d := &AppTasksDescriber{
+				env: &archer.Environment{
+					Project: "phonetool",
+					Name:    "test",
+				},
+				appName:        "frontend",
+				cluster:        tc.cachedCluster,
+				stackDescriber: mockStackDescriber,
+				ecsSvc:         mockECS,
+			}
+
+			// WHEN
+			actual, err := d.RunningTaskIDs()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedIDs, actual)
+			}
+		})
+	}
+}
@@ -37,8 +37,9 @@ const (
 
 // WebAppURI represents the unique identifier to access a web application.
 type WebAppURI struct {
-	DNSName string // The environment's subdomain if the application is served on HTTPS. Otherwise, the public load balancer's DNS.
-	Path    string // Empty if the application is served on HTTPS. Otherwise, the pattern used to match the application.
+	DNSName string   // The environment's subdomain if the application is served on HTTPS. Otherwise, the public load balancer's DNS.
+	Path    string   // Empty if the application is served on HTTPS. Otherwise, the pattern used to match the application.
+	Aliases []string // Custom domain names the application is also served on over HTTPS.
 }
 
 // CfnResource contains application resources created by cloudformation.
@@ -70,9 +71,10 @@ type WebAppConfig struct {
 
 // WebAppRoute contains serialized route parameters for a web application.
 type WebAppRoute struct {
-	Environment string `json:"environment"`
-	URL         string `json:"url"`
-	Path        string `json:"path"`
+	Environment string   `json:"environment"`
+	URL         string   `json:"url"`
+	Path        string   `json:"path"`
+	Aliases     []string `json:"aliases,omitempty"`
 }
 
 // WebAppEnvVars contains serialized environment variables for a web application.
@@ -237,23 +239,39 @@ func (d *WebAppDescriber) URI(envName string) (*WebAppURI, error) {
 	if err != nil {
 		return nil, err
 	}
-	appParams, err := d.appParams(env)
+	appStack, err := d.stack(env.ManagerRoleARN, env.Region, stack.NameForApp(d.app.Project, env.Name, d.app.Name))
 	if err != nil {
 		return nil, err
 	}
 
-	uri := &WebAppURI{
-		DNSName: envOutputs[stack.EnvOutputPublicLoadBalancerDNSName],
-		Path:    appParams[stack.LBFargateRulePathKey],
-	}
+	return newWebAppURI(d.app.Name, envOutputs, stackParameters(appStack), stackOutputs(appStack)), nil
+}
+
+// newWebAppURI returns the URI of an application given the outputs of its environment stack
+// and the parameters and outputs of its application stack.
+func newWebAppURI(appName string, envOutputs, appParams, appOutputs map[string]string) *WebAppURI {
+	aliases := splitOutput(appOutputs[stack.LBFargateOutputAliasesKey])
 	_, isHTTPS := envOutputs[stack.EnvOutputSubdomain]
 	if isHTTPS {
-		dnsName := fmt.Sprintf("%s.%s", d.app.Name, envOutputs[stack.EnvOutputSubdomain])
-		uri = &WebAppURI{
-			DNSName: dnsName,
+		return &WebAppURI{
+			DNSName: fmt.Sprintf("%s.%s", appName, envOutputs[stack.EnvOutputSubdomain]),
+			Aliases: aliases,
+		}
+	}
+	if len(aliases) != 0 {
+		// Without a project domain, the application is only reachable over HTTPS on its aliases.
+		uri := &WebAppURI{
+			DNSName: aliases[0],
+		}
+		if len(aliases) > 1 {
+			uri.Aliases = aliases[1:]
 		}
+		return uri
+	}
+	return &WebAppURI{
+		DNSName: envOutputs[stack.EnvOutputPublicLoadBalancerDNSName],
+		Path:    appParams[stack.LBFargateRulePathKey],
 	}
-	return uri, nil
 }
 
 func (d *WebAppDescriber) envOutputs(env *archer.Environment) (map[string]string, error) {
@@ -261,11 +279,7 @@ func (d *WebAppDescriber) envOutputs(env *archer.Environment) (map[string]string
 	if err != nil {
 		return nil, err
 	}
-	outputs := make(map[string]string)
-	for _, out := range envStack.Outputs {
-		outputs[*out.OutputKey] = *out.OutputValue
-	}
-	return outputs, nil
+	return stackOutputs(envStack), nil
 }
 
 func (d *WebAppDescriber) appParams(env *archer.Environment) (map[string]string, error) {
@@ -273,11 +287,25 @@ func (d *WebAppDescriber) appParams(env *archer.Environment) (map[string]string,
 	if err != nil {
 		return nil, err
 	}
+	return stackParameters(appStack), nil
+}
+
+// stackParameters returns the parameter values of a stack by key.
+func stackParameters(s *cloudformation.Stack) map[string]string {
 	params := make(map[string]string)
-	for _, param := range appStack.Parameters {
-		params[*param.ParameterKey] = *param.ParameterValue
+	for _, param := range s.Parameters {
+		params[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
 	}
-	return params, nil
+	return params
+}
+
+// stackOutputs returns the output values of a stack by key.
+func stackOutputs(s *cloudformation.Stack) map[string]string {
+	outputs := make(map[string]string)
+	for _, out := range s.Outputs {
+		outputs[aws.StringValue(out.OutputKey)] = aws.StringValue(out.OutputValue)
+	}
+	return outputs
 }
 
 func (d *WebAppDescriber) describeStackResources(roleARN, region, stackName string) ([]*cloudformation.StackResource, error) {
@@ -351,6 +379,10 @@ func (w *WebApp) HumanString() string {
 	fmt.Fprintf(writer, "  %s\t%s\t%s\n", "Environment", "URL", "Path")
 	for _, route := range w.Routes {
 		fmt.Fprintf(writer, "  %s\t%s\t%s\n", route.Environment, route.URL, route.Path)
+		// Aliases are served by the same environment, so we replace its name with "-" to reduce text.
+		for _, alias := range route.Aliases {
+			fmt.Fprintf(writer, "  -\t%s\t%s\n", alias, route.Path)
+		}
 	}
 	fmt.Fprintf(writer, color.Bold.Sprint("\nVariables\n\n"))
 	writer.Flush()
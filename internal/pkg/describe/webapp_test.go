@@ -223,6 +223,122 @@ func TestWebAppDescriber_URI(t *testing.T) {
 				Path:    testAppPath,
 			},
 		},
+		"https web application with aliases": {
+			mockStore: func(ctrl *gomock.Controller) *mocks.MockenvGetter {
+				m := mocks.NewMockenvGetter(ctrl)
+				m.EXPECT().GetEnvironment(testProject, testEnv).Return(&archer.Environment{
+					Project:        testProject,
+					Name:           testEnv,
+					ManagerRoleARN: testManagerRoleARN,
+				}, nil)
+				return m
+			},
+			mockStackDescribers: func(ctrl *gomock.Controller) map[string]stackDescriber {
+				m := mocks.NewMockstackDescriber(ctrl)
+				describers := make(map[string]stackDescriber)
+				m.EXPECT().DescribeStacks(&cloudformation.DescribeStacksInput{
+					StackName: aws.String(stack.NameForEnv(testProject, testEnv)),
+				}).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							Outputs: []*cloudformation.Output{
+								{
+									OutputKey:   aws.String(stack.EnvOutputSubdomain),
+									OutputValue: aws.String(testEnvSubdomain),
+								},
+								{
+									OutputKey:   aws.String(stack.EnvOutputPublicLoadBalancerDNSName),
+									OutputValue: aws.String(testEnvLBDNSName),
+								},
+							},
+						},
+					},
+				}, nil)
+				m.EXPECT().DescribeStacks(&cloudformation.DescribeStacksInput{
+					StackName: aws.String(stack.NameForApp(testProject, testEnv, testApp)),
+				}).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							Parameters: []*cloudformation.Parameter{
+								{
+									ParameterKey:   aws.String(stack.LBFargateRulePathKey),
+									ParameterValue: aws.String(testAppPath),
+								},
+							},
+							Outputs: []*cloudformation.Output{
+								{
+									OutputKey:   aws.String(stack.LBFargateOutputAliasesKey),
+									OutputValue: aws.String("example.com,www.example.com"),
+								},
+							},
+						},
+					},
+				}, nil)
+				describers[testManagerRoleARN] = m
+				return describers
+			},
+
+			wantedURI: &WebAppURI{
+				DNSName: testApp + "." + testEnvSubdomain,
+				Aliases: []string{"example.com", "www.example.com"},
+			},
+		},
+		"web application served on aliases without a project domain": {
+			mockStore: func(ctrl *gomock.Controller) *mocks.MockenvGetter {
+				m := mocks.NewMockenvGetter(ctrl)
+				m.EXPECT().GetEnvironment(testProject, testEnv).Return(&archer.Environment{
+					Project:        testProject,
+					Name:           testEnv,
+					ManagerRoleARN: testManagerRoleARN,
+				}, nil)
+				return m
+			},
+			mockStackDescribers: func(ctrl *gomock.Controller) map[string]stackDescriber {
+				m := mocks.NewMockstackDescriber(ctrl)
+				describers := make(map[string]stackDescriber)
+				m.EXPECT().DescribeStacks(&cloudformation.DescribeStacksInput{
+					StackName: aws.String(stack.NameForEnv(testProject, testEnv)),
+				}).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							Outputs: []*cloudformation.Output{
+								{
+									OutputKey:   aws.String(stack.EnvOutputPublicLoadBalancerDNSName),
+									OutputValue: aws.String(testEnvLBDNSName),
+								},
+							},
+						},
+					},
+				}, nil)
+				m.EXPECT().DescribeStacks(&cloudformation.DescribeStacksInput{
+					StackName: aws.String(stack.NameForApp(testProject, testEnv, testApp)),
+				}).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							Parameters: []*cloudformation.Parameter{
+								{
+									ParameterKey:   aws.String(stack.LBFargateRulePathKey),
+									ParameterValue: aws.String(testAppPath),
+								},
+							},
+							Outputs: []*cloudformation.Output{
+								{
+									OutputKey:   aws.String(stack.LBFargateOutputAliasesKey),
+									OutputValue: aws.String("example.com,www.example.com"),
+								},
+							},
+						},
+					},
+				}, nil)
+				describers[testManagerRoleARN] = m
+				return describers
+			},
+
+			wantedURI: &WebAppURI{
+				DNSName: "example.com",
+				Aliases: []string{"www.example.com"},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
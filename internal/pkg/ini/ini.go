@@ -50,3 +50,23 @@ func (i *INI) Sections() []string {
 	}
 	return names
 }
+
+// Keys returns the key-value pairs of the section with the given name.
+// An empty map is returned if the section doesn't exist.
+//
+// For example, the method returns {"protocol": "http", "http_port": "9999"} for the section "server" if the file's content is:
+//  [server]
+//  protocol = http
+//  http_port = 9999
+func (i *INI) Keys(section string) map[string]string {
+	keys := make(map[string]string)
+	for _, s := range i.cfg.Sections() {
+		if s.Name() != section {
+			continue
+		}
+		for _, k := range s.Keys() {
+			keys[k.Name()] = k.Value()
+		}
+	}
+	return keys
+}
@@ -28,3 +28,24 @@ protocol = http
 	// THEN
 	require.Equal(t, []string{"paths", "server"}, actualNames)
 }
+
+func TestINI_Keys(t *testing.T) {
+	// GIVEN
+	content := `[paths]
+data = /home/git/grafana
+
+[server]
+protocol = http
+http_port = 9999
+`
+	cfg, _ := ini.Load([]byte(content))
+	ini := &INI{cfg: cfg}
+
+	// WHEN
+	serverKeys := ini.Keys("server")
+	missingKeys := ini.Keys("database")
+
+	// THEN
+	require.Equal(t, map[string]string{"protocol": "http", "http_port": "9999"}, serverKeys)
+	require.Empty(t, missingKeys)
+}
@@ -44,6 +44,7 @@ type AppManifestProps struct {
 // UnmarshalApp deserializes the YAML input stream into a manifest object.
 // If an error occurs during deserialization, then returns the error.
 // If the application type in the manifest is invalid, then returns an ErrInvalidManifestType.
+// If the application's configuration is invalid, then returns the error.
 func UnmarshalApp(in []byte) (archer.Manifest, error) {
 	am := AppManifest{}
 	if err := yaml.Unmarshal(in, &am); err != nil {
@@ -56,6 +57,9 @@ func UnmarshalApp(in []byte) (archer.Manifest, error) {
 		if err := yaml.Unmarshal(in, &m); err != nil {
 			return nil, &ErrUnmarshalLBFargateManifest{parent: err}
 		}
+		if err := m.validate(); err != nil {
+			return nil, err
+		}
 		return &m, nil
 	default:
 		return nil, &ErrInvalidAppManifestType{Type: am.Type}
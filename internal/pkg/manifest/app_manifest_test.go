@@ -4,6 +4,7 @@
 package manifest
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -79,6 +80,108 @@ environments:
 				require.Equal(t, wantedManifest, actualManifest)
 			},
 		},
+		"load balanced web application with logging": {
+			inContent: `
+name: frontend
+type: "Load Balanced Web App"
+logging:
+  retention: 30
+  subscription:
+    destination: arn:aws:firehose:us-west-2:123456789012:deliverystream/my-stream
+    pattern: '{ $.level = "error" }'
+environments:
+  prod:
+    logging:
+      retention: 365
+      router:
+        options:
+          Name: firehose
+          delivery_stream: my-stream
+`,
+			requireCorrectValues: func(t *testing.T, i interface{}) {
+				actualManifest, ok := i.(*LBFargateManifest)
+				require.True(t, ok)
+				require.Equal(t, &LoggingConfig{
+					Retention: 30,
+					Subscription: &LogSubscription{
+						Destination: "arn:aws:firehose:us-west-2:123456789012:deliverystream/my-stream",
+						Pattern:     `{ $.level = "error" }`,
+					},
+				}, actualManifest.Logging)
+				require.Equal(t, &LoggingConfig{
+					Retention: 365,
+					Router: &LogRouter{
+						Options: map[string]string{
+							"Name":            "firehose",
+							"delivery_stream": "my-stream",
+						},
+					},
+				}, actualManifest.Environments["prod"].Logging)
+			},
+		},
+		"negative capacity provider weight": {
+			inContent: `
+name: frontend
+type: "Load Balanced Web App"
+capacityProviders:
+  spot: -1
+  ondemand: 1
+`,
+			wantedErr: errors.New(`"capacityProviders.spot" must not be negative`),
+		},
+		"no capacity provider weight in an environment": {
+			inContent: `
+name: frontend
+type: "Load Balanced Web App"
+environments:
+  test:
+    capacityProviders:
+      spot: 0
+      ondemand: 0
+`,
+			wantedErr: errors.New(`"environments.test.capacityProviders.spot" or "environments.test.capacityProviders.ondemand" must be greater than 0`),
+		},
+		"invalid log retention": {
+			inContent: `
+name: frontend
+type: "Load Balanced Web App"
+logging:
+  retention: 10
+`,
+			wantedErr: errors.New(`"logging.retention" must be one of [1 3 5 7 14 30 60 90 120 150 180 365 400 545 731 1827 3653] days`),
+		},
+		"missing log subscription destination in an environment": {
+			inContent: `
+name: frontend
+type: "Load Balanced Web App"
+environments:
+  prod:
+    logging:
+      subscription:
+        pattern: ERROR
+`,
+			wantedErr: errors.New(`"environments.prod.logging.subscription.destination" is required`),
+		},
+		"log subscription destination is not an ARN": {
+			inContent: `
+name: frontend
+type: "Load Balanced Web App"
+logging:
+  subscription:
+    destination: my-stream
+`,
+			wantedErr: errors.New(`"logging.subscription.destination" must be the ARN of a Kinesis stream, Firehose delivery stream or Lambda function`),
+		},
+		"missing log router options": {
+			inContent: `
+name: frontend
+type: "Load Balanced Web App"
+logging:
+  router:
+    image: amazon/aws-for-fluent-bit:latest
+`,
+			wantedErr: errors.New(`"logging.router.options" is required to tell the log router where to send the logs`),
+		},
 		"invalid app type": {
 			inContent: `
 name: CowApp
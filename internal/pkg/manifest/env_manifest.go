@@ -0,0 +1,167 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/aws/amazon-ecs-cli-v2/templates"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	envManifestPath = "environment/manifest.yml"
+
+	// Default CIDR ranges of the VPC created for an environment.
+	defaultVPCCIDR            = "10.0.0.0/16"
+	defaultPublicSubnet1CIDR  = "10.0.0.0/24"
+	defaultPublicSubnet2CIDR  = "10.0.1.0/24"
+	defaultPrivateSubnet1CIDR = "10.0.2.0/24"
+	defaultPrivateSubnet2CIDR = "10.0.3.0/24"
+
+	// Number of subnets of each kind created for an environment.
+	envSubnetCount = 2
+)
+
+// EnvironmentManifest holds the configuration of an environment.
+type EnvironmentManifest struct {
+	Name               string           `yaml:"name"`
+	Prod               bool             `yaml:"prod"`
+	PublicLoadBalancer bool             `yaml:"publicLoadBalancer"`
+	CertificateARN     string           `yaml:"certificateARN,omitempty"`
+	Cluster            EnvClusterConfig `yaml:"cluster"`
+	Network            EnvNetworkConfig `yaml:"network"`
+}
+
+// EnvClusterConfig holds the settings of an environment's ECS cluster.
+type EnvClusterConfig struct {
+	ContainerInsights bool `yaml:"containerInsights"`
+	FargateSpot       bool `yaml:"fargateSpot"`
+}
+
+// EnvNetworkConfig holds the networking configuration of an environment.
+// At most one of VPC or ImportVPC can be set.
+type EnvNetworkConfig struct {
+	VPC       *EnvVPCConfig       `yaml:"vpc,omitempty"`
+	ImportVPC *EnvImportVPCConfig `yaml:"importVPC,omitempty"`
+}
+
+// EnvVPCConfig holds the CIDR ranges of the VPC created for an environment
+// and whether the VPC has endpoints to reach AWS services without going through the internet.
+// Endpoints are only created in the VPC of the environment, not in an imported VPC.
+type EnvVPCConfig struct {
+	CIDR               string   `yaml:"cidr"`
+	PublicSubnetCIDRs  []string `yaml:"publicSubnetCIDRs"`
+	PrivateSubnetCIDRs []string `yaml:"privateSubnetCIDRs"`
+	Endpoints          bool     `yaml:"endpoints"`
+}
+
+// EnvImportVPCConfig holds the IDs of an existing VPC and its subnets to deploy an environment into.
+type EnvImportVPCConfig struct {
+	ID               string   `yaml:"id"`
+	PublicSubnetIDs  []string `yaml:"publicSubnetIDs"`
+	PrivateSubnetIDs []string `yaml:"privateSubnetIDs"`
+}
+
+// EnvironmentManifestProps contains properties for creating a new environment manifest.
+type EnvironmentManifestProps struct {
+	Name           string
+	Prod           bool
+	CertificateARN string
+}
+
+// NewEnvironmentManifest creates a new environment with a public load balancer and a VPC with the default CIDR ranges.
+// Production environments enable Container Insights, while other environments make Fargate Spot available to cut costs.
+func NewEnvironmentManifest(input *EnvironmentManifestProps) *EnvironmentManifest {
+	return &EnvironmentManifest{
+		Name:               input.Name,
+		Prod:               input.Prod,
+		PublicLoadBalancer: true,
+		CertificateARN:     input.CertificateARN,
+		Cluster: EnvClusterConfig{
+			ContainerInsights: input.Prod,
+			FargateSpot:       !input.Prod,
+		},
+		Network: EnvNetworkConfig{
+			VPC: &EnvVPCConfig{
+				CIDR:               defaultVPCCIDR,
+				PublicSubnetCIDRs:  []string{defaultPublicSubnet1CIDR, defaultPublicSubnet2CIDR},
+				PrivateSubnetCIDRs: []string{defaultPrivateSubnet1CIDR, defaultPrivateSubnet2CIDR},
+			},
+		},
+	}
+}
+
+// MarshalBinary serializes the manifest object into a YAML document.
+func (m *EnvironmentManifest) MarshalBinary() ([]byte, error) {
+	box := templates.Box()
+	content, err := box.FindString(envManifestPath)
+	if err != nil {
+		return nil, err
+	}
+	tpl, err := template.New("template").Funcs(template.FuncMap{
+		"join": strings.Join,
+	}).Parse(content)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, *m); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalEnvironment deserializes the YAML input stream into an environment manifest object.
+// If an error occurs during deserialization or the manifest is invalid, then returns the error.
+func UnmarshalEnvironment(in []byte) (*EnvironmentManifest, error) {
+	m := EnvironmentManifest{}
+	if err := yaml.Unmarshal(in, &m); err != nil {
+		return nil, &ErrUnmarshalEnvironmentManifest{parent: err}
+	}
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (m *EnvironmentManifest) validate() error {
+	if m.Name == "" {
+		return errors.New(`environment manifest is missing the "name" field`)
+	}
+	if m.CertificateARN != "" && !m.PublicLoadBalancer {
+		return errors.New(`"certificateARN" requires "publicLoadBalancer" to be true`)
+	}
+	vpc, imported := m.Network.VPC, m.Network.ImportVPC
+	if vpc != nil && imported != nil {
+		if vpc.Endpoints {
+			return errors.New(`"network.vpc.endpoints" can't be used with "network.importVPC", VPC endpoints are only created in the VPC of the environment`)
+		}
+		return errors.New(`only one of "network.vpc" or "network.importVPC" may be specified`)
+	}
+	if vpc != nil {
+		if vpc.CIDR == "" {
+			return errors.New(`"network.vpc.cidr" is required`)
+		}
+		if len(vpc.PublicSubnetCIDRs) != envSubnetCount || len(vpc.PrivateSubnetCIDRs) != envSubnetCount {
+			return fmt.Errorf(`"network.vpc" requires exactly %d public and %d private subnet CIDRs`, envSubnetCount, envSubnetCount)
+		}
+	}
+	if imported != nil {
+		if imported.ID == "" {
+			return errors.New(`"network.importVPC.id" is required`)
+		}
+		if len(imported.PrivateSubnetIDs) == 0 {
+			return errors.New(`"network.importVPC.privateSubnetIDs" is required`)
+		}
+		if m.PublicLoadBalancer && len(imported.PublicSubnetIDs) == 0 {
+			return errors.New(`"network.importVPC.publicSubnetIDs" is required to create a public load balancer`)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+// Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvironmentManifest_Marshal(t *testing.T) {
+	// GIVEN
+	wantedContent := `# The manifest for the "test" environment.
+# Run ` + "`ecs-preview env deploy --name test`" + ` to apply changes to this file.
+
+# Your environment name will be used in naming your resources like the VPC, cluster, etc.
+name: test
+# Production environments are created with additional guardrails.
+prod: false
+# Whether a public load balancer is shared by the environment's applications.
+publicLoadBalancer: true
+# ARN of an existing ACM certificate for the HTTPS listener of the public load balancer.
+# Applications can then serve their "http.alias" domain names over HTTPS.
+#certificateARN: arn:aws:acm:us-west-2:123456789012:certificate/example
+
+cluster:
+  # Collect metrics and logs of your tasks with CloudWatch Container Insights.
+  containerInsights: false
+  # Let applications run tasks on spare capacity at a discount with the FARGATE_SPOT capacity provider.
+  fargateSpot: true
+
+network:
+  # CIDR ranges of the VPC created for the environment.
+  vpc:
+    cidr: 10.0.0.0/16
+    publicSubnetCIDRs: [10.0.0.0/24, 10.0.1.0/24]
+    privateSubnetCIDRs: [10.0.2.0/24, 10.0.3.0/24]
+    # Create VPC endpoints for ECR, S3, CloudWatch Logs, Secrets Manager and SSM so that
+    # tasks pull images and fetch secrets without their traffic leaving the VPC.
+    endpoints: false
+
+  # Instead of creating a VPC, you can deploy the environment into an existing one
+  # by replacing the "vpc" section above with the following. VPC endpoints are only
+  # created in the VPC of the environment, not in an existing one.
+  #importVPC:
+  #  id: vpc-0123456789abcdef0
+  #  publicSubnetIDs: [subnet-01, subnet-02]    # Subnets for the public load balancer.
+  #  privateSubnetIDs: [subnet-03, subnet-04]   # Subnets for your applications' tasks.
+`
+	m := NewEnvironmentManifest(&EnvironmentManifestProps{
+		Name: "test",
+	})
+
+	// WHEN
+	b, err := m.MarshalBinary()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, wantedContent, strings.Replace(string(b), "\r\n", "\n", -1))
+
+	unmarshaled, err := UnmarshalEnvironment(b)
+	require.NoError(t, err)
+	require.Equal(t, m, unmarshaled)
+}
+
+func TestUnmarshalEnvironment(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedManifest *EnvironmentManifest
+		wantedErr      error
+	}{
+		"imported VPC": {
+			inContent: `
+name: prod
+prod: true
+publicLoadBalancer: true
+network:
+  importVPC:
+    id: vpc-1234
+    publicSubnetIDs: [subnet-1, subnet-2]
+    privateSubnetIDs: [subnet-3, subnet-4]
+`,
+			wantedManifest: &EnvironmentManifest{
+				Name:               "prod",
+				Prod:               true,
+				PublicLoadBalancer: true,
+				Network: EnvNetworkConfig{
+					ImportVPC: &EnvImportVPCConfig{
+						ID:               "vpc-1234",
+						PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+						PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+					},
+				},
+			},
+		},
+		"VPC with endpoints": {
+			inContent: `
+name: test
+network:
+  vpc:
+    cidr: 10.0.0.0/16
+    publicSubnetCIDRs: [10.0.0.0/24, 10.0.1.0/24]
+    privateSubnetCIDRs: [10.0.2.0/24, 10.0.3.0/24]
+    endpoints: true
+`,
+			wantedManifest: &EnvironmentManifest{
+				Name: "test",
+				Network: EnvNetworkConfig{
+					VPC: &EnvVPCConfig{
+						CIDR:               "10.0.0.0/16",
+						PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+						PrivateSubnetCIDRs: []string{"10.0.2.0/24", "10.0.3.0/24"},
+						Endpoints:          true,
+					},
+				},
+			},
+		},
+		"without network configuration": {
+			inContent: `
+name: test
+`,
+			wantedManifest: &EnvironmentManifest{
+				Name: "test",
+			},
+		},
+		"invalid YAML": {
+			inContent: `name: [test`,
+
+			wantedErr: &ErrUnmarshalEnvironmentManifest{},
+		},
+		"missing name": {
+			inContent: `prod: true`,
+
+			wantedErr: errors.New(`environment manifest is missing the "name" field`),
+		},
+		"both VPC and imported VPC": {
+			inContent: `
+name: test
+network:
+  vpc:
+    cidr: 10.0.0.0/16
+  importVPC:
+    id: vpc-1234
+`,
+			wantedErr: errors.New(`only one of "network.vpc" or "network.importVPC" may be specified`),
+		},
+		"VPC endpoints with an imported VPC": {
+			inContent: `
+name: test
+network:
+  vpc:
+    endpoints: true
+  importVPC:
+    id: vpc-1234
+`,
+			wantedErr: errors.New(`"network.vpc.endpoints" can't be used with "network.importVPC", VPC endpoints are only created in the VPC of the environment`),
+		},
+		"wrong number of subnet CIDRs": {
+			inContent: `
+name: test
+network:
+  vpc:
+    cidr: 10.0.0.0/16
+    publicSubnetCIDRs: [10.0.0.0/24]
+    privateSubnetCIDRs: [10.0.2.0/24, 10.0.3.0/24]
+`,
+			wantedErr: errors.New(`"network.vpc" requires exactly 2 public and 2 private subnet CIDRs`),
+		},
+		"certificate without a public load balancer": {
+			inContent: `
+name: test
+publicLoadBalancer: false
+certificateARN: arn:aws:acm:us-west-2:123456789012:certificate/abcd
+`,
+			wantedErr: errors.New(`"certificateARN" requires "publicLoadBalancer" to be true`),
+		},
+		"imported VPC without public subnets for the load balancer": {
+			inContent: `
+name: test
+publicLoadBalancer: true
+network:
+  importVPC:
+    id: vpc-1234
+    privateSubnetIDs: [subnet-3, subnet-4]
+`,
+			wantedErr: errors.New(`"network.importVPC.publicSubnetIDs" is required to create a public load balancer`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			m, err := UnmarshalEnvironment([]byte(tc.inContent))
+
+			// THEN
+			if tc.wantedErr != nil {
+				var unmarshalErr *ErrUnmarshalEnvironmentManifest
+				if errors.As(tc.wantedErr, &unmarshalErr) {
+					require.True(t, errors.Is(err, tc.wantedErr), "expected error %v, got %v", tc.wantedErr, err)
+				} else {
+					require.EqualError(t, err, tc.wantedErr.Error())
+				}
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedManifest, m)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package manifest
 
 import (
 	"fmt"
+	"strings"
 )
 
 // ErrInvalidAppManifestType occurs when a user requested a manifest template type that doesn't exist.
@@ -33,6 +34,35 @@ func (e *ErrInvalidPipelineManifestVersion) Is(target error) bool {
 	return ok && t.invalidVersion == e.invalidVersion
 }
 
+// ErrInvalidPipelineManifest occurs when the pipeline.yml file refers to resources that don't exist,
+// or contains invalid values.
+type ErrInvalidPipelineManifest struct {
+	Problems []*PipelineManifestProblem
+}
+
+func (e *ErrInvalidPipelineManifest) Error() string {
+	problems := make([]string, len(e.Problems))
+	for i, problem := range e.Problems {
+		problems[i] = "  " + problem.String()
+	}
+	return fmt.Sprintf("pipeline.yml has %d problem(s):\n%s", len(e.Problems), strings.Join(problems, "\n"))
+}
+
+// PipelineManifestProblem is an issue found in the pipeline.yml file, at a position of the file.
+type PipelineManifestProblem struct {
+	// Line and Column are zero if the position of the problem is unknown.
+	Line    int
+	Column  int
+	Message string
+}
+
+func (p *PipelineManifestProblem) String() string {
+	if p.Line == 0 {
+		return p.Message
+	}
+	return fmt.Sprintf("line %d, column %d: %s", p.Line, p.Column, p.Message)
+}
+
 // ErrUnknownProvider occurs CreateProvider() is called with configurations
 // that do not map to any supported provider.
 type ErrUnknownProvider struct {
@@ -78,3 +108,17 @@ func (e *ErrUnmarshalLBFargateManifest) Is(target error) bool {
 	_, ok := target.(*ErrUnmarshalLBFargateManifest)
 	return ok
 }
+
+// ErrUnmarshalEnvironmentManifest occurs if a byte stream cannot be unmarshalled into an environment manifest.
+type ErrUnmarshalEnvironmentManifest struct {
+	parent error
+}
+
+func (e *ErrUnmarshalEnvironmentManifest) Error() string {
+	return fmt.Sprintf("unmarshal to environment manifest: %v", e.parent)
+}
+
+func (e *ErrUnmarshalEnvironmentManifest) Is(target error) bool {
+	_, ok := target.(*ErrUnmarshalEnvironmentManifest)
+	return ok
+}
@@ -5,9 +5,12 @@ package manifest
 
 import (
 	"bytes"
+	"fmt"
+	"strings"
 	"text/template"
 
 	"github.com/aws/amazon-ecs-cli-v2/templates"
+	"github.com/aws/aws-sdk-go/aws/arn"
 )
 
 // LBFargateManifest holds the configuration to build a container image with an exposed port that receives
@@ -27,9 +30,11 @@ type ImageWithPort struct {
 
 // LBFargateConfig represents a load balanced web application with AWS Fargate as compute.
 type LBFargateConfig struct {
-	RoutingRule      `yaml:"http,flow"`
-	ContainersConfig `yaml:",inline"`
-	Scaling          *AutoScalingConfig `yaml:",flow"`
+	RoutingRule       `yaml:"http,flow"`
+	ContainersConfig  `yaml:",inline"`
+	Scaling           *AutoScalingConfig        `yaml:",flow"`
+	CapacityProviders *CapacityProviderStrategy `yaml:"capacityProviders,flow"`
+	Logging           *LoggingConfig            `yaml:",flow"`
 }
 
 // ContainersConfig represents the resource boundaries and environment variables for the containers in the service.
@@ -41,9 +46,12 @@ type ContainersConfig struct {
 	Secrets   map[string]string `yaml:"secrets"`
 }
 
-// RoutingRule holds the path to route requests to the service.
+// RoutingRule holds the path to route requests to the service,
+// and the custom domain names to route HTTPS requests from.
 type RoutingRule struct {
-	Path string `yaml:"path"`
+	Path       string   `yaml:"path"`
+	Aliases    []string `yaml:"alias"`
+	HostedZone string   `yaml:"hostedZone"`
 }
 
 // AutoScalingConfig is the configuration to scale the service with target tracking scaling policies.
@@ -55,6 +63,121 @@ type AutoScalingConfig struct {
 	TargetMemory float64 `yaml:"targetMemory"`
 }
 
+// CapacityProviderStrategy is the relative weight of the service's tasks to run on Fargate Spot and on-demand Fargate capacity.
+type CapacityProviderStrategy struct {
+	Spot     int `yaml:"spot"`
+	OnDemand int `yaml:"ondemand"`
+}
+
+// LoggingConfig is the configuration of the logs of the service's containers.
+type LoggingConfig struct {
+	Retention    int              `yaml:"retention"` // Number of days to keep the logs, forever if unset.
+	Subscription *LogSubscription `yaml:",flow"`
+	Router       *LogRouter       `yaml:",flow"`
+}
+
+// LogSubscription forwards the log events matching a filter pattern to a Kinesis stream,
+// a Kinesis Data Firehose delivery stream or a Lambda function.
+type LogSubscription struct {
+	Destination string `yaml:"destination"` // ARN of the destination.
+	Pattern     string `yaml:"pattern"`     // Forwards all the log events if empty.
+}
+
+// IsLambda returns true if the destination of the subscription is a Lambda function.
+// CloudWatch Logs invokes functions with a resource-based permission instead of assuming a role.
+func (s *LogSubscription) IsLambda() bool {
+	parsed, err := arn.Parse(s.Destination)
+	if err != nil {
+		return false
+	}
+	return parsed.Service == "lambda"
+}
+
+// LogRouter is a FireLens sidecar container that routes the logs of the application's container
+// instead of the awslogs driver.
+type LogRouter struct {
+	Image   string            `yaml:"image"`   // Defaults to the AWS for Fluent Bit image.
+	Options map[string]string `yaml:"options"` // Options of the awsfirelens log driver, like the Fluent Bit output plugin.
+}
+
+// Fluent Bit output plugins writing to Kinesis Data Firehose, Kinesis Data Streams and CloudWatch Logs.
+var (
+	firehoseLogRouterPlugins = []string{"firehose", "kinesis_firehose"}
+	kinesisLogRouterPlugins  = []string{"kinesis", "kinesis_streams"}
+)
+
+const cloudWatchLogsLogRouterPlugin = "cloudwatch"
+
+// LogRouterDestination is the AWS resource a log router writes the logs to.
+type LogRouterDestination struct {
+	Service  string   // Service namespace in the ARN of the resource.
+	Region   string   // Empty if the resource is in the region of the service.
+	Resource string   // Resource part of the ARN.
+	Actions  []string // Actions the log router needs to be allowed to write the logs.
+}
+
+// WritesToCloudWatchLogs returns true if the output plugin of the log router writes to CloudWatch Logs.
+// Unless they are set, the logs are then written to the log group and the log streams of the awslogs driver
+// so that they can still be read by application and task.
+func (r *LogRouter) WritesToCloudWatchLogs() bool {
+	return strings.ToLower(r.option("name")) == cloudWatchLogsLogRouterPlugin
+}
+
+// HasOption returns true if the Fluent Bit option is set.
+func (r *LogRouter) HasOption(key string) bool {
+	return r.option(key) != ""
+}
+
+// Destination returns the delivery stream, the Kinesis stream or the log group the log router writes to,
+// or nil if its output plugin doesn't write to any of them.
+// The Resource of a log group destination is empty if it's the log group of the application.
+func (r *LogRouter) Destination() *LogRouterDestination {
+	plugin := strings.ToLower(r.option("name"))
+	if r.WritesToCloudWatchLogs() {
+		dest := &LogRouterDestination{
+			Service: "logs",
+			Region:  r.option("region"),
+			Actions: []string{"logs:CreateLogStream", "logs:DescribeLogStreams", "logs:PutLogEvents"},
+		}
+		if group := r.option("log_group_name"); group != "" {
+			dest.Resource = fmt.Sprintf("log-group:%s:*", group)
+		}
+		return dest
+	}
+	if contains(firehoseLogRouterPlugins, plugin) && r.option("delivery_stream") != "" {
+		return &LogRouterDestination{
+			Service:  "firehose",
+			Region:   r.option("region"),
+			Resource: "deliverystream/" + r.option("delivery_stream"),
+			Actions:  []string{"firehose:PutRecordBatch"},
+		}
+	}
+	if contains(kinesisLogRouterPlugins, plugin) && r.option("stream") != "" {
+		return &LogRouterDestination{
+			Service:  "kinesis",
+			Region:   r.option("region"),
+			Resource: "stream/" + r.option("stream"),
+			Actions:  []string{"kinesis:PutRecords"},
+		}
+	}
+	return nil
+}
+
+// option returns the value of a Fluent Bit option, the keys of the options are case-insensitive.
+func (r *LogRouter) option(key string) string {
+	for k, v := range r.Options {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+	return ""
+}
+
+// Valid numbers of days to keep the log events of a log group in CloudWatch Logs.
+var logRetentionDays = []int{1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1827, 3653}
+
+const defaultLogRetentionDays = 30
+
 // LBFargateManifestProps contains properties for creating a new load balanced fargate application manifest.
 type LBFargateManifestProps struct {
 	*AppManifestProps
@@ -84,6 +207,9 @@ func NewLoadBalancedFargateManifest(input *LBFargateManifestProps) *LBFargateMan
 				Memory: 512,
 				Count:  1,
 			},
+			Logging: &LoggingConfig{
+				Retention: defaultLogRetentionDays,
+			},
 		},
 	}
 }
@@ -136,9 +262,31 @@ func (m *LBFargateManifest) EnvConf(envName string) LBFargateConfig {
 			TargetMemory: m.Scaling.TargetMemory,
 		}
 	}
+	var capacityProviders *CapacityProviderStrategy
+	if m.CapacityProviders != nil {
+		capacityProviders = &CapacityProviderStrategy{
+			Spot:     m.CapacityProviders.Spot,
+			OnDemand: m.CapacityProviders.OnDemand,
+		}
+	}
+	var logging *LoggingConfig
+	if m.Logging != nil {
+		logging = &LoggingConfig{
+			Retention:    m.Logging.Retention,
+			Subscription: m.Logging.Subscription,
+			Router:       m.Logging.Router,
+		}
+	}
+	var aliases []string
+	if m.Aliases != nil {
+		aliases = make([]string, len(m.Aliases))
+		copy(aliases, m.Aliases)
+	}
 	conf := LBFargateConfig{
 		RoutingRule: RoutingRule{
-			Path: m.Path,
+			Path:       m.Path,
+			Aliases:    aliases,
+			HostedZone: m.HostedZone,
 		},
 		ContainersConfig: ContainersConfig{
 			CPU:       m.CPU,
@@ -147,7 +295,9 @@ func (m *LBFargateManifest) EnvConf(envName string) LBFargateConfig {
 			Variables: envVars,
 			Secrets:   secrets,
 		},
-		Scaling: scaling,
+		Scaling:           scaling,
+		CapacityProviders: capacityProviders,
+		Logging:           logging,
 	}
 
 	// Override with fields set in the environment.
@@ -155,6 +305,12 @@ func (m *LBFargateManifest) EnvConf(envName string) LBFargateConfig {
 	if target.RoutingRule.Path != "" {
 		conf.RoutingRule.Path = target.RoutingRule.Path
 	}
+	if target.RoutingRule.Aliases != nil {
+		conf.RoutingRule.Aliases = target.RoutingRule.Aliases
+	}
+	if target.RoutingRule.HostedZone != "" {
+		conf.RoutingRule.HostedZone = target.RoutingRule.HostedZone
+	}
 	if target.CPU != 0 {
 		conf.CPU = target.CPU
 	}
@@ -187,9 +343,95 @@ func (m *LBFargateManifest) EnvConf(envName string) LBFargateConfig {
 			conf.Scaling.TargetMemory = target.Scaling.TargetMemory
 		}
 	}
+	if target.CapacityProviders != nil {
+		// The strategy is overridden as a whole since the weights are relative to each other.
+		conf.CapacityProviders = &CapacityProviderStrategy{
+			Spot:     target.CapacityProviders.Spot,
+			OnDemand: target.CapacityProviders.OnDemand,
+		}
+	}
+	if target.Logging != nil {
+		if conf.Logging == nil {
+			conf.Logging = &LoggingConfig{}
+		}
+		if target.Logging.Retention != 0 {
+			conf.Logging.Retention = target.Logging.Retention
+		}
+		// The subscription and the log router are overridden as a whole since their fields depend on each other.
+		if target.Logging.Subscription != nil {
+			conf.Logging.Subscription = target.Logging.Subscription
+		}
+		if target.Logging.Router != nil {
+			conf.Logging.Router = target.Logging.Router
+		}
+	}
 	return conf
 }
 
+func (m *LBFargateManifest) validate() error {
+	if err := m.CapacityProviders.validate("capacityProviders"); err != nil {
+		return err
+	}
+	if err := m.Logging.validate("logging"); err != nil {
+		return err
+	}
+	for envName, conf := range m.Environments {
+		if err := conf.CapacityProviders.validate(fmt.Sprintf("environments.%s.capacityProviders", envName)); err != nil {
+			return err
+		}
+		if err := conf.Logging.validate(fmt.Sprintf("environments.%s.logging", envName)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *CapacityProviderStrategy) validate(path string) error {
+	if s == nil {
+		return nil
+	}
+	if s.Spot < 0 {
+		return fmt.Errorf(`"%s.spot" must not be negative`, path)
+	}
+	if s.OnDemand < 0 {
+		return fmt.Errorf(`"%s.ondemand" must not be negative`, path)
+	}
+	if s.Spot == 0 && s.OnDemand == 0 {
+		return fmt.Errorf(`"%s.spot" or "%s.ondemand" must be greater than 0`, path, path)
+	}
+	return nil
+}
+
+func (c *LoggingConfig) validate(path string) error {
+	if c == nil {
+		return nil
+	}
+	if c.Retention != 0 && !containsInt(logRetentionDays, c.Retention) {
+		return fmt.Errorf(`"%s.retention" must be one of %v days`, path, logRetentionDays)
+	}
+	if c.Subscription != nil {
+		if c.Subscription.Destination == "" {
+			return fmt.Errorf(`"%s.subscription.destination" is required`, path)
+		}
+		if !arn.IsARN(c.Subscription.Destination) {
+			return fmt.Errorf(`"%s.subscription.destination" must be the ARN of a Kinesis stream, Firehose delivery stream or Lambda function`, path)
+		}
+	}
+	if c.Router != nil && len(c.Router.Options) == 0 {
+		return fmt.Errorf(`"%s.router.options" is required to tell the log router where to send the logs`, path)
+	}
+	return nil
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 // CFNTemplate serializes the manifest object into a CloudFormation template.
 func (m *LBFargateManifest) CFNTemplate() (string, error) {
 	return "", nil
@@ -30,6 +30,10 @@ image:
 http:
   # Requests to this path will be forwarded to your service.
   path: 'frontend'
+  # Custom domain names to serve HTTPS requests from, requires a certificate for them in the environment.
+  #alias: [www.example.com]
+  # ID of the Route53 hosted zone in the environment's account to create the alias records in.
+  #hostedZone: Z0123456789ABCDEFGHIJ
 
 # Number of CPU units for the task.
 cpu: 256
@@ -38,6 +42,20 @@ memory: 512
 # Number of tasks that should be running in your service.
 count: 1
 
+logging:
+  # Number of days to keep the logs of your application in CloudWatch Logs.
+  retention: 30
+  #subscription:                # Forwards the log events to a Kinesis stream, Firehose delivery stream or Lambda function.
+  #  destination: arn:aws:firehose:us-west-2:123456789012:deliverystream/my-stream
+  #  pattern: '{ $.level = "error" }'  # Forwards all the log events if empty.
+  # The "app logs" command only shows the routed logs if they are sent to CloudWatch Logs with the "cloudwatch" plugin.
+  #router:                      # Routes the logs with a FireLens Fluent Bit sidecar instead of the awslogs driver.
+  #  image: amazon/aws-for-fluent-bit:latest
+  #  options:                   # Options of the Fluent Bit output plugin.
+  #    Name: firehose
+  #    region: us-west-2
+  #    delivery_stream: my-stream
+
 # Optional fields for more advanced use-cases.
 #
 #variables:                    # Pass environment variables as key value pairs.
@@ -52,6 +70,10 @@ count: 1
 #
 #  # If the target value is crossed, ECS starts adding or removing tasks.
 #  targetCPU: 75.0               # Target average CPU utilization percentage.
+#
+#capacityProviders:            # Optional relative weights to spread your tasks across Fargate capacity providers.
+#  spot: 2                       # Tasks on Fargate Spot, requires "fargateSpot" in the environment's manifest.
+#  ondemand: 1                   # Tasks on on-demand Fargate.
 
 # You can override any of the values defined above by environment.
 #environments:
@@ -226,6 +248,126 @@ func TestLBFargateManifest_EnvConf(t *testing.T) {
 				},
 			},
 		},
+		"with logging override": {
+			inDefaultConfig: LBFargateConfig{
+				RoutingRule: RoutingRule{Path: "/awards/*"},
+				ContainersConfig: ContainersConfig{
+					CPU:    1024,
+					Memory: 1024,
+					Count:  1,
+				},
+				Logging: &LoggingConfig{
+					Retention: 30,
+					Subscription: &LogSubscription{
+						Destination: "arn:aws:lambda:us-west-2:123456789012:function:forward",
+					},
+				},
+			},
+			inEnvNameToQuery: "prod-iad",
+			inEnvOverride: map[string]LBFargateConfig{
+				"prod-iad": {
+					Logging: &LoggingConfig{
+						Retention: 365,
+						Router: &LogRouter{
+							Options: map[string]string{"Name": "firehose"},
+						},
+					},
+				},
+			},
+
+			wantedConfig: LBFargateConfig{
+				RoutingRule: RoutingRule{Path: "/awards/*"},
+				ContainersConfig: ContainersConfig{
+					CPU:       1024,
+					Memory:    1024,
+					Count:     1,
+					Variables: map[string]string{},
+					Secrets:   map[string]string{},
+				},
+				Logging: &LoggingConfig{
+					Retention: 365,
+					Subscription: &LogSubscription{
+						Destination: "arn:aws:lambda:us-west-2:123456789012:function:forward",
+					},
+					Router: &LogRouter{
+						Options: map[string]string{"Name": "firehose"},
+					},
+				},
+			},
+		},
+		"with capacity provider override": {
+			inDefaultConfig: LBFargateConfig{
+				RoutingRule: RoutingRule{Path: "/awards/*"},
+				ContainersConfig: ContainersConfig{
+					CPU:    1024,
+					Memory: 1024,
+					Count:  1,
+				},
+				CapacityProviders: &CapacityProviderStrategy{
+					Spot:     2,
+					OnDemand: 1,
+				},
+			},
+			inEnvNameToQuery: "prod-iad",
+			inEnvOverride: map[string]LBFargateConfig{
+				"prod-iad": {
+					CapacityProviders: &CapacityProviderStrategy{
+						OnDemand: 1,
+					},
+				},
+			},
+
+			wantedConfig: LBFargateConfig{
+				RoutingRule: RoutingRule{Path: "/awards/*"},
+				ContainersConfig: ContainersConfig{
+					CPU:       1024,
+					Memory:    1024,
+					Count:     1,
+					Variables: map[string]string{},
+					Secrets:   map[string]string{},
+				},
+				CapacityProviders: &CapacityProviderStrategy{
+					OnDemand: 1,
+				},
+			},
+		},
+		"with alias override": {
+			inDefaultConfig: LBFargateConfig{
+				RoutingRule: RoutingRule{
+					Path:       "/awards/*",
+					Aliases:    []string{"test.example.com"},
+					HostedZone: "Z1",
+				},
+				ContainersConfig: ContainersConfig{
+					CPU:    1024,
+					Memory: 1024,
+					Count:  1,
+				},
+			},
+			inEnvNameToQuery: "prod-iad",
+			inEnvOverride: map[string]LBFargateConfig{
+				"prod-iad": {
+					RoutingRule: RoutingRule{
+						Aliases: []string{"example.com", "www.example.com"},
+					},
+				},
+			},
+
+			wantedConfig: LBFargateConfig{
+				RoutingRule: RoutingRule{
+					Path:       "/awards/*",
+					Aliases:    []string{"example.com", "www.example.com"},
+					HostedZone: "Z1",
+				},
+				ContainersConfig: ContainersConfig{
+					CPU:       1024,
+					Memory:    1024,
+					Count:     1,
+					Variables: map[string]string{},
+					Secrets:   map[string]string{},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -245,3 +387,97 @@ func TestLBFargateManifest_EnvConf(t *testing.T) {
 		})
 	}
 }
+
+func TestLogRouter_Destination(t *testing.T) {
+	testCases := map[string]struct {
+		inOptions map[string]string
+
+		wanted *LogRouterDestination
+	}{
+		"firehose delivery stream": {
+			inOptions: map[string]string{"Name": "firehose", "delivery_stream": "my-stream"},
+
+			wanted: &LogRouterDestination{
+				Service:  "firehose",
+				Resource: "deliverystream/my-stream",
+				Actions:  []string{"firehose:PutRecordBatch"},
+			},
+		},
+		"kinesis stream in another region": {
+			inOptions: map[string]string{"name": "kinesis_streams", "stream": "my-stream", "region": "us-east-1"},
+
+			wanted: &LogRouterDestination{
+				Service:  "kinesis",
+				Region:   "us-east-1",
+				Resource: "stream/my-stream",
+				Actions:  []string{"kinesis:PutRecords"},
+			},
+		},
+		"log group of the application": {
+			inOptions: map[string]string{"Name": "cloudwatch"},
+
+			wanted: &LogRouterDestination{
+				Service: "logs",
+				Actions: []string{"logs:CreateLogStream", "logs:DescribeLogStreams", "logs:PutLogEvents"},
+			},
+		},
+		"other log group": {
+			inOptions: map[string]string{"Name": "cloudwatch", "log_group_name": "my-group"},
+
+			wanted: &LogRouterDestination{
+				Service:  "logs",
+				Resource: "log-group:my-group:*",
+				Actions:  []string{"logs:CreateLogStream", "logs:DescribeLogStreams", "logs:PutLogEvents"},
+			},
+		},
+		"other output plugin": {
+			inOptions: map[string]string{"Name": "datadog"},
+		},
+		"firehose without a delivery stream": {
+			inOptions: map[string]string{"Name": "kinesis_firehose"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := &LogRouter{Options: tc.inOptions}
+
+			require.Equal(t, tc.wanted, r.Destination())
+		})
+	}
+}
+
+func TestLogSubscription_IsLambda(t *testing.T) {
+	testCases := map[string]struct {
+		inDestination string
+
+		wanted bool
+	}{
+		"lambda function": {
+			inDestination: "arn:aws:lambda:us-west-2:123456789012:function:forward",
+			wanted:        true,
+		},
+		"lambda function in aws-cn": {
+			inDestination: "arn:aws-cn:lambda:cn-north-1:123456789012:function:forward",
+			wanted:        true,
+		},
+		"lambda function in aws-us-gov": {
+			inDestination: "arn:aws-us-gov:lambda:us-gov-west-1:123456789012:function:forward",
+			wanted:        true,
+		},
+		"delivery stream": {
+			inDestination: "arn:aws:firehose:us-west-2:123456789012:deliverystream/my-stream",
+		},
+		"not an ARN": {
+			inDestination: "forward",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			s := &LogSubscription{Destination: tc.inDestination}
+
+			require.Equal(t, tc.wanted, s.IsLambda())
+		})
+	}
+}
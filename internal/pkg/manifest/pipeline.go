@@ -7,6 +7,8 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"text/template"
 
 	"github.com/fatih/structs"
@@ -16,8 +18,33 @@ import (
 )
 
 const (
-	GithubProviderName    = "GitHub"
-	GithubSecretIdKeyName = "access_token_secret"
+	GithubProviderName     = "GitHub"
+	GithubSecretIdKeyName  = "access_token_secret"
+	CodeCommitProviderName = "CodeCommit"
+	ConnectionProviderName = "CodeStarSourceConnection"
+)
+
+// Types of the third-party providers that can be linked to a pipeline through an AWS CodeStar connection.
+const (
+	BitbucketConnectionType        = "Bitbucket"
+	GitHubConnectionType           = "GitHub"
+	GitHubEnterpriseConnectionType = "GitHubEnterpriseServer"
+)
+
+// Compute types of the CodeBuild project that builds the applications of a pipeline.
+const (
+	BuildComputeTypeSmall   = "BUILD_GENERAL1_SMALL"
+	BuildComputeTypeMedium  = "BUILD_GENERAL1_MEDIUM"
+	BuildComputeTypeLarge   = "BUILD_GENERAL1_LARGE"
+	BuildComputeType2XLarge = "BUILD_GENERAL1_2XLARGE"
+)
+
+// Events of a pipeline that can be sent as notifications.
+const (
+	NotificationEventStageFailed       = "stage_failed"
+	NotificationEventApprovalNeeded    = "approval_needed"
+	NotificationEventPipelineFailed    = "pipeline_failed"
+	NotificationEventPipelineSucceeded = "pipeline_succeeded"
 )
 
 // Provider defines a source of the artifacts
@@ -53,7 +80,60 @@ type GitHubProperties struct {
 	// An example for OwnerAndRepository would be: "aws/amazon-ecs-cli-v2"
 	OwnerAndRepository    string `structs:"repository" yaml:"repository"`
 	Branch                string `structs:"branch" yaml:"branch"`
-	GithubSecretIdKeyName string `structs:"access_token_secret" yaml:"access_token_secret` // TODO fix naming
+	GithubSecretIdKeyName string `structs:"access_token_secret" yaml:"access_token_secret"` // TODO fix naming
+}
+
+type codecommitProvider struct {
+	properties *CodeCommitProperties
+}
+
+func (p *codecommitProvider) Name() string {
+	return CodeCommitProviderName
+}
+
+func (p *codecommitProvider) String() string {
+	return CodeCommitProviderName
+}
+
+func (p *codecommitProvider) Properties() map[string]interface{} {
+	return structs.Map(p.properties)
+}
+
+// CodeCommitProperties contain information for configuring a CodeCommit
+// source provider.
+type CodeCommitProperties struct {
+	// An example for Repository would be: "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/wings"
+	Repository string `structs:"repository" yaml:"repository"`
+	Branch     string `structs:"branch" yaml:"branch"`
+}
+
+type connectionProvider struct {
+	properties *ConnectionProperties
+}
+
+func (p *connectionProvider) Name() string {
+	return ConnectionProviderName
+}
+
+func (p *connectionProvider) String() string {
+	return p.properties.ProviderType
+}
+
+func (p *connectionProvider) Properties() map[string]interface{} {
+	return structs.Map(p.properties)
+}
+
+// ConnectionProperties contain information for configuring a Bitbucket, GitHub or
+// GitHub Enterprise Server source provider authorized through an AWS CodeStar connection.
+// If ConnectionARN is empty, the connection is created by the pipeline stack.
+type ConnectionProperties struct {
+	// An example for Repository would be: "https://bitbucket.org/myCompany/myRepo"
+	Repository    string `structs:"repository" yaml:"repository"`
+	Branch        string `structs:"branch" yaml:"branch"`
+	ProviderType  string `structs:"provider_type" yaml:"provider_type"`
+	ConnectionARN string `structs:"connection_arn,omitempty" yaml:"connection_arn,omitempty"`
+	// HostARN is the CodeStar host of the GitHub Enterprise Server, required to create its connection.
+	HostARN string `structs:"host_arn,omitempty" yaml:"host_arn,omitempty"`
 }
 
 // NewProvider creates a source provider based on the type of
@@ -64,6 +144,14 @@ func NewProvider(configs interface{}) (Provider, error) {
 		return &githubProvider{
 			properties: props,
 		}, nil
+	case *CodeCommitProperties:
+		return &codecommitProvider{
+			properties: props,
+		}, nil
+	case *ConnectionProperties:
+		return &connectionProvider{
+			properties: props,
+		}, nil
 	default:
 		return nil, &ErrUnknownProvider{unknownProviderProperties: props}
 	}
@@ -85,7 +173,48 @@ type PipelineManifest struct {
 	Name    string                     `yaml:"name"`
 	Version PipelineSchemaMajorVersion `yaml:"version"`
 	Source  *Source                    `yaml:"source"`
-	Stages  []PipelineStage            `yaml:"stages"`
+	// Apps are the applications built and deployed by the pipeline.
+	// If it is empty, all the applications in the workspace are.
+	Apps   []string        `yaml:"apps,omitempty"`
+	Stages []PipelineStage `yaml:"stages"`
+	// Build overrides the settings of the CodeBuild project that builds the applications.
+	Build *PipelineBuild `yaml:"build,omitempty"`
+	// Notifications configures where events of the pipeline are sent to.
+	Notifications *PipelineNotifications `yaml:"notifications,omitempty"`
+
+	// node is the parsed pipeline.yml document, used to report the positions of problems.
+	node *yaml.Node
+}
+
+// PipelineBuild defines the CodeBuild project that builds the applications of a pipeline.
+type PipelineBuild struct {
+	// Image is the CodeBuild image, for example "aws/codebuild/amazonlinux2-x86_64-standard:3.0".
+	Image       string `yaml:"image,omitempty"`
+	ComputeType string `yaml:"compute_type,omitempty"`
+	// Privileged runs the build in privileged mode, which is required to build Docker images. Defaults to true.
+	Privileged *bool               `yaml:"privileged,omitempty"`
+	Cache      *PipelineBuildCache `yaml:"cache,omitempty"`
+}
+
+// PipelineBuildCache defines what is kept between the builds of a pipeline.
+type PipelineBuildCache struct {
+	// DockerLayers keeps the Docker layers on the build host. Defaults to true, unless S3 is enabled.
+	DockerLayers *bool `yaml:"docker_layers,omitempty"`
+	// S3 stores the paths listed under "cache: paths" in the buildspec of the pipeline, such as
+	// dependency directories, in the artifact bucket of the project.
+	S3 *bool `yaml:"s3,omitempty"`
+}
+
+// PipelineNotifications defines the targets notified of the events of a pipeline.
+type PipelineNotifications struct {
+	// TopicARN is an existing SNS topic that notifications are published to, for example
+	// a topic that AWS Chatbot is subscribed to.
+	TopicARN string `yaml:"topic_arn,omitempty"`
+	// Emails are subscribed to a topic created along with the pipeline.
+	Emails []string `yaml:"emails,omitempty"`
+	// Events that trigger a notification.
+	// If it is empty, failed stages and manual approvals trigger a notification.
+	Events []string `yaml:"events,omitempty"`
 }
 
 // Source defines the source of the artifacts to be built and deployed.
@@ -96,7 +225,14 @@ type Source struct {
 
 // PipelineStage represents a stage in the pipeline manifest
 type PipelineStage struct {
-	Name string `yaml:"name`
+	Name string `yaml:"name"`
+	// RequiresApproval adds a manual approval action before deploying to the stage.
+	// If it is not set, only production environments require an approval.
+	RequiresApproval *bool `yaml:"requires_approval,omitempty"`
+	// TestCommands are run after the applications are deployed to the stage.
+	TestCommands []string `yaml:"test_commands,omitempty"`
+	// DeployOrder lists applications that are deployed one after the other, before the rest of the applications.
+	DeployOrder []string `yaml:"deploy_order,omitempty"`
 }
 
 // CreatePipeline returns a pipeline manifest object.
@@ -142,25 +278,42 @@ func (m *PipelineManifest) MarshalBinary() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// ParsePipeline deserializes the YAML input stream into a pipeline manifest object
+// without checking its schema version, so that Validate can report it along with
+// the other problems of the file.
+func ParsePipeline(in []byte) (*PipelineManifest, error) {
+	pm := PipelineManifest{}
+	if err := yaml.Unmarshal(in, &pm); err != nil {
+		return nil, err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(in, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) > 0 {
+		pm.node = doc.Content[0]
+	}
+	return &pm, nil
+}
+
 // UnmarshalPipeline deserializes the YAML input stream into a pipeline
 // manifest object. It returns an error if any issue occurs during
 // deserialization or the YAML input contains invalid fields.
 func UnmarshalPipeline(in []byte) (*PipelineManifest, error) {
-	pm := PipelineManifest{}
-	err := yaml.Unmarshal(in, &pm)
+	pm, err := ParsePipeline(in)
 	if err != nil {
 		return nil, err
 	}
 
 	var version PipelineSchemaMajorVersion
-	if version, err = validateVersion(&pm); err != nil {
+	if version, err = validateVersion(pm); err != nil {
 		return nil, err
 	}
 
 	// TODO: #221 Do more validations
 	switch version {
 	case Ver1:
-		return &pm, nil
+		return pm, nil
 	}
 	// we should never reach here, this is just to make the compiler happy
 	return nil, errors.New("unexpected error occurs while unmarshalling pipeline.yml")
@@ -177,3 +330,164 @@ func validateVersion(pm *PipelineManifest) (PipelineSchemaMajorVersion, error) {
 			}
 	}
 }
+
+// PipelineValidationStore provides the environments of the project and the applications of the workspace
+// that a pipeline manifest refers to.
+type PipelineValidationStore interface {
+	EnvironmentNames() ([]string, error)
+	LocalAppNames() ([]string, error)
+}
+
+var pipelineProviders = []string{GithubProviderName, CodeCommitProviderName, ConnectionProviderName}
+
+var buildComputeTypes = []string{
+	BuildComputeTypeSmall,
+	BuildComputeTypeMedium,
+	BuildComputeTypeLarge,
+	BuildComputeType2XLarge,
+}
+
+var notificationEvents = []string{
+	NotificationEventStageFailed,
+	NotificationEventApprovalNeeded,
+	NotificationEventPipelineFailed,
+	NotificationEventPipelineSucceeded,
+}
+
+// Validate checks the pipeline manifest against the environments of the project and the applications
+// of the workspace. It returns an ErrInvalidPipelineManifest listing all the problems found.
+func (m *PipelineManifest) Validate(store PipelineValidationStore) error {
+	envNames, err := store.EnvironmentNames()
+	if err != nil {
+		return fmt.Errorf("list environments: %w", err)
+	}
+	appNames, err := store.LocalAppNames()
+	if err != nil {
+		return fmt.Errorf("list applications: %w", err)
+	}
+
+	var problems []*PipelineManifestProblem
+	addProblem := func(msg string, path ...string) {
+		line, column := m.position(path...)
+		problems = append(problems, &PipelineManifestProblem{
+			Line:    line,
+			Column:  column,
+			Message: msg,
+		})
+	}
+
+	if _, err := validateVersion(m); err != nil {
+		addProblem(fmt.Sprintf("unsupported schema version %d, must be %d", m.Version, Ver1), "version")
+	}
+
+	switch {
+	case m.Source == nil:
+		addProblem("missing source")
+	case !contains(pipelineProviders, m.Source.ProviderName):
+		addProblem(fmt.Sprintf("unknown source provider %q, must be one of %s",
+			m.Source.ProviderName, strings.Join(pipelineProviders, ", ")), "source", "provider")
+	}
+
+	if len(m.Stages) == 0 {
+		addProblem("missing stages, the pipeline must deploy to at least one environment", "stages")
+	}
+	seenStages := make(map[string]bool)
+	for i, stage := range m.Stages {
+		index := strconv.Itoa(i)
+		switch {
+		case stage.Name == "":
+			addProblem(fmt.Sprintf("missing environment name of stage %d", i+1), "stages", index)
+		case seenStages[stage.Name]:
+			addProblem(fmt.Sprintf("environment %s is deployed to by more than one stage", stage.Name), "stages", index, "name")
+		case !contains(envNames, stage.Name):
+			addProblem(fmt.Sprintf("environment %s does not exist in the project", stage.Name), "stages", index, "name")
+		}
+		seenStages[stage.Name] = true
+		for j, app := range stage.DeployOrder {
+			if !contains(appNames, app) {
+				addProblem(fmt.Sprintf("application %s in the deploy order of stage %s does not exist in your workspace", app, stage.Name),
+					"stages", index, "deploy_order", strconv.Itoa(j))
+			}
+		}
+	}
+
+	if len(m.Apps) == 0 && len(appNames) == 0 {
+		addProblem("no applications to deploy, there are no applications in your workspace")
+	}
+	for i, app := range m.Apps {
+		if !contains(appNames, app) {
+			addProblem(fmt.Sprintf("application %s does not exist in your workspace", app), "apps", strconv.Itoa(i))
+		}
+	}
+
+	if m.Build != nil {
+		if m.Build.ComputeType != "" && !contains(buildComputeTypes, m.Build.ComputeType) {
+			addProblem(fmt.Sprintf("unknown build compute type %q, must be one of %s",
+				m.Build.ComputeType, strings.Join(buildComputeTypes, ", ")), "build", "compute_type")
+		}
+		if cache := m.Build.Cache; cache != nil && cache.S3 != nil && *cache.S3 && cache.DockerLayers != nil && *cache.DockerLayers {
+			addProblem("the Docker layers can't be cached along with the buildspec cache paths, which are stored in S3", "build", "cache", "docker_layers")
+		}
+	}
+
+	if m.Notifications != nil {
+		if m.Notifications.TopicARN == "" && len(m.Notifications.Emails) == 0 {
+			addProblem("missing notification targets, must have a topic_arn or emails", "notifications")
+		}
+		for i, event := range m.Notifications.Events {
+			if !contains(notificationEvents, event) {
+				addProblem(fmt.Sprintf("unknown notification event %q, must be one of %s",
+					event, strings.Join(notificationEvents, ", ")), "notifications", "events", strconv.Itoa(i))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ErrInvalidPipelineManifest{Problems: problems}
+	}
+	return nil
+}
+
+// position returns the line and column of the value at the path in pipeline.yml, such as "stages", "0", "name".
+// If the path can't be found, it returns the position of its closest parent.
+// It returns zeros if the manifest was not unmarshalled from a file.
+func (m *PipelineManifest) position(path ...string) (line, column int) {
+	node := m.node
+	if node == nil {
+		return 0, 0
+	}
+	for _, key := range path {
+		next := childNode(node, key)
+		if next == nil {
+			break
+		}
+		node = next
+	}
+	return node.Line, node.Column
+}
+
+func childNode(node *yaml.Node, key string) *yaml.Node {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == key {
+				return node.Content[i+1]
+			}
+		}
+	case yaml.SequenceNode:
+		i, err := strconv.Atoi(key)
+		if err == nil && i < len(node.Content) {
+			return node.Content[i]
+		}
+	}
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
 )
 
@@ -23,6 +24,19 @@ func TestNewProvider(t *testing.T) {
 				Branch:             "master",
 			},
 		},
+		"successfully create CodeCommit provider": {
+			providerConfig: &CodeCommitProperties{
+				Repository: "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/wings",
+				Branch:     "master",
+			},
+		},
+		"successfully create connection provider": {
+			providerConfig: &ConnectionProperties{
+				Repository:   "https://bitbucket.org/aws/wings",
+				Branch:       "master",
+				ProviderType: BitbucketConnectionType,
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -70,7 +84,7 @@ func TestCreatePipeline(t *testing.T) {
 				return p
 			}(),
 			inputStages:    []string{"chicken", "wings"},
-			expectedStages: []PipelineStage{{"chicken"}, {"wings"}},
+			expectedStages: []PipelineStage{{Name: "chicken"}, {Name: "wings"}},
 		},
 	}
 
@@ -109,13 +123,57 @@ source:
     branch: master
     repository: aws/amazon-ecs-cli-v2
 
+# Optional: the applications built and deployed by the pipeline.
+# Defaults to all the applications in the workspace.
+# apps: [frontend, backend]
+
 # The deployment section defines the order the pipeline will deploy
 # to your environments.
 stages:
     - # The name of the environment to deploy to.
       name: chicken
+      # Optional: require a manual approval before deploying to the environment.
+      # Defaults to true for production environments.
+      # requires_approval: true
+      # Optional: commands run after the deployment to test the environment.
+      # The URL of each application is available as an environment variable, for example $FRONTEND_URL.
+      # test_commands:
+      #   - curl -f $FRONTEND_URL
+      # Optional: applications deployed one after the other, before the rest of the applications.
+      # deploy_order: [backend]
     - # The name of the environment to deploy to.
       name: wings
+      # Optional: require a manual approval before deploying to the environment.
+      # Defaults to true for production environments.
+      # requires_approval: true
+      # Optional: commands run after the deployment to test the environment.
+      # The URL of each application is available as an environment variable, for example $FRONTEND_URL.
+      # test_commands:
+      #   - curl -f $FRONTEND_URL
+      # Optional: applications deployed one after the other, before the rest of the applications.
+      # deploy_order: [backend]
+
+# Optional: override the settings of the CodeBuild project that builds your applications.
+# build:
+#   image: aws/codebuild/amazonlinux2-x86_64-standard:1.0
+#   # One of BUILD_GENERAL1_SMALL, BUILD_GENERAL1_MEDIUM, BUILD_GENERAL1_LARGE or BUILD_GENERAL1_2XLARGE.
+#   compute_type: BUILD_GENERAL1_SMALL
+#   # Privileged mode is required to build Docker images.
+#   privileged: true
+#   cache:
+#     # Keep the Docker layers on the build host, this can't be combined with s3.
+#     docker_layers: true
+#     # Store the directories listed under "cache: paths" in your buildspec in the
+#     # artifact bucket of your project between builds.
+#     # s3: true
+
+# Optional: send notifications of the pipeline events to an SNS topic or email addresses.
+# notifications:
+#   # The existing topic must allow codestar-notifications.amazonaws.com to publish to it.
+#   topic_arn: arn:aws:sns:us-west-2:123456789012:pipeline-alerts
+#   emails: [team@example.com]
+#   # Defaults to [stage_failed, approval_needed]. Can also include pipeline_failed and pipeline_succeeded.
+#   events: [stage_failed, approval_needed]
 `
 	// reset the global map before each test case is run
 	provider, err := NewProvider(&GitHubProperties{
@@ -176,11 +234,27 @@ source:
     access_token_secret: "github-token-badgoose-backend"
     branch: master
 
+apps: [frontend, backend]
+
 stages:
     -
       name: chicken
+      test_commands:
+        - make test
+        - echo "$FRONTEND_URL"
     -
       name: wings
+      requires_approval: false
+      deploy_order: [backend, frontend]
+
+build:
+  compute_type: BUILD_GENERAL1_LARGE
+  cache:
+    s3: true
+
+notifications:
+  emails: [team@example.com]
+  events: [stage_failed, pipeline_succeeded]
 `,
 			expectedManifest: &PipelineManifest{
 				Name:    "pipepiper",
@@ -193,9 +267,28 @@ stages:
 						"branch":              "master",
 					},
 				},
+				Apps: []string{"frontend", "backend"},
 				Stages: []PipelineStage{
-					PipelineStage{"chicken"},
-					PipelineStage{"wings"}},
+					{
+						Name:         "chicken",
+						TestCommands: []string{"make test", `echo "$FRONTEND_URL"`},
+					},
+					{
+						Name:             "wings",
+						RequiresApproval: aws.Bool(false),
+						DeployOrder:      []string{"backend", "frontend"},
+					},
+				},
+				Build: &PipelineBuild{
+					ComputeType: "BUILD_GENERAL1_LARGE",
+					Cache: &PipelineBuildCache{
+						S3: aws.Bool(true),
+					},
+				},
+				Notifications: &PipelineNotifications{
+					Emails: []string{"team@example.com"},
+					Events: []string{"stage_failed", "pipeline_succeeded"},
+				},
 			},
 		},
 	}
@@ -207,8 +300,170 @@ stages:
 			if tc.expectedErr != nil {
 				require.EqualError(t, err, tc.expectedErr.Error())
 			} else {
+				// The positions of the fields are covered by TestPipelineManifest_Validate.
+				m.node = nil
 				require.Equal(t, tc.expectedManifest, m)
 			}
 		})
 	}
 }
+
+type mockPipelineValidationStore struct {
+	envNames []string
+	appNames []string
+	err      error
+}
+
+func (s *mockPipelineValidationStore) EnvironmentNames() ([]string, error) {
+	return s.envNames, s.err
+}
+
+func (s *mockPipelineValidationStore) LocalAppNames() ([]string, error) {
+	return s.appNames, s.err
+}
+
+func TestPipelineManifest_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+		inStore   *mockPipelineValidationStore
+
+		wantedErr string
+	}{
+		"returns an error if fail to list the environments": {
+			inContent: `
+name: pipepiper
+version: 1
+`,
+			inStore: &mockPipelineValidationStore{
+				err: errors.New("some error"),
+			},
+			wantedErr: "list environments: some error",
+		},
+		"valid manifest": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: CodeCommit
+  properties:
+    repository: wings
+    branch: main
+
+apps: [frontend]
+
+stages:
+    - name: test
+      deploy_order: [frontend]
+    - name: prod
+
+notifications:
+  topic_arn: arn:aws:sns:us-west-2:1234:alerts
+  events: [approval_needed]
+`,
+			inStore: &mockPipelineValidationStore{
+				envNames: []string{"test", "prod"},
+				appNames: []string{"frontend", "backend"},
+			},
+		},
+		"reports all the problems with their positions": {
+			inContent: `name: pipepiper
+version: 1
+source:
+  provider: SVN
+apps: [frontend, api]
+stages:
+    - name: test
+      deploy_order: [worker]
+    - name: test
+    - name: staging
+    - requires_approval: true
+build:
+  compute_type: BUILD_GENERAL1_HUGE
+  cache:
+    docker_layers: true
+    s3: true
+notifications:
+  events: [build_started]
+`,
+			inStore: &mockPipelineValidationStore{
+				envNames: []string{"test", "prod"},
+				appNames: []string{"frontend"},
+			},
+			wantedErr: `pipeline.yml has 10 problem(s):
+  line 4, column 13: unknown source provider "SVN", must be one of GitHub, CodeCommit, CodeStarSourceConnection
+  line 8, column 22: application worker in the deploy order of stage test does not exist in your workspace
+  line 9, column 13: environment test is deployed to by more than one stage
+  line 10, column 13: environment staging does not exist in the project
+  line 11, column 7: missing environment name of stage 4
+  line 5, column 18: application api does not exist in your workspace
+  line 13, column 17: unknown build compute type "BUILD_GENERAL1_HUGE", must be one of BUILD_GENERAL1_SMALL, BUILD_GENERAL1_MEDIUM, BUILD_GENERAL1_LARGE, BUILD_GENERAL1_2XLARGE
+  line 15, column 20: the Docker layers can't be cached along with the buildspec cache paths, which are stored in S3
+  line 18, column 3: missing notification targets, must have a topic_arn or emails
+  line 18, column 12: unknown notification event "build_started", must be one of stage_failed, approval_needed, pipeline_failed, pipeline_succeeded`,
+		},
+		"reports an unsupported version along with the other problems": {
+			inContent: `name: pipepiper
+version: 2
+source:
+  provider: GitHub
+apps: [frontend]
+stages:
+    - name: staging
+`,
+			inStore: &mockPipelineValidationStore{
+				envNames: []string{"test"},
+				appNames: []string{"frontend"},
+			},
+			wantedErr: `pipeline.yml has 2 problem(s):
+  line 2, column 10: unsupported schema version 2, must be 1
+  line 7, column 13: environment staging does not exist in the project`,
+		},
+		"reports missing stages and applications": {
+			inContent: `name: pipepiper
+version: 1
+source:
+  provider: GitHub
+`,
+			inStore: &mockPipelineValidationStore{},
+			wantedErr: `pipeline.yml has 2 problem(s):
+  line 1, column 1: missing stages, the pipeline must deploy to at least one environment
+  line 1, column 1: no applications to deploy, there are no applications in your workspace`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m, err := ParsePipeline([]byte(tc.inContent))
+			require.NoError(t, err)
+
+			err = m.Validate(tc.inStore)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPipelineManifest_ValidateWithoutFile(t *testing.T) {
+	m := &PipelineManifest{
+		Name:    "pipepiper",
+		Version: PipelineSchemaMajorVersion(2),
+		Source: &Source{
+			ProviderName: GithubProviderName,
+		},
+		Stages: []PipelineStage{{Name: "test"}},
+	}
+
+	err := m.Validate(&mockPipelineValidationStore{
+		envNames: []string{"test"},
+		appNames: []string{"frontend"},
+	})
+
+	var invalid *ErrInvalidPipelineManifest
+	require.True(t, errors.As(err, &invalid))
+	require.EqualError(t, err, "pipeline.yml has 1 problem(s):\n  unsupported schema version 2, must be 1")
+}
@@ -25,30 +25,8 @@ func (s *Store) CreateProject(project *archer.Project) error {
 		return fmt.Errorf("serializing project %s: %w", project.Name, err)
 	}
 
-	if project.Domain != "" {
-		domainExist := false
-		in := &route53API.ListHostedZonesByNameInput{DNSName: aws.String(project.Domain)}
-		resp, err := s.route53Svc.ListHostedZonesByName(in)
-		if err != nil {
-			return fmt.Errorf("list hosted zone for %s: %w", project.Domain, err)
-		}
-		for {
-			if route53.HostedZoneExists(resp.HostedZones, project.Domain) {
-				domainExist = true
-				break
-			}
-			if !aws.BoolValue(resp.IsTruncated) {
-				break
-			}
-			in = &route53API.ListHostedZonesByNameInput{DNSName: resp.NextDNSName, HostedZoneId: resp.NextHostedZoneId}
-			resp, err = s.route53Svc.ListHostedZonesByName(in)
-			if err != nil {
-				return fmt.Errorf("list hosted zone for %s: %w", project.Domain, err)
-			}
-		}
-		if !domainExist {
-			return fmt.Errorf("no hosted zone found for %s", project.Domain)
-		}
+	if err := s.ValidateDomain(project.Domain); err != nil {
+		return err
 	}
 
 	_, err = s.ssmClient.PutParameter(&ssm.PutParameterInput{
@@ -72,6 +50,36 @@ func (s *Store) CreateProject(project *archer.Project) error {
 	return nil
 }
 
+// UpdateProject overwrites an existing project in SSM with the new values of its fields.
+// If the project has a domain, it validates that a hosted zone exists for the domain.
+func (s *Store) UpdateProject(project *archer.Project) error {
+	if _, err := s.GetProject(project.Name); err != nil {
+		return err
+	}
+	projectPath := fmt.Sprintf(fmtProjectPath, project.Name)
+	project.Version = schemaVersion
+
+	data, err := marshal(project)
+	if err != nil {
+		return fmt.Errorf("serializing project %s: %w", project.Name, err)
+	}
+	if err := s.ValidateDomain(project.Domain); err != nil {
+		return err
+	}
+
+	_, err = s.ssmClient.PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(projectPath),
+		Description: aws.String("An ECS-CLI Project"),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(data),
+		Overwrite:   aws.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf("update project %s: %w", project.Name, err)
+	}
+	return nil
+}
+
 // GetProject fetches a project by name. If it can't be found, return a ErrNoSuchProject
 func (s *Store) GetProject(projectName string) (*archer.Project, error) {
 	projectPath := fmt.Sprintf(fmtProjectPath, projectName)
@@ -142,3 +150,29 @@ func (s *Store) DeleteProject(name string) error {
 
 	return nil
 }
+
+// ValidateDomain returns an error if the domain is not empty and there is no hosted zone for it.
+func (s *Store) ValidateDomain(domain string) error {
+	if domain == "" {
+		return nil
+	}
+	in := &route53API.ListHostedZonesByNameInput{DNSName: aws.String(domain)}
+	resp, err := s.route53Svc.ListHostedZonesByName(in)
+	if err != nil {
+		return fmt.Errorf("list hosted zone for %s: %w", domain, err)
+	}
+	for {
+		if route53.HostedZoneExists(resp.HostedZones, domain) {
+			return nil
+		}
+		if !aws.BoolValue(resp.IsTruncated) {
+			break
+		}
+		in = &route53API.ListHostedZonesByNameInput{DNSName: resp.NextDNSName, HostedZoneId: resp.NextHostedZoneId}
+		resp, err = s.route53Svc.ListHostedZonesByName(in)
+		if err != nil {
+			return fmt.Errorf("list hosted zone for %s: %w", domain, err)
+		}
+	}
+	return fmt.Errorf("no hosted zone found for %s", domain)
+}
@@ -407,6 +407,104 @@ func TestStore_CreateProject(t *testing.T) {
 	}
 }
 
+func TestStore_UpdateProject(t *testing.T) {
+	testCases := map[string]struct {
+		inProject *archer.Project
+
+		mockRoute53      func(m *awsmock.MockLister)
+		mockPutParameter func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+		wantedErr        error
+	}{
+		"overwrites the project with its new domain": {
+			inProject: &archer.Project{Name: "phonetool", AccountID: "1234", Domain: "phonetool.com"},
+			mockRoute53: func(m *awsmock.MockLister) {
+				m.EXPECT().ListHostedZonesByName(&route53.ListHostedZonesByNameInput{DNSName: aws.String("phonetool.com")}).Return(&route53.ListHostedZonesByNameOutput{
+					HostedZones: []*route53.HostedZone{
+						{
+							Name: aws.String("phonetool.com."),
+						},
+					},
+				}, nil).Times(1)
+			},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, fmt.Sprintf(fmtProjectPath, "phonetool"), *param.Name)
+				require.Equal(t, fmt.Sprintf(`{"name":"phonetool","account":"1234","domain":"phonetool.com","version":"%s"}`, schemaVersion), *param.Value)
+				require.True(t, *param.Overwrite)
+				return &ssm.PutParameterOutput{
+					Version: aws.Int64(2),
+				}, nil
+			},
+		},
+		"overwrites the project without a domain": {
+			inProject:   &archer.Project{Name: "phonetool", AccountID: "1234"},
+			mockRoute53: func(m *awsmock.MockLister) {},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, fmt.Sprintf(`{"name":"phonetool","account":"1234","domain":"","version":"%s"}`, schemaVersion), *param.Value)
+				return &ssm.PutParameterOutput{
+					Version: aws.Int64(2),
+				}, nil
+			},
+		},
+		"with no domain name found error": {
+			inProject: &archer.Project{Name: "phonetool", AccountID: "1234", Domain: "phonetool.com"},
+			mockRoute53: func(m *awsmock.MockLister) {
+				m.EXPECT().ListHostedZonesByName(&route53.ListHostedZonesByNameInput{DNSName: aws.String("phonetool.com")}).Return(&route53.ListHostedZonesByNameOutput{
+					HostedZones: []*route53.HostedZone{
+						{
+							Name: aws.String("examples.com."),
+						},
+					},
+				}, nil).Times(1)
+			},
+			wantedErr: errors.New("no hosted zone found for phonetool.com"),
+		},
+		"with SSM error": {
+			inProject:   &archer.Project{Name: "phonetool", AccountID: "1234"},
+			mockRoute53: func(m *awsmock.MockLister) {},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, fmt.Errorf("broken")
+			},
+			wantedErr: fmt.Errorf("update project phonetool: broken"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRoute53 := awsmock.NewMockLister(ctrl)
+			tc.mockRoute53(mockRoute53)
+			store := &Store{
+				ssmClient: &mockSSM{
+					t: t,
+					mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+						require.Equal(t, fmt.Sprintf(fmtProjectPath, "phonetool"), *param.Name)
+						return &ssm.GetParameterOutput{
+							Parameter: &ssm.Parameter{
+								Value: aws.String(`{"name":"phonetool","account":"1234","domain":"","version":"1.0"}`),
+							},
+						}, nil
+					},
+					mockPutParameter: tc.mockPutParameter,
+				},
+				route53Svc: mockRoute53,
+			}
+
+			// WHEN
+			err := store.UpdateProject(tc.inProject)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestDeleteProject(t *testing.T) {
 	mockProjectName := "mockProjectName"
 	mockError := errors.New("mockError")
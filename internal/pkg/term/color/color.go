@@ -5,6 +5,7 @@
 package color
 
 import (
+	"hash/fnv"
 	"os"
 	"strings"
 
@@ -25,6 +26,16 @@ var (
 	BoldItalic = color.New(color.Bold).Add(color.Italic)
 )
 
+// labelColors are the colors used to tell apart the sources of interleaved output.
+var labelColors = []*color.Color{
+	color.New(color.FgCyan),
+	color.New(color.FgMagenta),
+	color.New(color.FgGreen),
+	color.New(color.FgBlue),
+	color.New(color.FgHiMagenta),
+	color.New(color.FgHiGreen),
+}
+
 const colorEnvVar = "COLOR"
 
 var lookupEnv = os.LookupEnv
@@ -66,6 +77,14 @@ func HighlightResource(s string) string {
 	return HiCyan.Sprint(s)
 }
 
+// Label colors the string to denote the source of a line of interleaved output, and returns it.
+// The same string is always colored the same way.
+func Label(s string) string {
+	h := fnv.New32a()
+	h.Write([]byte(s))
+	return labelColors[h.Sum32()%uint32(len(labelColors))].Sprint(s)
+}
+
 // HighlightCode wraps the string with the ` character, colors it to denote it's a code block, and returns it.
 func HighlightCode(s string) string {
 	return HiCyan.Sprintf("`%s`", s)
@@ -10,8 +10,13 @@
 //  │   ├── .ecs-workspace             (workspace summary)
 //  │   └── my-app
 //  │   │   └── manifest.yml             (application manifest)
-//  │   ├── buildspec.yml              (buildspec for the pipeline's build stage)
-//  │   └── pipeline.yml               (pipeline manifest)
+//  │   ├── environments
+//  │   │   └── test
+//  │   │       └── env.yml              (environment manifest)
+//  │   └── pipelines
+//  │       └── my-pipeline
+//  │           ├── buildspec.yml        (buildspec for the pipeline's build stage)
+//  │           └── pipeline.yml         (pipeline manifest)
 //  └── my-app                         (customer application)
 package workspace
 
@@ -20,6 +25,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 
 	"github.com/spf13/afero"
@@ -33,8 +39,11 @@ const (
 	workspaceSummaryFileName  = ".ecs-workspace"
 	maximumParentDirsToSearch = 5
 	pipelineFileName          = "pipeline.yml"
+	pipelinesDirName          = "pipelines"
 	manifestFileName          = "manifest.yml"
 	buildspecFileName         = "buildspec.yml"
+	environmentsDirName       = "environments"
+	envManifestFileName       = "env.yml"
 )
 
 // Summary is a description of what's associated with this workspace.
@@ -144,9 +153,117 @@ func (ws *Workspace) ReadAppManifest(appName string) ([]byte, error) {
 	return ws.read(appName, manifestFileName)
 }
 
-// ReadPipelineManifest returns the contents of the pipeline manifest under ecs-project/pipeline.yml.
-func (ws *Workspace) ReadPipelineManifest() ([]byte, error) {
-	return ws.read(pipelineFileName)
+// PipelineNames returns the names of the pipelines in the workspace.
+// The pipeline of workspaces created before pipelines were named, under ecs-project/pipeline.yml, is listed along with the others.
+func (ws *Workspace) PipelineNames() ([]string, error) {
+	names, err := ws.namedPipelineNames()
+	if err != nil {
+		return nil, err
+	}
+	legacyName, err := ws.legacyPipelineName()
+	if err != nil {
+		return nil, err
+	}
+	if legacyName == "" {
+		return names, nil
+	}
+	for _, name := range names {
+		if name == legacyName {
+			// The pipeline has its own directory, so its name refers to it instead.
+			return names, nil
+		}
+	}
+	return append(names, legacyName), nil
+}
+
+// namedPipelineNames returns the names of the pipelines with a directory under ecs-project/pipelines.
+func (ws *Workspace) namedPipelineNames() ([]string, error) {
+	projectPath, err := ws.projectDirPath()
+	if err != nil {
+		return nil, err
+	}
+	pipelinesPath := filepath.Join(projectPath, pipelinesDirName)
+	exists, err := ws.fsUtils.DirExists(pipelinesPath)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+	files, err := ws.fsUtils.ReadDir(pipelinesPath)
+	if err != nil {
+		return nil, fmt.Errorf("read directory %s: %w", pipelinesPath, err)
+	}
+	var names []string
+	for _, f := range files {
+		if !f.IsDir() {
+			continue
+		}
+		if exists, _ := ws.fsUtils.Exists(filepath.Join(pipelinesPath, f.Name(), pipelineFileName)); !exists {
+			continue
+		}
+		names = append(names, f.Name())
+	}
+	return names, nil
+}
+
+// legacyPipelineName returns the name of the pipeline under ecs-project/pipeline.yml, or an empty string if there is none.
+func (ws *Workspace) legacyPipelineName() (string, error) {
+	projectPath, err := ws.projectDirPath()
+	if err != nil {
+		return "", err
+	}
+	manifestPath := filepath.Join(projectPath, pipelineFileName)
+	exists, err := ws.fsUtils.Exists(manifestPath)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", nil
+	}
+	data, err := ws.fsUtils.ReadFile(manifestPath)
+	if err != nil {
+		return "", fmt.Errorf("read pipeline manifest %s: %w", manifestPath, err)
+	}
+	var pipeline struct {
+		Name string `yaml:"name"`
+	}
+	if err := yaml.Unmarshal(data, &pipeline); err != nil {
+		return "", fmt.Errorf("read the name of the pipeline in %s: %w", manifestPath, err)
+	}
+	return pipeline.Name, nil
+}
+
+// ReadPipelineManifest returns the contents of the pipeline manifest under ecs-project/pipelines/{pipelineName}/pipeline.yml.
+// Workspaces created before pipelines were named have a single manifest under ecs-project/pipeline.yml,
+// which is returned if the name is empty or if there is no directory for the pipeline.
+func (ws *Workspace) ReadPipelineManifest(pipelineName string) ([]byte, error) {
+	isNamed, err := ws.isNamedPipeline(pipelineName)
+	if err != nil {
+		return nil, err
+	}
+	if !isNamed {
+		return ws.read(pipelineFileName)
+	}
+	return ws.read(pipelinesDirName, pipelineName, pipelineFileName)
+}
+
+// PipelineBuildspecPath returns the path of the buildspec of the pipeline relative to the root of the repository.
+func (ws *Workspace) PipelineBuildspecPath(pipelineName string) (string, error) {
+	isNamed, err := ws.isNamedPipeline(pipelineName)
+	if err != nil {
+		return "", err
+	}
+	// The path is resolved by CodeBuild, so it always uses forward slashes.
+	if !isNamed {
+		return path.Join(ProjectDirectoryName, buildspecFileName), nil
+	}
+	return path.Join(ProjectDirectoryName, pipelinesDirName, pipelineName, buildspecFileName), nil
+}
+
+// ReadEnvironmentManifest returns the contents of the environment manifest under ecs-project/environments/{envName}/env.yml.
+func (ws *Workspace) ReadEnvironmentManifest(envName string) ([]byte, error) {
+	return ws.read(environmentsDirName, envName, envManifestFileName)
 }
 
 // WriteAppManifest writes the application manifest under the project directory.
@@ -159,24 +276,34 @@ func (ws *Workspace) WriteAppManifest(marshaler encoding.BinaryMarshaler, appNam
 	return ws.write(data, appName, manifestFileName)
 }
 
-// WritePipelineBuildspec writes the pipeline buildspec under the project directory.
+// WritePipelineBuildspec writes the pipeline buildspec under the pipeline's directory.
 // If successful returns the full path of the file, otherwise returns an empty string and the error.
-func (ws *Workspace) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler) (string, error) {
+func (ws *Workspace) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler, pipelineName string) (string, error) {
 	data, err := marshaler.MarshalBinary()
 	if err != nil {
-		return "", fmt.Errorf("marshal pipeline buildspec to binary: %w", err)
+		return "", fmt.Errorf("marshal pipeline %s buildspec to binary: %w", pipelineName, err)
 	}
-	return ws.write(data, buildspecFileName)
+	return ws.write(data, pipelinesDirName, pipelineName, buildspecFileName)
 }
 
-// WritePipelineManifest writes the pipeline manifest under the project directory.
+// WritePipelineManifest writes the pipeline manifest under the pipeline's directory.
 // If successful returns the full path of the file, otherwise returns an empty string and the error.
-func (ws *Workspace) WritePipelineManifest(marshaler encoding.BinaryMarshaler) (string, error) {
+func (ws *Workspace) WritePipelineManifest(marshaler encoding.BinaryMarshaler, pipelineName string) (string, error) {
 	data, err := marshaler.MarshalBinary()
 	if err != nil {
-		return "", fmt.Errorf("marshal pipeline manifest to binary: %w", err)
+		return "", fmt.Errorf("marshal pipeline %s manifest to binary: %w", pipelineName, err)
 	}
-	return ws.write(data, pipelineFileName)
+	return ws.write(data, pipelinesDirName, pipelineName, pipelineFileName)
+}
+
+// WriteEnvironmentManifest writes the environment manifest under the project directory.
+// If successful returns the full path of the file, otherwise returns an empty string and the error.
+func (ws *Workspace) WriteEnvironmentManifest(marshaler encoding.BinaryMarshaler, envName string) (string, error) {
+	data, err := marshaler.MarshalBinary()
+	if err != nil {
+		return "", fmt.Errorf("marshal environment %s manifest to binary: %w", envName, err)
+	}
+	return ws.write(data, environmentsDirName, envName, envManifestFileName)
 }
 
 // DeleteApp removes the application directory from the project directory.
@@ -193,6 +320,18 @@ func (ws *Workspace) DeleteAll() error {
 	return ws.fsUtils.RemoveAll(ProjectDirectoryName)
 }
 
+// isNamedPipeline returns true if the pipeline has its own directory under ecs-project/pipelines.
+func (ws *Workspace) isNamedPipeline(pipelineName string) (bool, error) {
+	if pipelineName == "" {
+		return false, nil
+	}
+	projectPath, err := ws.projectDirPath()
+	if err != nil {
+		return false, err
+	}
+	return ws.fsUtils.Exists(filepath.Join(projectPath, pipelinesDirName, pipelineName, pipelineFileName))
+}
+
 func (ws *Workspace) writeSummary(projectName string) error {
 	summaryPath, err := ws.summaryPath()
 	if err != nil {
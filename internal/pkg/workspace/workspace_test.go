@@ -278,6 +278,10 @@ func TestWorkspace_AppNames(t *testing.T) {
 
 				// Missing manifest.yml.
 				fs.Mkdir("/ecs-project/inventory", 0755)
+
+				// Environment manifests are not applications.
+				fs.MkdirAll("/ecs-project/environments/test", 0755)
+				fs.Create("/ecs-project/environments/test/env.yml")
 				return fs
 			},
 			wantedNames: []string{"users", "payments"},
@@ -303,6 +307,133 @@ func TestWorkspace_AppNames(t *testing.T) {
 	}
 }
 
+func TestWorkspace_PipelineNames(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedNames []string
+	}{
+		"no pipelines directory": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.Mkdir("/ecs-project", 0755)
+				fs.Create("/ecs-project/pipeline.yml")
+				return fs
+			},
+		},
+		"retrieve only directories with pipeline manifests": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/ecs-project/pipelines/release", 0755)
+				fs.Create("/ecs-project/pipelines/release/pipeline.yml")
+				fs.Create("/ecs-project/pipelines/release/buildspec.yml")
+				fs.MkdirAll("/ecs-project/pipelines/hotfix", 0755)
+				fs.Create("/ecs-project/pipelines/hotfix/pipeline.yml")
+
+				// Missing pipeline.yml.
+				fs.MkdirAll("/ecs-project/pipelines/staging", 0755)
+				fs.Create("/ecs-project/pipelines/staging/buildspec.yml")
+				return fs
+			},
+			wantedNames: []string{"release", "hotfix"},
+		},
+		"pipeline at the root of the project directory": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.Mkdir("/ecs-project", 0755)
+				afero.WriteFile(fs, "/ecs-project/pipeline.yml", []byte("name: pipeline-phonetool"), 0644)
+				return fs
+			},
+			wantedNames: []string{"pipeline-phonetool"},
+		},
+		"pipeline at the root of the project directory along with named pipelines": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				afero.WriteFile(fs, "/ecs-project/pipeline.yml", []byte("name: pipeline-phonetool"), 0644)
+				fs.MkdirAll("/ecs-project/pipelines/release", 0755)
+				fs.Create("/ecs-project/pipelines/release/pipeline.yml")
+				return fs
+			},
+			wantedNames: []string{"release", "pipeline-phonetool"},
+		},
+		"named pipeline with the name of the pipeline at the root of the project directory": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				afero.WriteFile(fs, "/ecs-project/pipeline.yml", []byte("name: release"), 0644)
+				fs.MkdirAll("/ecs-project/pipelines/release", 0755)
+				fs.Create("/ecs-project/pipelines/release/pipeline.yml")
+				return fs
+			},
+			wantedNames: []string{"release"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ws := &Workspace{
+				projectDir: "/ecs-project",
+				fsUtils: &afero.Afero{
+					Fs: tc.fs(),
+				},
+			}
+
+			names, err := ws.PipelineNames()
+
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.wantedNames, names)
+		})
+	}
+}
+
+func TestWorkspace_ReadPipelineManifest(t *testing.T) {
+	testCases := map[string]struct {
+		inPipelineName string
+
+		wantedData          string
+		wantedBuildspecPath string
+	}{
+		"named pipeline": {
+			inPipelineName: "hotfix",
+
+			wantedData:          "name: hotfix",
+			wantedBuildspecPath: "ecs-project/pipelines/hotfix/buildspec.yml",
+		},
+		"empty name reads the pipeline at the root of the project directory": {
+			wantedData:          "name: pipeline-phonetool",
+			wantedBuildspecPath: "ecs-project/buildspec.yml",
+		},
+		"pipeline without a directory reads the pipeline at the root of the project directory": {
+			inPipelineName: "pipeline-phonetool",
+
+			wantedData:          "name: pipeline-phonetool",
+			wantedBuildspecPath: "ecs-project/buildspec.yml",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			fs.MkdirAll("/ecs-project/pipelines/hotfix", 0755)
+			afero.WriteFile(fs, "/ecs-project/pipeline.yml", []byte("name: pipeline-phonetool"), 0644)
+			afero.WriteFile(fs, "/ecs-project/pipelines/hotfix/pipeline.yml", []byte("name: hotfix"), 0644)
+			ws := &Workspace{
+				projectDir: "/ecs-project",
+				fsUtils: &afero.Afero{
+					Fs: fs,
+				},
+			}
+
+			data, err := ws.ReadPipelineManifest(tc.inPipelineName)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedData, string(data))
+
+			buildspecPath, err := ws.PipelineBuildspecPath(tc.inPipelineName)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedBuildspecPath, buildspecPath)
+		})
+	}
+}
+
 func TestWorkspace_Read(t *testing.T) {
 	testCases := map[string]struct {
 		elems []string
@@ -357,6 +488,10 @@ func TestWorkspace_Write(t *testing.T) {
 			elems:      []string{"webhook", "addons", "policy.yml"},
 			wantedPath: "/ecs-project/webhook/addons/policy.yml",
 		},
+		"create environment manifest": {
+			elems:      []string{environmentsDirName, "test", envManifestFileName},
+			wantedPath: "/ecs-project/environments/test/env.yml",
+		},
 		"create file under project directory": {
 			elems:      []string{pipelineFileName},
 			wantedPath: "/ecs-project/pipeline.yml",
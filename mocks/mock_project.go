@@ -77,6 +77,20 @@ func (mr *MockProjectStoreMockRecorder) CreateProject(project interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectStore)(nil).CreateProject), project)
 }
 
+// UpdateProject mocks base method
+func (m *MockProjectStore) UpdateProject(project *archer.Project) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProject", project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProject indicates an expected call of UpdateProject
+func (mr *MockProjectStoreMockRecorder) UpdateProject(project interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockProjectStore)(nil).UpdateProject), project)
+}
+
 // DeleteProject mocks base method
 func (m *MockProjectStore) DeleteProject(name string) error {
 	m.ctrl.T.Helper()
@@ -166,6 +180,43 @@ func (mr *MockProjectCreatorMockRecorder) CreateProject(project interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectCreator)(nil).CreateProject), project)
 }
 
+// MockProjectUpdater is a mock of ProjectUpdater interface
+type MockProjectUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectUpdaterMockRecorder
+}
+
+// MockProjectUpdaterMockRecorder is the mock recorder for MockProjectUpdater
+type MockProjectUpdaterMockRecorder struct {
+	mock *MockProjectUpdater
+}
+
+// NewMockProjectUpdater creates a new mock instance
+func NewMockProjectUpdater(ctrl *gomock.Controller) *MockProjectUpdater {
+	mock := &MockProjectUpdater{ctrl: ctrl}
+	mock.recorder = &MockProjectUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockProjectUpdater) EXPECT() *MockProjectUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateProject mocks base method
+func (m *MockProjectUpdater) UpdateProject(project *archer.Project) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProject", project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProject indicates an expected call of UpdateProject
+func (mr *MockProjectUpdaterMockRecorder) UpdateProject(project interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockProjectUpdater)(nil).UpdateProject), project)
+}
+
 // MockProjectGetter is a mock of ProjectGetter interface
 type MockProjectGetter struct {
 	ctrl     *gomock.Controller